@@ -285,39 +285,6 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 	evidenceKeeper.SetRouter(evidenceRouter)
 	app.evidenceKeeper = *evidenceKeeper
 
-	// create committee keeper with router
-	committeeGovRouter := gov.NewRouter()
-	committeeGovRouter.
-		AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
-		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
-	// Note: the committee proposal handler is not registered on the committee router. This means committees cannot create or update other committees.
-	// Adding the committee proposal handler to the router is possible but awkward as the handler depends on the keeper which depends on the handler.
-	app.committeeKeeper = committee.NewKeeper(
-		app.cdc,
-		keys[committee.StoreKey],
-		committeeGovRouter,
-		app.paramsKeeper,
-	)
-
-	// create gov keeper with router
-	govRouter := gov.NewRouter()
-	govRouter.
-		AddRoute(gov.RouterKey, gov.ProposalHandler).
-		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
-		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
-		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(committee.RouterKey, committee.NewProposalHandler(app.committeeKeeper))
-	app.govKeeper = gov.NewKeeper(
-		app.cdc,
-		keys[gov.StoreKey],
-		govSubspace,
-		app.supplyKeeper,
-		&stakingKeeper,
-		govRouter,
-	)
-
 	app.vvKeeper = validatorvesting.NewKeeper(
 		app.cdc,
 		keys[validatorvesting.StoreKey],
@@ -326,7 +293,7 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		app.supplyKeeper,
 		&stakingKeeper,
 	)
-	app.pricefeedKeeper = pricefeed.NewKeeper(
+	pricefeedKeeper := pricefeed.NewKeeper(
 		app.cdc,
 		keys[pricefeed.StoreKey],
 		pricefeedSubspace,
@@ -341,7 +308,7 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		app.cdc,
 		keys[cdp.StoreKey],
 		cdpSubspace,
-		app.pricefeedKeeper,
+		pricefeedKeeper,
 		app.auctionKeeper,
 		app.supplyKeeper,
 		app.accountKeeper,
@@ -362,15 +329,10 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		app.accountKeeper,
 		app.supplyKeeper,
 		&stakingKeeper,
-		app.pricefeedKeeper,
+		pricefeedKeeper,
 		app.auctionKeeper,
 	)
-	app.kavadistKeeper = kavadist.NewKeeper(
-		app.cdc,
-		keys[kavadist.StoreKey],
-		kavadistSubspace,
-		app.supplyKeeper,
-	)
+
 	app.incentiveKeeper = incentive.NewKeeper(
 		app.cdc,
 		keys[incentive.StoreKey],
@@ -380,6 +342,60 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 		&hardKeeper,
 		app.accountKeeper,
 		&stakingKeeper,
+		app.distrKeeper,
+	)
+	// delegators earn source rewards for the bond denom, in addition to hard delegator rewards
+	app.incentiveKeeper.RegisterRewardSource(incentive.BondDenom, incentive.NewDelegatorRewardSource(&stakingKeeper))
+
+	// param changes re-index the cdp collateral markets and prune the pricefeed store as soon as they are enacted,
+	// and incentive param changes are rejected if they add rewards for unregistered reward sources
+	paramChangeProposalHandler := incentive.NewParamChangeProposalHandler(app.incentiveKeeper,
+		pricefeed.NewParamChangeProposalHandler(&pricefeedKeeper,
+			cdp.NewParamChangeProposalHandler(cdpKeeper, params.NewParamChangeProposalHandler(app.paramsKeeper))))
+
+	// create committee keeper with router
+	committeeGovRouter := gov.NewRouter()
+	committeeGovRouter.
+		AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(params.RouterKey, paramChangeProposalHandler).
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper))
+	// Note: the committee proposal handler is not registered on the committee router. This means committees cannot create or update other committees.
+	// Adding the committee proposal handler to the router is possible but awkward as the handler depends on the keeper which depends on the handler.
+	app.committeeKeeper = committee.NewKeeper(
+		app.cdc,
+		keys[committee.StoreKey],
+		committeeGovRouter,
+		app.paramsKeeper,
+		app.accountKeeper,
+		app.supplyKeeper,
+		&stakingKeeper,
+		&hardKeeper,
+		app.ModuleAccountAddrs(),
+	)
+
+	// create gov keeper with router
+	govRouter := gov.NewRouter()
+	govRouter.
+		AddRoute(gov.RouterKey, gov.ProposalHandler).
+		AddRoute(params.RouterKey, committee.NewParamChangeProposalHandler(app.committeeKeeper, paramChangeProposalHandler)).
+		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
+		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
+		AddRoute(committee.RouterKey, committee.NewProposalHandler(app.committeeKeeper))
+	app.govKeeper = gov.NewKeeper(
+		app.cdc,
+		keys[gov.StoreKey],
+		govSubspace,
+		app.supplyKeeper,
+		&stakingKeeper,
+		govRouter,
+	)
+
+	app.kavadistKeeper = kavadist.NewKeeper(
+		app.cdc,
+		keys[kavadist.StoreKey],
+		kavadistSubspace,
+		app.supplyKeeper,
 	)
 	app.issuanceKeeper = issuance.NewKeeper(
 		app.cdc,
@@ -398,6 +414,8 @@ func NewApp(logger log.Logger, db dbm.DB, traceStore io.Writer, appOpts AppOptio
 
 	app.hardKeeper = *hardKeeper.SetHooks(hard.NewMultiHARDHooks(app.incentiveKeeper.Hooks()))
 
+	app.pricefeedKeeper = *pricefeedKeeper.SetHooks(pricefeed.NewMultiPricefeedHooks(app.cdpKeeper.Hooks()))
+
 	// create the module manager (Note: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.)
 	app.mm = module.NewManager(
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/bep3"
+)
+
+// debugCmd returns a command containing tools to debug kava state
+func debugCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "debug",
+		Short: "Tools for debugging kava state",
+	}
+	cmd.AddCommand(reconcileBep3Cmd(cdc))
+	return cmd
+}
+
+// reconcileBep3Cmd returns a command that checks bep3 swap accounting in an exported genesis file.
+func reconcileBep3Cmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reconcile-bep3 [genesis-file]",
+		Short: "Check bep3 swap accounting in an exported genesis file",
+		Long: `Run the bep3 invariants over an exported genesis file and report any discrepancies.
+The bep3 module account balance must match the coins locked in outgoing swaps, each asset's incoming and outgoing
+supply must match the coins locked in its incoming and outgoing swaps, and no asset's current supply can exceed its supply limit.
+The command exits with an error if any discrepancies are found.`,
+		Example: fmt.Sprintf(`%s debug reconcile-bep3 /path/to/genesis.json`, version.ServerName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			genDoc, err := tmtypes.GenesisDocFromFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to read genesis document from file %s: %w", args[0], err)
+			}
+
+			var appState app.GenesisState
+			if err := cdc.UnmarshalJSON(genDoc.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to unmarshal app state: %w", err)
+			}
+
+			var bep3GenState bep3.GenesisState
+			if err := cdc.UnmarshalJSON(appState[bep3.ModuleName], &bep3GenState); err != nil {
+				return fmt.Errorf("failed to unmarshal %s genesis state: %w", bep3.ModuleName, err)
+			}
+
+			var authGenState auth.GenesisState
+			if err := cdc.UnmarshalJSON(appState[auth.ModuleName], &authGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal %s genesis state: %w", auth.ModuleName, err)
+			}
+
+			moduleAddress := supply.NewModuleAddress(bep3.ModuleName)
+			moduleAccCoins := sdk.NewCoins()
+			for _, acc := range authGenState.Accounts {
+				if acc.GetAddress().Equals(moduleAddress) {
+					moduleAccCoins = acc.GetCoins()
+					break
+				}
+			}
+
+			var discrepancies []string
+			discrepancies = append(discrepancies, bep3.ReconcileModuleAccount(moduleAccCoins, bep3GenState.AtomicSwaps)...)
+			discrepancies = append(discrepancies, bep3.ReconcileAssetSupplies(bep3GenState.Supplies, bep3GenState.AtomicSwaps)...)
+			discrepancies = append(discrepancies, bep3.ReconcileSupplyLimits(bep3GenState.Supplies, bep3GenState.Params.AssetParams)...)
+
+			if len(discrepancies) == 0 {
+				fmt.Printf("no discrepancies found in %s swap accounting\n", bep3.ModuleName)
+				return nil
+			}
+			fmt.Printf("found %d discrepancies in %s swap accounting:\n", len(discrepancies), bep3.ModuleName)
+			for _, d := range discrepancies {
+				fmt.Printf("\t%s\n", d)
+			}
+			cmd.SilenceUsage = true
+			return fmt.Errorf("%s swap accounting has %d discrepancies", bep3.ModuleName, len(discrepancies))
+		},
+	}
+
+	return cmd
+}
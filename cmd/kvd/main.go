@@ -68,6 +68,7 @@ func main() {
 		genutilcli.ValidateGenesisCmd(ctx, cdc, app.ModuleBasics),
 		AddGenesisAccountCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome),
 		testnetCmd(ctx, cdc, app.ModuleBasics, auth.GenesisAccountIterator{}),
+		debugCmd(cdc),
 		flags.NewCompletionCmd(rootCmd, true),
 	)
 
@@ -198,7 +198,7 @@ func MigrateBep3(oldGenState v0_9bep3.GenesisState) v0_11bep3.GenesisState {
 		sdk.NewInt(3),
 		sdk.NewInt(1000000000),
 		220,
-		270,
+		270, nil,
 	)
 	btcbAssetSupply := v0_11bep3.NewAssetSupply(
 		sdk.NewCoin("btcb", sdk.ZeroInt()),
@@ -224,7 +224,7 @@ func MigrateBep3(oldGenState v0_9bep3.GenesisState) v0_11bep3.GenesisState {
 		sdk.NewInt(100001),
 		sdk.NewInt(10000000000000),
 		220,
-		270,
+		270, nil,
 	)
 	xrpbAssetSupply := v0_11bep3.NewAssetSupply(
 		sdk.NewCoin("xrpb", sdk.ZeroInt()),
@@ -250,7 +250,7 @@ func MigrateBep3(oldGenState v0_9bep3.GenesisState) v0_11bep3.GenesisState {
 		sdk.NewInt(20001),
 		sdk.NewInt(1000000000000),
 		220,
-		270,
+		270, nil,
 	)
 	busdAssetSupply := v0_11bep3.NewAssetSupply(
 		sdk.NewCoin("busd", sdk.ZeroInt()),
@@ -261,7 +261,7 @@ func MigrateBep3(oldGenState v0_9bep3.GenesisState) v0_11bep3.GenesisState {
 	assetParams = append(assetParams, busdAssetParam)
 	assetSupplies = append(assetSupplies, busdAssetSupply)
 	return v0_11bep3.GenesisState{
-		Params:            v0_11bep3.NewParams(assetParams),
+		Params:            v0_11bep3.NewParams(assetParams, v0_11bep3.ChainParams{}),
 		AtomicSwaps:       swaps,
 		Supplies:          assetSupplies,
 		PreviousBlockTime: v0_11bep3.DefaultPreviousBlockTime,
@@ -770,9 +770,9 @@ func MigratePricefeed(oldGenState v0_9pricefeed.GenesisState) v0_11pricefeed.Gen
 		newPrice := v0_11pricefeed.NewPostedPrice(price.MarketID, price.OracleAddress, price.Price, price.Expiry)
 		newPostedPrices = append(newPostedPrices, newPrice)
 	}
-	newParams := v0_11pricefeed.NewParams(newMarkets)
+	newParams := v0_11pricefeed.NewParams(newMarkets, v0_11pricefeed.DefaultOracleParams, v0_11pricefeed.DefaultPriceHistoryRetention)
 
-	return v0_11pricefeed.NewGenesisState(newParams, newPostedPrices)
+	return v0_11pricefeed.NewGenesisState(newParams, newPostedPrices, nil, nil, nil)
 }
 
 func mustAccAddressFromBech32(bech32Addr string) sdk.AccAddress {
@@ -16,6 +16,7 @@ import (
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction"
 	"github.com/kava-labs/kava/x/bep3"
 	v0_14cdp "github.com/kava-labs/kava/x/cdp"
 	v0_11cdp "github.com/kava-labs/kava/x/cdp/legacy/v0_11"
@@ -98,6 +99,12 @@ func MigrateAppState(v0_11AppState genutil.AppMap) genutil.AppMap {
 		delete(v0_11AppState, bep3.ModuleName)
 		v0_14AppState[bep3.ModuleName] = cdc.MustMarshalJSON(Bep3(bep3GS))
 	}
+	if v0_11AppState[auction.ModuleName] != nil {
+		var auctionGS auction.GenesisState
+		cdc.MustUnmarshalJSON(v0_11AppState[auction.ModuleName], &auctionGS)
+		delete(v0_11AppState, auction.ModuleName)
+		v0_14AppState[auction.ModuleName] = cdc.MustMarshalJSON(Auction(auctionGS))
+	}
 	if v0_11AppState[v0_11committee.ModuleName] != nil {
 		var committeeGS v0_11committee.GenesisState
 		cdc := codec.New()
@@ -169,7 +176,7 @@ func CDP(oldGenState v0_11cdp.GenesisState) v0_14cdp.GenesisState {
 
 	newGlobalDebtLimit := oldGenState.Params.GlobalDebtLimit
 
-	newParams := v0_14cdp.NewParams(newGlobalDebtLimit, newCollateralParams, newDebtParam, oldGenState.Params.SurplusAuctionThreshold, oldGenState.Params.SurplusAuctionLot, oldGenState.Params.DebtAuctionThreshold, oldGenState.Params.DebtAuctionLot, false)
+	newParams := v0_14cdp.NewParams(newGlobalDebtLimit, newCollateralParams, newDebtParam, oldGenState.Params.SurplusAuctionThreshold, oldGenState.Params.SurplusAuctionLot, oldGenState.Params.DebtAuctionThreshold, oldGenState.Params.DebtAuctionLot, false, v0_14cdp.DefaultSealedBidSurplus)
 
 	return v0_14cdp.NewGenesisState(
 		newParams,
@@ -292,7 +299,7 @@ func Incentive(hardGS v0_11hard.GenesisState, incentiveGS v0_11incentive.Genesis
 		newRP := v0_14incentive.NewRewardPeriod(rp.DistributionSchedule.Active, rp.DistributionSchedule.DepositDenom, rp.DistributionSchedule.Start, rp.DistributionSchedule.End, rp.DistributionSchedule.RewardsPerSecond)
 		hardDelegatorRewardPeriods = append(hardDelegatorRewardPeriods, newRP)
 	}
-	params := v0_14incentive.NewParams(usdxMintingRewardPeriods, hardSupplyRewardPeriods, hardBorrowRewardPeriods, hardDelegatorRewardPeriods, v0_14incentive.Multipliers{v0_14incentive.NewMultiplier(v0_14incentive.Small, 1, sdk.MustNewDecFromStr("0.2")), v0_14incentive.NewMultiplier(v0_14incentive.Large, 12, sdk.MustNewDecFromStr("1.0"))}, ClaimEndTime)
+	params := v0_14incentive.NewParams(usdxMintingRewardPeriods, hardSupplyRewardPeriods, hardBorrowRewardPeriods, hardDelegatorRewardPeriods, v0_14incentive.DefaultMultiRewardPeriods, v0_14incentive.Multipliers{v0_14incentive.NewMultiplier(v0_14incentive.Small, 1, sdk.MustNewDecFromStr("0.2")), v0_14incentive.NewMultiplier(v0_14incentive.Large, 12, sdk.MustNewDecFromStr("1.0"))}, v0_14incentive.NewFixedClaimWindows(ClaimEndTime))
 
 	usdxGenAccumulationTimes := v0_14incentive.GenesisAccumulationTimes{}
 
@@ -396,6 +403,10 @@ func Incentive(hardGS v0_11hard.GenesisState, incentiveGS v0_11incentive.Genesis
 		hardDelegatorGenAccumulationTimes,
 		usdxClaims,
 		hardClaims,
+		v0_14incentive.DefaultGenesisAccumulationTimes,
+		v0_14incentive.DefaultSourceClaims,
+		v0_14incentive.DefaultGenesisClaimAccrualTimes,
+		v0_14incentive.DefaultGenesisClaimExpiryStarts,
 	)
 }
 
@@ -527,8 +538,38 @@ func Bep3(genesisState bep3.GenesisState) bep3.GenesisState {
 		ap.MaxBlockLock = uint64(86400)
 		newAssetParams = append(newAssetParams, ap)
 	}
-	newParams := bep3.NewParams(newAssetParams)
-	return bep3.NewGenesisState(newParams, newSwaps, newSupplies, genesisState.PreviousBlockTime)
+	newParams := bep3.NewParams(newAssetParams, bep3.ChainParams{})
+	return bep3.NewGenesisState(newParams, newSwaps, newSupplies, bep3.ChainSupplies{},
+		bep3.AddressQuotaUsages{}, bep3.OutgoingQuotaUsages{}, genesisState.PreviousBlockTime)
+}
+
+// Auction migrates a v0.11 auction genesis state to a v0.14 auction genesis state
+func Auction(genesisState auction.GenesisState) auction.GenesisState {
+	// sealed bid auctions and denom params did not exist in v0.11, so their params are set to the defaults
+	params := genesisState.Params
+	params.SealedBidCommitDuration = auction.DefaultSealedBidCommitDuration
+	params.SealedBidRevealDuration = auction.DefaultSealedBidRevealDuration
+	params.SealedBidPricingRule = auction.DefaultSealedBidPricingRule
+	params.DenomParams = auction.DenomParams{}
+
+	// open auctions fix their durations and increments when started, for existing auctions use the migrated params
+	auctions := auction.GenesisAuctions{}
+	for _, a := range genesisState.Auctions {
+		switch a := a.(type) {
+		case auction.SurplusAuction:
+			a.Params = params.ForDenom(a.Lot.Denom)
+			auctions = append(auctions, a)
+		case auction.DebtAuction:
+			a.Params = params.ForDenom(a.Lot.Denom)
+			auctions = append(auctions, a)
+		case auction.CollateralAuction:
+			a.Params = params.ForDenom(a.Lot.Denom)
+			auctions = append(auctions, a)
+		default:
+			auctions = append(auctions, a)
+		}
+	}
+	return auction.NewGenesisState(genesisState.NextAuctionID, params, auctions)
 }
 
 // Committee migrates from a v0.11 (or v0.12) committee genesis state to a v0.13 committee genesis state
@@ -616,7 +657,14 @@ func Committee(genesisState v0_11committee.GenesisState) v0_14committee.GenesisS
 					// update AllowedAssetParams
 					var newAssetParams v0_14committee.AllowedAssetParams
 					for _, ap := range subPerm.AllowedAssetParams {
-						newAP := v0_14committee.AllowedAssetParam(ap)
+						newAP := v0_14committee.AllowedAssetParam{
+							Denom:         ap.Denom,
+							CoinID:        ap.CoinID,
+							Limit:         ap.Limit,
+							Active:        ap.Active,
+							MaxSwapAmount: ap.MaxSwapAmount,
+							MinBlockLock:  ap.MinBlockLock,
+						}
 						newAssetParams = append(newAssetParams, newAP)
 					}
 					newStabilitySubParamPermissions.AllowedAssetParams = newAssetParams
@@ -624,7 +672,13 @@ func Committee(genesisState v0_11committee.GenesisState) v0_14committee.GenesisS
 					// Update Allowed Markets
 					var newMarketParams v0_14committee.AllowedMarkets
 					for _, mp := range subPerm.AllowedMarkets {
-						newMP := v0_14committee.AllowedMarket(mp)
+						newMP := v0_14committee.AllowedMarket{
+							MarketID:   mp.MarketID,
+							BaseAsset:  mp.BaseAsset,
+							QuoteAsset: mp.QuoteAsset,
+							Oracles:    mp.Oracles,
+							Active:     mp.Active,
+						}
 						newMarketParams = append(newMarketParams, newMP)
 					}
 					newStabilitySubParamPermissions.AllowedMarkets = newMarketParams
@@ -655,7 +709,7 @@ func Committee(genesisState v0_11committee.GenesisState) v0_14committee.GenesisS
 	}
 
 	for _, v := range genesisState.Votes {
-		votes = append(votes, v0_14committee.Vote(v))
+		votes = append(votes, v0_14committee.NewVote(v.ProposalID, v.Voter, v0_14committee.Yes, sdk.OneInt()))
 	}
 
 	for _, p := range genesisState.Proposals {
@@ -664,7 +718,7 @@ func Committee(genesisState v0_11committee.GenesisState) v0_14committee.GenesisS
 		proposals = append(proposals, newProp)
 	}
 	return v0_14committee.NewGenesisState(
-		genesisState.NextProposalID, committees, proposals, votes)
+		genesisState.NextProposalID, committees, proposals, votes, []v0_14committee.QueuedProposal{}, []v0_14committee.ParamChangeRecord{})
 }
 
 // Pricefeed migrates from a v0.11 (or v0.12) pricefeed genesis state to a v0.13 pricefeed genesis state
@@ -678,15 +732,27 @@ func Pricefeed(genesisState v0_11pricefeed.GenesisState) v0_14pricefeed.GenesisS
 	usdx := v0_11pricefeed.NewMarket("usdx:usd", "usdx", "usd", oracles, true)
 	newMarkets = append(newMarkets, usdx)
 
+	marketOracles := make(map[string]map[string]bool)
+	for _, m := range newMarkets {
+		marketOracles[m.MarketID] = make(map[string]bool)
+		for _, o := range m.Oracles {
+			marketOracles[m.MarketID][o.String()] = true
+		}
+	}
+
 	newPrices := v0_14pricefeed.PostedPrices{}
 
 	for _, p := range genesisState.PostedPrices {
+		// drop prices posted by addresses that are no longer oracles of their market, which would fail genesis validation
+		if !marketOracles[p.MarketID][p.OracleAddress.String()] {
+			continue
+		}
 		if p.Expiry.After(GenesisTime) {
 			newPrices = append(newPrices, p)
 		}
 	}
 
-	return v0_14pricefeed.NewGenesisState(v0_14pricefeed.NewParams(newMarkets), newPrices)
+	return v0_14pricefeed.NewGenesisState(v0_14pricefeed.NewParams(newMarkets, v0_14pricefeed.DefaultOracleParams, v0_14pricefeed.DefaultPriceHistoryRetention), newPrices, nil, nil, nil)
 }
 
 func removeIndex(accs authexported.GenesisAccounts, index int) authexported.GenesisAccounts {
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -15,9 +16,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/supply"
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 
+	"github.com/tendermint/tendermint/crypto"
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction"
 	"github.com/kava-labs/kava/x/bep3"
 	v0_11cdp "github.com/kava-labs/kava/x/cdp/legacy/v0_11"
 	v0_14committee "github.com/kava-labs/kava/x/committee"
@@ -194,6 +197,19 @@ func TestPricefeed(t *testing.T) {
 	err = newGenState.Validate()
 	require.NoError(t, err)
 	require.Equal(t, len(oldPricefeedGenState.Params.Markets)+1, len(newGenState.Params.Markets))
+
+	// prices posted by addresses that have since been removed as oracles of their market are dropped
+	market := oldPricefeedGenState.Params.Markets[0]
+	removedOracle := sdk.AccAddress(crypto.AddressHash([]byte("RemovedOracle")))
+	validPrice := v0_11pricefeed.NewPostedPrice(market.MarketID, market.Oracles[0], sdk.OneDec(), GenesisTime.Add(time.Hour))
+	oldPricefeedGenState.PostedPrices = v0_11pricefeed.PostedPrices{
+		validPrice,
+		v0_11pricefeed.NewPostedPrice(market.MarketID, removedOracle, sdk.OneDec(), GenesisTime.Add(time.Hour)),
+		v0_11pricefeed.NewPostedPrice("unknown:usd", market.Oracles[0], sdk.OneDec(), GenesisTime.Add(time.Hour)),
+	}
+	newGenState = Pricefeed(oldPricefeedGenState)
+	require.NoError(t, newGenState.Validate())
+	require.Equal(t, v0_11pricefeed.PostedPrices{validPrice}, newGenState.PostedPrices)
 }
 func TestBep3(t *testing.T) {
 	bz, err := ioutil.ReadFile(filepath.Join("testdata", "kava-6-bep3-state.json"))
@@ -227,6 +243,56 @@ func TestBep3(t *testing.T) {
 	require.Equal(t, uint64(86400), newGenState.Params.AssetParams[0].MaxBlockLock)
 }
 
+func TestAuction(t *testing.T) {
+	// v0.11 auction params predate sealed bid auctions and denom params
+	bz := []byte(`{
+		"next_auction_id": "12",
+		"params": {
+			"max_auction_duration": "172800000000000",
+			"bid_duration": "3600000000000",
+			"increment_surplus": "0.050000000000000000",
+			"increment_debt": "0.050000000000000000",
+			"increment_collateral": "0.050000000000000000"
+		},
+		"auctions": [
+			{
+				"type": "auction/SurplusAuction",
+				"value": {
+					"base_auction": {
+						"id": "11",
+						"initiator": "liquidator",
+						"lot": {"denom": "usdx", "amount": "10000000000"},
+						"bidder": "",
+						"bid": {"denom": "ukava", "amount": "0"},
+						"has_received_bids": false,
+						"end_time": "9000-01-01T00:00:00Z",
+						"max_end_time": "9000-01-01T00:00:00Z"
+					}
+				}
+			}
+		]
+	}`)
+	cdc := app.MakeCodec()
+	var oldGenState auction.GenesisState
+	require.NotPanics(t, func() {
+		cdc.MustUnmarshalJSON(bz, &oldGenState)
+	})
+	require.Error(t, oldGenState.Validate())
+
+	newGenState := Auction(oldGenState)
+	err := newGenState.Validate()
+	require.NoError(t, err)
+	require.Equal(t, auction.DefaultSealedBidCommitDuration, newGenState.Params.SealedBidCommitDuration)
+	require.Equal(t, auction.DefaultSealedBidRevealDuration, newGenState.Params.SealedBidRevealDuration)
+	require.Equal(t, auction.DefaultSealedBidPricingRule, newGenState.Params.SealedBidPricingRule)
+	require.Equal(t, oldGenState.Params.MaxAuctionDuration, newGenState.Params.MaxAuctionDuration)
+	require.Equal(t, uint64(12), newGenState.NextAuctionID)
+	require.Len(t, newGenState.Auctions, 1)
+	surplusAuction, ok := newGenState.Auctions[0].(auction.SurplusAuction)
+	require.True(t, ok)
+	require.Equal(t, newGenState.Params.ForDenom("usdx"), surplusAuction.Params)
+}
+
 func TestMigrateFull(t *testing.T) {
 	oldGenDoc, err := tmtypes.GenesisDocFromFile(filepath.Join("testdata", "kava-6-block-127500.json"))
 	require.NoError(t, err)
@@ -8,105 +8,144 @@ import (
 )
 
 const (
-	AttributeKeyAuctionID     = types.AttributeKeyAuctionID
-	AttributeKeyAuctionType   = types.AttributeKeyAuctionType
-	AttributeKeyBid           = types.AttributeKeyBid
-	AttributeKeyBidder        = types.AttributeKeyBidder
-	AttributeKeyCloseBlock    = types.AttributeKeyCloseBlock
-	AttributeKeyEndTime       = types.AttributeKeyEndTime
-	AttributeKeyLot           = types.AttributeKeyLot
-	AttributeKeyMaxBid        = types.AttributeKeyMaxBid
-	AttributeValueCategory    = types.AttributeValueCategory
-	CollateralAuctionType     = types.CollateralAuctionType
-	DebtAuctionType           = types.DebtAuctionType
-	DefaultBidDuration        = types.DefaultBidDuration
-	DefaultMaxAuctionDuration = types.DefaultMaxAuctionDuration
-	DefaultNextAuctionID      = types.DefaultNextAuctionID
-	DefaultParamspace         = types.DefaultParamspace
-	EventTypeAuctionBid       = types.EventTypeAuctionBid
-	EventTypeAuctionClose     = types.EventTypeAuctionClose
-	EventTypeAuctionStart     = types.EventTypeAuctionStart
-	ForwardAuctionPhase       = types.ForwardAuctionPhase
-	ModuleName                = types.ModuleName
-	QuerierRoute              = types.QuerierRoute
-	QueryGetAuction           = types.QueryGetAuction
-	QueryGetAuctions          = types.QueryGetAuctions
-	QueryGetParams            = types.QueryGetParams
-	QueryNextAuctionID        = types.QueryNextAuctionID
-	ReverseAuctionPhase       = types.ReverseAuctionPhase
-	RouterKey                 = types.RouterKey
-	StoreKey                  = types.StoreKey
-	SurplusAuctionType        = types.SurplusAuctionType
+	AttributeKeyAuctionID          = types.AttributeKeyAuctionID
+	AttributeKeyAuctionType        = types.AttributeKeyAuctionType
+	AttributeKeyBid                = types.AttributeKeyBid
+	AttributeKeyBidHash            = types.AttributeKeyBidHash
+	AttributeKeyBidder             = types.AttributeKeyBidder
+	AttributeKeyCloseBlock         = types.AttributeKeyCloseBlock
+	AttributeKeyDeposit            = types.AttributeKeyDeposit
+	AttributeKeyEndTime            = types.AttributeKeyEndTime
+	AttributeKeyLot                = types.AttributeKeyLot
+	AttributeKeyMaxBid             = types.AttributeKeyMaxBid
+	AttributeKeyRevealStart        = types.AttributeKeyRevealStart
+	AttributeValueCategory         = types.AttributeValueCategory
+	CollateralAuctionType          = types.CollateralAuctionType
+	DebtAuctionType                = types.DebtAuctionType
+	DefaultBidDuration             = types.DefaultBidDuration
+	DefaultMaxAuctionDuration      = types.DefaultMaxAuctionDuration
+	DefaultNextAuctionID           = types.DefaultNextAuctionID
+	DefaultParamspace              = types.DefaultParamspace
+	DefaultSealedBidCommitDuration = types.DefaultSealedBidCommitDuration
+	DefaultSealedBidPricingRule    = types.DefaultSealedBidPricingRule
+	DefaultSealedBidRevealDuration = types.DefaultSealedBidRevealDuration
+	EventTypeAuctionBid            = types.EventTypeAuctionBid
+	EventTypeAuctionClose          = types.EventTypeAuctionClose
+	EventTypeAuctionCommit         = types.EventTypeAuctionCommit
+	EventTypeAuctionReveal         = types.EventTypeAuctionReveal
+	EventTypeAuctionStart          = types.EventTypeAuctionStart
+	FirstPriceSealedBid            = types.FirstPriceSealedBid
+	ForwardAuctionPhase            = types.ForwardAuctionPhase
+	ModuleName                     = types.ModuleName
+	QuerierRoute                   = types.QuerierRoute
+	QueryGetAuction                = types.QueryGetAuction
+	QueryGetAuctions               = types.QueryGetAuctions
+	QueryGetParams                 = types.QueryGetParams
+	QueryNextAuctionID             = types.QueryNextAuctionID
+	ReverseAuctionPhase            = types.ReverseAuctionPhase
+	RouterKey                      = types.RouterKey
+	SealedBidSaltLength            = types.SealedBidSaltLength
+	SealedBidSurplusAuctionType    = types.SealedBidSurplusAuctionType
+	SecondPriceSealedBid           = types.SecondPriceSealedBid
+	StoreKey                       = types.StoreKey
+	SurplusAuctionType             = types.SurplusAuctionType
 )
 
 var (
 	// function aliases
-	ModuleAccountInvariants  = keeper.ModuleAccountInvariants
-	NewKeeper                = keeper.NewKeeper
-	NewQuerier               = keeper.NewQuerier
-	RegisterInvariants       = keeper.RegisterInvariants
-	ValidAuctionInvariant    = keeper.ValidAuctionInvariant
-	ValidIndexInvariant      = keeper.ValidIndexInvariant
-	DefaultGenesisState      = types.DefaultGenesisState
-	DefaultParams            = types.DefaultParams
-	GetAuctionByTimeKey      = types.GetAuctionByTimeKey
-	GetAuctionKey            = types.GetAuctionKey
-	NewAuctionWithPhase      = types.NewAuctionWithPhase
-	NewCollateralAuction     = types.NewCollateralAuction
-	NewDebtAuction           = types.NewDebtAuction
-	NewGenesisState          = types.NewGenesisState
-	NewMsgPlaceBid           = types.NewMsgPlaceBid
-	NewParams                = types.NewParams
-	NewQueryAllAuctionParams = types.NewQueryAllAuctionParams
-	NewQueryAuctionParams    = types.NewQueryAuctionParams
-	NewSurplusAuction        = types.NewSurplusAuction
-	NewWeightedAddresses     = types.NewWeightedAddresses
-	ParamKeyTable            = types.ParamKeyTable
-	RegisterCodec            = types.RegisterCodec
-	Uint64FromBytes          = types.Uint64FromBytes
-	Uint64ToBytes            = types.Uint64ToBytes
+	ModuleAccountInvariants    = keeper.ModuleAccountInvariants
+	NewKeeper                  = keeper.NewKeeper
+	NewQuerier                 = keeper.NewQuerier
+	RegisterInvariants         = keeper.RegisterInvariants
+	ValidAuctionInvariant      = keeper.ValidAuctionInvariant
+	ValidIndexInvariant        = keeper.ValidIndexInvariant
+	CalculateSealedBidHash     = types.CalculateSealedBidHash
+	DefaultGenesisState        = types.DefaultGenesisState
+	DefaultParams              = types.DefaultParams
+	GenerateSealedBidSalt      = types.GenerateSealedBidSalt
+	GetAuctionByTimeKey        = types.GetAuctionByTimeKey
+	GetAuctionKey              = types.GetAuctionKey
+	NewAuctionWithPhase        = types.NewAuctionWithPhase
+	NewCollateralAuction       = types.NewCollateralAuction
+	NewDebtAuction             = types.NewDebtAuction
+	NewDenomParam              = types.NewDenomParam
+	NewGenesisState            = types.NewGenesisState
+	NewMsgCommitBid            = types.NewMsgCommitBid
+	NewMsgPlaceBid             = types.NewMsgPlaceBid
+	NewMsgRevealBid            = types.NewMsgRevealBid
+	NewParams                  = types.NewParams
+	NewQueryAllAuctionParams   = types.NewQueryAllAuctionParams
+	NewQueryAuctionParams      = types.NewQueryAuctionParams
+	NewSealedBid               = types.NewSealedBid
+	NewSealedBidSurplusAuction = types.NewSealedBidSurplusAuction
+	NewSurplusAuction          = types.NewSurplusAuction
+	NewWeightedAddresses       = types.NewWeightedAddresses
+	ParamKeyTable              = types.ParamKeyTable
+	RegisterCodec              = types.RegisterCodec
+	Uint64FromBytes            = types.Uint64FromBytes
+	Uint64ToBytes              = types.Uint64ToBytes
+	ValidatePricingRule        = types.ValidatePricingRule
 
 	// variable aliases
-	AuctionByTimeKeyPrefix     = types.AuctionByTimeKeyPrefix
-	AuctionKeyPrefix           = types.AuctionKeyPrefix
-	DefaultIncrement           = types.DefaultIncrement
-	DistantFuture              = types.DistantFuture
-	ErrAuctionHasExpired       = types.ErrAuctionHasExpired
-	ErrAuctionHasNotExpired    = types.ErrAuctionHasNotExpired
-	ErrAuctionNotFound         = types.ErrAuctionNotFound
-	ErrBidTooLarge             = types.ErrBidTooLarge
-	ErrBidTooSmall             = types.ErrBidTooSmall
-	ErrInvalidBidDenom         = types.ErrInvalidBidDenom
-	ErrInvalidInitialAuctionID = types.ErrInvalidInitialAuctionID
-	ErrInvalidLotDenom         = types.ErrInvalidLotDenom
-	ErrLotTooLarge             = types.ErrLotTooLarge
-	ErrLotTooSmall             = types.ErrLotTooSmall
-	ErrUnrecognizedAuctionType = types.ErrUnrecognizedAuctionType
-	KeyBidDuration             = types.KeyBidDuration
-	KeyIncrementCollateral     = types.KeyIncrementCollateral
-	KeyIncrementDebt           = types.KeyIncrementDebt
-	KeyIncrementSurplus        = types.KeyIncrementSurplus
-	KeyMaxAuctionDuration      = types.KeyMaxAuctionDuration
-	ModuleCdc                  = types.ModuleCdc
-	NextAuctionIDKey           = types.NextAuctionIDKey
+	AuctionByTimeKeyPrefix      = types.AuctionByTimeKeyPrefix
+	AuctionKeyPrefix            = types.AuctionKeyPrefix
+	DefaultIncrement            = types.DefaultIncrement
+	DistantFuture               = types.DistantFuture
+	ErrAuctionHasExpired        = types.ErrAuctionHasExpired
+	ErrAuctionHasNotExpired     = types.ErrAuctionHasNotExpired
+	ErrAuctionNotFound          = types.ErrAuctionNotFound
+	ErrBidTooLarge              = types.ErrBidTooLarge
+	ErrBidTooSmall              = types.ErrBidTooSmall
+	ErrCommitPeriodEnded        = types.ErrCommitPeriodEnded
+	ErrDuplicateSealedBid       = types.ErrDuplicateSealedBid
+	ErrInvalidBidDenom          = types.ErrInvalidBidDenom
+	ErrInvalidInitialAuctionID  = types.ErrInvalidInitialAuctionID
+	ErrInvalidLotDenom          = types.ErrInvalidLotDenom
+	ErrInvalidReveal            = types.ErrInvalidReveal
+	ErrLotTooLarge              = types.ErrLotTooLarge
+	ErrLotTooSmall              = types.ErrLotTooSmall
+	ErrNotRevealPeriod          = types.ErrNotRevealPeriod
+	ErrNotSealedBidAuction      = types.ErrNotSealedBidAuction
+	ErrSealedBidAlreadyRevealed = types.ErrSealedBidAlreadyRevealed
+	ErrSealedBidAuction         = types.ErrSealedBidAuction
+	ErrSealedBidNotFound        = types.ErrSealedBidNotFound
+	ErrUnrecognizedAuctionType  = types.ErrUnrecognizedAuctionType
+	KeyBidDuration              = types.KeyBidDuration
+	KeyDenomParams              = types.KeyDenomParams
+	KeyIncrementCollateral      = types.KeyIncrementCollateral
+	KeyIncrementDebt            = types.KeyIncrementDebt
+	KeyIncrementSurplus         = types.KeyIncrementSurplus
+	KeyMaxAuctionDuration       = types.KeyMaxAuctionDuration
+	KeySealedBidCommitDuration  = types.KeySealedBidCommitDuration
+	KeySealedBidPricingRule     = types.KeySealedBidPricingRule
+	KeySealedBidRevealDuration  = types.KeySealedBidRevealDuration
+	ModuleCdc                   = types.ModuleCdc
+	NextAuctionIDKey            = types.NextAuctionIDKey
 )
 
 type (
-	Keeper                = keeper.Keeper
-	Auction               = types.Auction
-	AuctionWithPhase      = types.AuctionWithPhase
-	Auctions              = types.Auctions
-	BaseAuction           = types.BaseAuction
-	CollateralAuction     = types.CollateralAuction
-	DebtAuction           = types.DebtAuction
-	GenesisAuction        = types.GenesisAuction
-	GenesisAuctions       = types.GenesisAuctions
-	GenesisState          = types.GenesisState
-	MsgPlaceBid           = types.MsgPlaceBid
-	Params                = types.Params
-	QueryAllAuctionParams = types.QueryAllAuctionParams
-	QueryAuctionParams    = types.QueryAuctionParams
-	SupplyKeeper          = types.SupplyKeeper
-	SurplusAuction        = types.SurplusAuction
-	WeightedAddresses     = types.WeightedAddresses
+	Auction                 = types.Auction
+	AuctionWithPhase        = types.AuctionWithPhase
+	Auctions                = types.Auctions
+	BaseAuction             = types.BaseAuction
+	CollateralAuction       = types.CollateralAuction
+	DebtAuction             = types.DebtAuction
+	DenomParam              = types.DenomParam
+	DenomParams             = types.DenomParams
+	GenesisAuction          = types.GenesisAuction
+	GenesisAuctions         = types.GenesisAuctions
+	GenesisState            = types.GenesisState
+	Keeper                  = keeper.Keeper
+	MsgCommitBid            = types.MsgCommitBid
+	MsgPlaceBid             = types.MsgPlaceBid
+	MsgRevealBid            = types.MsgRevealBid
+	Params                  = types.Params
+	QueryAllAuctionParams   = types.QueryAllAuctionParams
+	QueryAuctionParams      = types.QueryAuctionParams
+	SealedBid               = types.SealedBid
+	SealedBidSurplusAuction = types.SealedBidSurplusAuction
+	SealedBids              = types.SealedBids
+	SupplyKeeper            = types.SupplyKeeper
+	SurplusAuction          = types.SurplusAuction
+	WeightedAddresses       = types.WeightedAddresses
 )
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"bufio"
+	"encoding/hex"
 	"fmt"
 	"strconv"
 	"strings"
@@ -28,6 +29,8 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	auctionTxCmd.AddCommand(flags.PostCommands(
 		GetCmdPlaceBid(cdc),
+		GetCmdCommitBid(cdc),
+		GetCmdRevealBid(cdc),
 	)...)
 
 	return auctionTxCmd
@@ -69,3 +72,99 @@ $ %s tx %s bid 34 1000usdx --from myKeyName
 		},
 	}
 }
+
+// GetCmdCommitBid cli command for committing sealed bids on sealed bid auctions
+func GetCmdCommitBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "commit-bid [auction-id] [amount] [deposit]",
+		Short: "commit a sealed bid on a sealed bid auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit a sealed bid of [amount] on a sealed bid auction, escrowing [deposit] until the auction closes.
+The deposit must be at least the bid amount. A random salt is generated to blind the bid and must be kept to reveal the bid later.
+
+Example:
+$ %s tx %s commit-bid 34 1000ukava 1500ukava --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			amt, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoin(args[2])
+			if err != nil {
+				return err
+			}
+
+			salt, err := types.GenerateSealedBidSalt()
+			if err != nil {
+				return err
+			}
+
+			bidHash := types.CalculateSealedBidHash(id, cliCtx.GetFromAddress(), amt, salt)
+
+			// Print salt and hash to user's console
+			fmt.Printf("\nSalt: %s\n", hex.EncodeToString(salt))
+			fmt.Printf("Bid hash: %s\n\n", hex.EncodeToString(bidHash))
+
+			msg := types.NewMsgCommitBid(id, cliCtx.GetFromAddress(), bidHash, deposit)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRevealBid cli command for revealing sealed bids on sealed bid auctions
+func GetCmdRevealBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reveal-bid [auction-id] [amount] [salt]",
+		Short: "reveal a sealed bid on a sealed bid auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal a sealed bid previously committed with commit-bid. Bids that are not revealed before the auction ends forfeit their deposit.
+
+Example:
+$ %s tx %s reveal-bid 34 1000ukava 56f13e6a5cd397447f8b5f8c82fdb5bbf56127db75269f5cc14e50acd8ac9a4c --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			amt, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			salt, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealBid(id, cliCtx.GetFromAddress(), amt, salt)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
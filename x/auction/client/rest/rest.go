@@ -3,6 +3,8 @@ package rest
 import (
 	"github.com/gorilla/mux"
 
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
 	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
@@ -28,3 +30,17 @@ type placeBidReq struct {
 	BaseReq rest.BaseReq `json:"base_req"`
 	Amount  sdk.Coin     `json:"amount"`
 }
+
+// commitBidReq defines the properties of a sealed bid commit request's body
+type commitBidReq struct {
+	BaseReq rest.BaseReq     `json:"base_req"`
+	BidHash tmbytes.HexBytes `json:"bid_hash"`
+	Deposit sdk.Coin         `json:"deposit"`
+}
+
+// revealBidReq defines the properties of a sealed bid reveal request's body
+type revealBidReq struct {
+	BaseReq rest.BaseReq     `json:"base_req"`
+	Amount  sdk.Coin         `json:"amount"`
+	Salt    tmbytes.HexBytes `json:"salt"`
+}
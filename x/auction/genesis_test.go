@@ -24,6 +24,7 @@ var testAuction = auction.NewCollateralAuction(
 	c("biddenom", 1000),
 	auction.WeightedAddresses{Addresses: testAddrs, Weights: []sdk.Int{sdk.OneInt(), sdk.OneInt()}},
 	c("debt", 1000),
+	auction.DefaultParams().ForDenom("lotdenom"),
 ).WithID(3).(auction.GenesisAuction)
 
 func TestInitGenesis(t *testing.T) {
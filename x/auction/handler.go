@@ -15,6 +15,10 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgPlaceBid:
 			return handleMsgPlaceBid(ctx, keeper, msg)
+		case MsgCommitBid:
+			return handleMsgCommitBid(ctx, keeper, msg)
+		case MsgRevealBid:
+			return handleMsgRevealBid(ctx, keeper, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -40,3 +44,43 @@ func handleMsgPlaceBid(ctx sdk.Context, keeper Keeper, msg MsgPlaceBid) (*sdk.Re
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+func handleMsgCommitBid(ctx sdk.Context, keeper Keeper, msg MsgCommitBid) (*sdk.Result, error) {
+
+	err := keeper.CommitBid(ctx, msg.AuctionID, msg.Bidder, msg.BidHash, msg.Deposit)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgRevealBid(ctx sdk.Context, keeper Keeper, msg MsgRevealBid) (*sdk.Result, error) {
+
+	err := keeper.RevealBid(ctx, msg.AuctionID, msg.Bidder, msg.Amount, msg.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+	)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
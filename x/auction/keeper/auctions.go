@@ -19,6 +19,7 @@ func (k Keeper) StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin
 		lot,
 		bidDenom,
 		types.DistantFuture,
+		k.GetDenomParams(ctx, lot.Denom),
 	)
 
 	// NOTE: for the duration of the auction the auction module account holds the lot
@@ -44,6 +45,51 @@ func (k Keeper) StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin
 	return auctionID, nil
 }
 
+// StartSealedBidSurplusAuction starts a new sealed bid surplus (forward) auction.
+// The commit and reveal periods and pricing rule are taken from the current params.
+func (k Keeper) StartSealedBidSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin, bidDenom string) (uint64, error) {
+	params := k.GetParams(ctx)
+	revealStartTime := ctx.BlockTime().Add(params.SealedBidCommitDuration)
+	auction := types.NewSealedBidSurplusAuction(
+		seller,
+		lot,
+		bidDenom,
+		revealStartTime,
+		revealStartTime.Add(params.SealedBidRevealDuration),
+		params.SealedBidPricingRule,
+	)
+
+	// This auction type burns proceeds at close. Need to check module account has burning privileges to avoid potential err in endblocker.
+	macc := k.supplyKeeper.GetModuleAccount(ctx, seller)
+	if !macc.HasPermission(supply.Burner) {
+		panic(fmt.Errorf("module '%s' does not have '%s' permission", seller, supply.Burner))
+	}
+
+	// NOTE: for the duration of the auction the auction module account holds the lot and any sealed bid deposits
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
+	if err != nil {
+		return 0, err
+	}
+
+	auctionID, err := k.StoreNewAuction(ctx, auction)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionStart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.GetType()),
+			sdk.NewAttribute(types.AttributeKeyBid, auction.Bid.String()),
+			sdk.NewAttribute(types.AttributeKeyLot, auction.Lot.String()),
+			sdk.NewAttribute(types.AttributeKeyRevealStart, fmt.Sprintf("%d", auction.RevealStartTime.Unix())),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", auction.EndTime.Unix())),
+		),
+	)
+	return auctionID, nil
+}
+
 // StartDebtAuction starts a new debt (reverse) auction.
 func (k Keeper) StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, initialLot sdk.Coin, debt sdk.Coin) (uint64, error) {
 
@@ -53,6 +99,7 @@ func (k Keeper) StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, in
 		initialLot,
 		types.DistantFuture,
 		debt,
+		k.GetDenomParams(ctx, initialLot.Denom),
 	)
 
 	// This auction type mints coins at close. Need to check module account has minting privileges to avoid potential err in endblocker.
@@ -100,6 +147,7 @@ func (k Keeper) StartCollateralAuction(
 		maxBid,
 		weightedAddresses,
 		debt,
+		k.GetDenomParams(ctx, lot.Denom),
 	)
 
 	// NOTE: for the duration of the auction the auction module account holds the debt and the lot
@@ -159,6 +207,8 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		} else {
 			updatedAuction, err = k.PlaceReverseBidCollateral(ctx, auctionType, bidder, newAmount)
 		}
+	case types.SealedBidSurplusAuction:
+		err = sdkerrors.Wrapf(types.ErrSealedBidAuction, "%d", auctionID)
 	default:
 		err = sdkerrors.Wrap(types.ErrUnrecognizedAuctionType, auction.GetType())
 	}
@@ -181,7 +231,7 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction types.SurplusAuction, b
 	minNewBidAmt := auction.Bid.Amount.Add( // new bids must be some % greater than old bid, and at least 1 larger to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdk.NewInt(1),
-			sdk.NewDecFromInt(auction.Bid.Amount).Mul(k.GetParams(ctx).IncrementSurplus).RoundInt(),
+			sdk.NewDecFromInt(auction.Bid.Amount).Mul(auction.Params.IncrementSurplus).RoundInt(),
 		),
 	)
 	if bid.Amount.LT(minNewBidAmt) {
@@ -214,10 +264,10 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, auction types.SurplusAuction, b
 	auction.Bidder = bidder
 	auction.Bid = bid
 	if !auction.HasReceivedBids {
-		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.MaxEndTime = ctx.BlockTime().Add(auction.Params.MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = earliestTime(ctx.BlockTime().Add(auction.Params.BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -244,7 +294,7 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction types.Collate
 	minNewBidAmt := auction.Bid.Amount.Add( // new bids must be some % greater than old bid, and at least 1 larger to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdk.NewInt(1),
-			sdk.NewDecFromInt(auction.Bid.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
+			sdk.NewDecFromInt(auction.Bid.Amount).Mul(auction.Params.IncrementCollateral).RoundInt(),
 		),
 	)
 	minNewBidAmt = sdk.MinInt(minNewBidAmt, auction.MaxBid.Amount) // allow new bids to hit MaxBid even though it may be less than the increment %
@@ -290,10 +340,10 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, auction types.Collate
 	auction.Bidder = bidder
 	auction.Bid = bid
 	if !auction.HasReceivedBids {
-		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.MaxEndTime = ctx.BlockTime().Add(auction.Params.MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = earliestTime(ctx.BlockTime().Add(auction.Params.BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -320,7 +370,7 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction types.Collate
 	maxNewLotAmt := auction.Lot.Amount.Sub( // new lot must be some % less than old lot, and at least 1 smaller to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdk.NewInt(1),
-			sdk.NewDecFromInt(auction.Lot.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
+			sdk.NewDecFromInt(auction.Lot.Amount).Mul(auction.Params.IncrementCollateral).RoundInt(),
 		),
 	)
 	if lot.Amount.GT(maxNewLotAmt) {
@@ -364,10 +414,10 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, auction types.Collate
 	auction.Bidder = bidder
 	auction.Lot = lot
 	if !auction.HasReceivedBids {
-		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.MaxEndTime = ctx.BlockTime().Add(auction.Params.MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = earliestTime(ctx.BlockTime().Add(auction.Params.BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -391,7 +441,7 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction types.DebtAuction, bidder
 	maxNewLotAmt := auction.Lot.Amount.Sub( // new lot must be some % less than old lot, and at least 1 smaller to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdk.NewInt(1),
-			sdk.NewDecFromInt(auction.Lot.Amount).Mul(k.GetParams(ctx).IncrementDebt).RoundInt(),
+			sdk.NewDecFromInt(auction.Lot.Amount).Mul(auction.Params.IncrementDebt).RoundInt(),
 		),
 	)
 	if lot.Amount.GT(maxNewLotAmt) {
@@ -430,10 +480,10 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction types.DebtAuction, bidder
 	auction.Bidder = bidder
 	auction.Lot = lot
 	if !auction.HasReceivedBids {
-		auction.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		auction.MaxEndTime = ctx.BlockTime().Add(auction.Params.MaxAuctionDuration) // set maximum ending time on receipt of first bid
 		auction.HasReceivedBids = true
 	}
-	auction.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
+	auction.EndTime = earliestTime(ctx.BlockTime().Add(auction.Params.BidDuration), auction.MaxEndTime) // increment timeout, up to MaxEndTime
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -448,6 +498,99 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, auction types.DebtAuction, bidder
 	return auction, nil
 }
 
+// CommitBid commits a sealed bid to a sealed bid auction, escrowing the deposit in the auction module account.
+func (k Keeper) CommitBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, bidHash []byte, deposit sdk.Coin) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+	}
+	sealedAuction, ok := auction.(types.SealedBidSurplusAuction)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrNotSealedBidAuction, "%d", auctionID)
+	}
+	if !sealedAuction.IsCommitPeriod(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrCommitPeriodEnded, "%d", auctionID)
+	}
+	if deposit.Denom != sealedAuction.Bid.Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidBidDenom, "%s ≠ %s", deposit.Denom, sealedAuction.Bid.Denom)
+	}
+	if _, _, found := sealedAuction.GetSealedBid(bidder); found {
+		return sdkerrors.Wrapf(types.ErrDuplicateSealedBid, "%s", bidder)
+	}
+
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(deposit))
+	if err != nil {
+		return err
+	}
+
+	sealedAuction.SealedBids = append(sealedAuction.SealedBids, types.NewSealedBid(bidder, bidHash, deposit))
+	sealedAuction.HasReceivedBids = true
+	k.SetAuction(ctx, sealedAuction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionCommit,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBidHash, fmt.Sprintf("%X", bidHash)),
+			sdk.NewAttribute(types.AttributeKeyDeposit, deposit.String()),
+		),
+	)
+	return nil
+}
+
+// RevealBid reveals a previously committed sealed bid. The bid must match the commitment and be covered by the deposit.
+// The highest revealed bid so far is recorded as the auction's bid.
+func (k Keeper) RevealBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, bid sdk.Coin, salt []byte) error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrAuctionNotFound, "%d", auctionID)
+	}
+	sealedAuction, ok := auction.(types.SealedBidSurplusAuction)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrNotSealedBidAuction, "%d", auctionID)
+	}
+	if !sealedAuction.IsRevealPeriod(ctx.BlockTime()) {
+		return sdkerrors.Wrapf(types.ErrNotRevealPeriod, "block time %s, reveal period %s to %s", ctx.BlockTime().UTC(), sealedAuction.RevealStartTime.UTC(), sealedAuction.EndTime.UTC())
+	}
+	sealedBid, index, found := sealedAuction.GetSealedBid(bidder)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrSealedBidNotFound, "%s", bidder)
+	}
+	if sealedBid.Revealed {
+		return sdkerrors.Wrapf(types.ErrSealedBidAlreadyRevealed, "%s", bidder)
+	}
+	if bid.Denom != sealedAuction.Bid.Denom {
+		return sdkerrors.Wrapf(types.ErrInvalidBidDenom, "%s ≠ %s", bid.Denom, sealedAuction.Bid.Denom)
+	}
+	if !sealedBid.MatchesHash(auctionID, bid, salt) {
+		return sdkerrors.Wrapf(types.ErrInvalidReveal, "%s", bidder)
+	}
+	if sealedBid.Deposit.IsLT(bid) {
+		return sdkerrors.Wrapf(types.ErrBidTooLarge, "%s > deposit %s", bid, sealedBid.Deposit)
+	}
+
+	sealedBid.Revealed = true
+	sealedBid.Amount = bid
+	sealedAuction.SealedBids[index] = sealedBid
+
+	// ties are won by the earliest commitment
+	leader := sealedAuction.SealedBids.Revealed()[0]
+	sealedAuction.Bidder = leader.Bidder
+	sealedAuction.Bid = leader.Amount
+	k.SetAuction(ctx, sealedAuction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionReveal,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBid, bid.String()),
+		),
+	)
+	return nil
+}
+
 // CloseAuction closes an auction and distributes funds to the highest bidder.
 func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 	auction, found := k.GetAuction(ctx, auctionID)
@@ -468,6 +611,8 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) error {
 		err = k.PayoutDebtAuction(ctx, auc)
 	case types.CollateralAuction:
 		err = k.PayoutCollateralAuction(ctx, auc)
+	case types.SealedBidSurplusAuction:
+		err = k.PayoutSealedBidSurplusAuction(ctx, auc)
 	default:
 		err = sdkerrors.Wrap(types.ErrUnrecognizedAuctionType, auc.GetType())
 	}
@@ -530,6 +675,60 @@ func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, auction types.Collatera
 	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.CorrespondingDebt))
 }
 
+// PayoutSealedBidSurplusAuction pays out the proceeds for a sealed bid surplus auction.
+// The highest revealed bid wins the lot and the price it pays is burned. Other revealed bids are refunded.
+// Deposits for bids that were never revealed are forfeited and burned.
+// If no bids were revealed the lot is returned to the initiator.
+func (k Keeper) PayoutSealedBidSurplusAuction(ctx sdk.Context, auction types.SealedBidSurplusAuction) error {
+	forfeited := auction.SealedBids.Unrevealed().TotalDeposits()
+	if !forfeited.IsZero() {
+		if err := k.burnAuctionProceeds(ctx, auction.Initiator, forfeited); err != nil {
+			return err
+		}
+	}
+
+	revealed := auction.SealedBids.Revealed()
+	if len(revealed) == 0 {
+		return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auction.Initiator, sdk.NewCoins(auction.Lot))
+	}
+
+	winner := revealed[0]
+	price := winner.Amount
+	if auction.PricingRule == types.SecondPriceSealedBid && len(revealed) > 1 {
+		price = revealed[1].Amount
+	}
+
+	if err := k.burnAuctionProceeds(ctx, auction.Initiator, sdk.NewCoins(price)); err != nil {
+		return err
+	}
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, winner.Bidder, sdk.NewCoins(auction.Lot)); err != nil {
+		return err
+	}
+
+	// refund the unused part of the winner's deposit, and the full deposits of the other revealed bids
+	refunds := append(types.SealedBids{}, revealed...)
+	refunds[0].Deposit = winner.Deposit.Sub(price)
+	for _, b := range refunds {
+		// if the refund amount is 0, don't send 0 coins
+		if !b.Deposit.IsPositive() {
+			continue
+		}
+		if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, b.Bidder, sdk.NewCoins(b.Deposit)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// burnAuctionProceeds moves coins from the auction module account to the initiator and burns them.
+func (k Keeper) burnAuctionProceeds(ctx sdk.Context, initiator string, coins sdk.Coins) error {
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, initiator, coins)
+	if err != nil {
+		return err
+	}
+	return k.supplyKeeper.BurnCoins(ctx, initiator, coins)
+}
+
 // CloseExpiredAuctions iterates over all the auctions stored by until the current
 // block timestamp and that are past (or at) their ending times and closes them,
 // paying out to the highest bidder.
@@ -62,6 +62,167 @@ func TestSurplusAuctionBasic(t *testing.T) {
 	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 120), c("token2", 80)))
 }
 
+func TestSurplusAuctionDenomParams(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	buyer := addrs[0]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner) // forward auctions burn proceeds
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Override the bid duration and surplus increment for auctions of token1
+	params := keeper.GetParams(ctx)
+	params.DenomParams = types.DenomParams{
+		types.NewDenomParam("token1", 2*time.Hour, 10*time.Minute, sdk.MustNewDecFromStr("0.5"), types.DefaultIncrement, types.DefaultIncrement),
+	}
+	keeper.SetParams(ctx, params)
+
+	auctionID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2") // lot, bid denom
+	require.NoError(t, err)
+
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 10)))
+	auction, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(10*time.Minute), auction.GetEndTime())
+
+	// Param changes after the auction started do not apply to it
+	params.DenomParams = nil
+	keeper.SetParams(ctx, params)
+
+	// New bids must be 50% larger than the previous bid
+	require.Error(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 14)))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 15)))
+	auction, found = keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(10*time.Minute), auction.GetEndTime())
+}
+
+func TestSealedBidSurplusAuctionBasic(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	buyer1, buyer2, buyer3 := addrs[0], addrs[1], addrs[2]
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+	auctionAddr := supply.NewModuleAddress(types.ModuleName)
+
+	tApp := app.NewTestApp()
+
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner) // forward auctions burn proceeds
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer1, cs(c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(buyer2, cs(c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(buyer3, cs(c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{}).WithBlockTime(time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC))
+	keeper := tApp.GetAuctionKeeper()
+
+	// Create an auction (lot: 20 token1, bid denom: token2)
+	auctionID, err := keeper.StartSealedBidSurplusAuction(ctx, sellerModName, c("token1", 20), "token2")
+	require.NoError(t, err)
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100)))
+
+	// Open bids are not accepted
+	require.Error(t, keeper.PlaceBid(ctx, auctionID, buyer1, c("token2", 10)))
+
+	// Commit sealed bids, escrowing deposits
+	salt1, salt2, salt3 := make([]byte, 32), make([]byte, 32), make([]byte, 32)
+	salt1[0], salt2[0], salt3[0] = 1, 2, 3
+	require.NoError(t, keeper.CommitBid(ctx, auctionID, buyer1, types.CalculateSealedBidHash(auctionID, buyer1, c("token2", 30), salt1), c("token2", 40)))
+	require.NoError(t, keeper.CommitBid(ctx, auctionID, buyer2, types.CalculateSealedBidHash(auctionID, buyer2, c("token2", 25), salt2), c("token2", 25)))
+	require.NoError(t, keeper.CommitBid(ctx, auctionID, buyer3, types.CalculateSealedBidHash(auctionID, buyer3, c("token2", 10), salt3), c("token2", 10)))
+	require.Error(t, keeper.CommitBid(ctx, auctionID, buyer3, types.CalculateSealedBidHash(auctionID, buyer3, c("token2", 10), salt3), c("token2", 10)))
+	tApp.CheckBalance(t, ctx, buyer1, cs(c("token2", 60)))
+	tApp.CheckBalance(t, ctx, auctionAddr, cs(c("token1", 20), c("token2", 75)))
+
+	// Bids cannot be revealed during the commit period
+	require.Error(t, keeper.RevealBid(ctx, auctionID, buyer1, c("token2", 30), salt1))
+
+	// Move to the reveal period, where new commitments are rejected
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultSealedBidCommitDuration))
+	_, addrs = app.GeneratePrivKeyAddressPairs(4)
+	require.Error(t, keeper.CommitBid(ctx, auctionID, addrs[3], types.CalculateSealedBidHash(auctionID, addrs[3], c("token2", 10), salt3), c("token2", 10)))
+
+	// Reveals must match the commitment
+	require.Error(t, keeper.RevealBid(ctx, auctionID, buyer1, c("token2", 31), salt1))
+	require.Error(t, keeper.RevealBid(ctx, auctionID, buyer1, c("token2", 30), salt2))
+	require.NoError(t, keeper.RevealBid(ctx, auctionID, buyer2, c("token2", 25), salt2))
+	require.NoError(t, keeper.RevealBid(ctx, auctionID, buyer1, c("token2", 30), salt1))
+	require.Error(t, keeper.RevealBid(ctx, auctionID, buyer1, c("token2", 30), salt1))
+
+	auction, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, buyer1, auction.GetBidder())
+	require.Equal(t, c("token2", 30), auction.GetBid())
+
+	// Auction cannot close before the reveal period ends
+	require.Error(t, keeper.CloseAuction(ctx, auctionID))
+
+	// Close auction at the end of the reveal period, where bids can no longer be revealed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultSealedBidRevealDuration))
+	require.Error(t, keeper.RevealBid(ctx, auctionID, buyer3, c("token2", 10), salt3))
+	require.NoError(t, keeper.CloseExpiredAuctions(ctx))
+	_, found = keeper.GetAuction(ctx, auctionID)
+	require.False(t, found)
+
+	// Winner receives the lot and pays the second price, the other revealed bid is refunded and the unrevealed deposit is forfeited
+	tApp.CheckBalance(t, ctx, buyer1, cs(c("token1", 20), c("token2", 75)))
+	tApp.CheckBalance(t, ctx, buyer2, cs(c("token2", 100)))
+	tApp.CheckBalance(t, ctx, buyer3, cs(c("token2", 90)))
+	// proceeds are burned
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100)))
+	require.True(t, tApp.GetSupplyKeeper().GetModuleAccount(ctx, types.ModuleName).GetCoins().IsZero())
+}
+
+func TestSealedBidSurplusAuctionNoReveals(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	buyer := addrs[0]
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{}).WithBlockTime(time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC))
+	keeper := tApp.GetAuctionKeeper()
+
+	auctionID, err := keeper.StartSealedBidSurplusAuction(ctx, sellerModName, c("token1", 20), "token2")
+	require.NoError(t, err)
+
+	salt := make([]byte, 32)
+	require.NoError(t, keeper.CommitBid(ctx, auctionID, buyer, types.CalculateSealedBidHash(auctionID, buyer, c("token2", 30), salt), c("token2", 40)))
+
+	// Close auction without revealing
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultSealedBidCommitDuration).Add(types.DefaultSealedBidRevealDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+
+	// Lot is returned to the seller and the deposit is burned
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token2", 60)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 100), c("token2", 100)))
+}
+
 func TestDebtAuctionBasic(t *testing.T) {
 	// Setup
 	_, addrs := app.GeneratePrivKeyAddressPairs(1)
@@ -341,7 +502,9 @@ func TestStartSurplusAuction(t *testing.T) {
 					HasReceivedBids: false,
 					EndTime:         types.DistantFuture,
 					MaxEndTime:      types.DistantFuture,
-				}})
+				},
+					Params: keeper.GetDenomParams(ctx, tc.args.lot.Denom),
+				})
 				require.Equal(t, expectedAuction, actualAuc, tc.name)
 			} else if !tc.expPanic && !tc.expectPass {
 				require.Error(t, err, tc.name)
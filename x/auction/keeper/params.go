@@ -14,3 +14,8 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// GetDenomParams returns the auction durations and increments for auctions with a lot of the given denom.
+func (k Keeper) GetDenomParams(ctx sdk.Context, denom string) types.DenomParam {
+	return k.GetParams(ctx).ForDenom(denom)
+}
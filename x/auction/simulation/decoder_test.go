@@ -26,7 +26,7 @@ func TestDecodeDistributionStore(t *testing.T) {
 	cdc := makeTestCodec()
 
 	oneCoin := sdk.NewCoin("coin", sdk.OneInt())
-	auction := types.NewSurplusAuction("me", oneCoin, "coin", time.Now().UTC())
+	auction := types.NewSurplusAuction("me", oneCoin, "coin", time.Now().UTC(), types.DefaultParams().ForDenom(oneCoin.Denom))
 
 	kvPairs := kv.Pairs{
 		kv.Pair{Key: types.AuctionKeyPrefix, Value: cdc.MustMarshalBinaryLengthPrefixed(&auction)},
@@ -60,6 +60,10 @@ func RandomizedGenState(simState *module.SimulationState) {
 		GenIncrementSurplus(simState.Rand),
 		GenIncrementDebt(simState.Rand),
 		GenIncrementCollateral(simState.Rand),
+		types.DefaultSealedBidCommitDuration,
+		types.DefaultSealedBidRevealDuration,
+		types.DefaultSealedBidPricingRule,
+		nil,
 	)
 	if err := p.Validate(); err != nil {
 		panic(err)
@@ -78,6 +82,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			sdk.NewInt64Coin("ukava", 1000000000000),
 			simState.GenTimestamp.Add(time.Hour*5),
 			sdk.NewInt64Coin("debt", 100), // same as usdx
+			p.ForDenom("ukava"),
 		),
 	}
 	var startingID = auctionGenesis.NextAuctionID
@@ -72,10 +72,9 @@ func SimulateMsgPlaceBid(ak auth.AccountKeeper, keeper keeper.Keeper) simulation
 
 		// search through auctions and accounts to find a pair where a bid can be placed (ie account has enough coins to place bid on auction)
 		blockTime := ctx.BlockHeader().Time
-		params := keeper.GetParams(ctx)
 		bidder, openAuction, found := findValidAccountAuctionPair(accs, openAuctions, func(acc simulation.Account, auc types.Auction) bool {
 			account := ak.GetAccount(ctx, acc.Address)
-			_, err := generateBidAmount(r, params, auc, account, blockTime)
+			_, err := generateBidAmount(r, auc, account, blockTime)
 			if err == errorNotEnoughCoins || err == errorCantReceiveBids {
 				return false // keep searching
 			} else if err != nil {
@@ -93,7 +92,7 @@ func SimulateMsgPlaceBid(ak auth.AccountKeeper, keeper keeper.Keeper) simulation
 		}
 
 		// pick a bid amount for the chosen auction and bidder
-		amount, err := generateBidAmount(r, params, openAuction, bidderAcc, blockTime)
+		amount, err := generateBidAmount(r, openAuction, bidderAcc, blockTime)
 		if err != nil { // shouldn't happen given the checks above
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
@@ -121,7 +120,7 @@ func SimulateMsgPlaceBid(ak auth.AccountKeeper, keeper keeper.Keeper) simulation
 }
 
 func generateBidAmount(
-	r *rand.Rand, params types.Params, auc types.Auction,
+	r *rand.Rand, auc types.Auction,
 	bidder authexported.Account, blockTime time.Time) (sdk.Coin, error) {
 	bidderBalance := bidder.SpendableCoins(blockTime)
 
@@ -140,7 +139,7 @@ func generateBidAmount(
 		maxNewLotAmt := a.Lot.Amount.Sub( // new lot must be some % less than old lot, and at least 1 smaller to avoid replacing an old bid at no cost
 			sdk.MaxInt(
 				sdk.NewInt(1),
-				sdk.NewDecFromInt(a.Lot.Amount).Mul(params.IncrementDebt).RoundInt(),
+				sdk.NewDecFromInt(a.Lot.Amount).Mul(a.Params.IncrementDebt).RoundInt(),
 			),
 		)
 		amt, err := RandIntInclusive(r, sdk.ZeroInt(), maxNewLotAmt) // maxNewLotAmt shouldn't be < 0 given the check above
@@ -154,7 +153,7 @@ func generateBidAmount(
 		minNewBidAmt := a.Bid.Amount.Add( // new bids must be some % greater than old bid, and at least 1 larger to avoid replacing an old bid at no cost
 			sdk.MaxInt(
 				sdk.NewInt(1),
-				sdk.NewDecFromInt(a.Bid.Amount).Mul(params.IncrementSurplus).RoundInt(),
+				sdk.NewDecFromInt(a.Bid.Amount).Mul(a.Params.IncrementSurplus).RoundInt(),
 			),
 		)
 		if bidderBalance.AmountOf(a.Bid.Denom).LT(minNewBidAmt) { // gov coin
@@ -172,7 +171,7 @@ func generateBidAmount(
 		minNewBidAmt := a.Bid.Amount.Add( // new bids must be some % greater than old bid, and at least 1 larger to avoid replacing an old bid at no cost
 			sdk.MaxInt(
 				sdk.NewInt(1),
-				sdk.NewDecFromInt(a.Bid.Amount).Mul(params.IncrementCollateral).RoundInt(),
+				sdk.NewDecFromInt(a.Bid.Amount).Mul(a.Params.IncrementCollateral).RoundInt(),
 			),
 		)
 		minNewBidAmt = sdk.MinInt(minNewBidAmt, a.MaxBid.Amount) // allow new bids to hit MaxBid even though it may be less than the increment %
@@ -188,7 +187,7 @@ func generateBidAmount(
 			maxNewLotAmt := a.Lot.Amount.Sub( // new lot must be some % less than old lot, and at least 1 smaller to avoid replacing an old bid at no cost
 				sdk.MaxInt(
 					sdk.NewInt(1),
-					sdk.NewDecFromInt(a.Lot.Amount).Mul(params.IncrementCollateral).RoundInt(),
+					sdk.NewDecFromInt(a.Lot.Amount).Mul(a.Params.IncrementCollateral).RoundInt(),
 				),
 			)
 			amt, err := RandIntInclusive(r, sdk.ZeroInt(), maxNewLotAmt) // maxNewLotAmt shouldn't be < 0 given the check above
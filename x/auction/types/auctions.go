@@ -1,13 +1,18 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 const (
@@ -16,6 +21,11 @@ const (
 	DebtAuctionType       = "debt"
 	ForwardAuctionPhase   = "forward"
 	ReverseAuctionPhase   = "reverse"
+
+	SealedBidSurplusAuctionType = "sealed_bid_surplus"
+
+	FirstPriceSealedBid  = "first_price"  // the winning sealed bidder pays their own bid
+	SecondPriceSealedBid = "second_price" // the winning sealed bidder pays the second highest revealed bid
 )
 
 // DistantFuture is a very large time value to use as initial the ending time for auctions.
@@ -117,6 +127,8 @@ func (a BaseAuction) String() string {
 // It is normally used to sell off excess pegged asset acquired by the CDP system.
 type SurplusAuction struct {
 	BaseAuction `json:"base_auction" yaml:"base_auction"`
+
+	Params DenomParam `json:"params" yaml:"params"` // Durations and increments for the auction, fixed when it is started.
 }
 
 // WithID returns an auction with the ID set.
@@ -135,27 +147,263 @@ func (a SurplusAuction) GetModuleAccountCoins() sdk.Coins {
 // GetPhase returns the direction of a surplus auction, which never changes.
 func (a SurplusAuction) GetPhase() string { return ForwardAuctionPhase }
 
+// Validate validates the SurplusAuction fields values.
+func (a SurplusAuction) Validate() error {
+	if err := validateAuctionParams(a.Params, a.Lot.Denom); err != nil {
+		return err
+	}
+	return a.BaseAuction.Validate()
+}
+
 // NewSurplusAuction returns a new surplus auction.
-func NewSurplusAuction(seller string, lot sdk.Coin, bidDenom string, endTime time.Time) SurplusAuction {
-	auction := SurplusAuction{BaseAuction{
-		// no ID
-		Initiator:       seller,
-		Lot:             lot,
-		Bidder:          nil,
-		Bid:             sdk.NewInt64Coin(bidDenom, 0),
-		HasReceivedBids: false, // new auctions don't have any bids
-		EndTime:         endTime,
-		MaxEndTime:      endTime,
-	}}
+func NewSurplusAuction(seller string, lot sdk.Coin, bidDenom string, endTime time.Time, params DenomParam) SurplusAuction {
+	auction := SurplusAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       seller,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             sdk.NewInt64Coin(bidDenom, 0),
+			HasReceivedBids: false, // new auctions don't have any bids
+			EndTime:         endTime,
+			MaxEndTime:      endTime,
+		},
+		Params: params,
+	}
+	return auction
+}
+
+// SealedBidSurplusAuction is a forward auction where bids are committed as hashes before being revealed.
+// Bidders escrow a deposit with their commitment, which caps the bid they can later reveal.
+// After EndTime the highest revealed bid wins the lot, paying a price set by the PricingRule, which is burned.
+// Deposits of bids that were never revealed are forfeited and burned.
+type SealedBidSurplusAuction struct {
+	BaseAuction `json:"base_auction" yaml:"base_auction"`
+
+	RevealStartTime time.Time  `json:"reveal_start_time" yaml:"reveal_start_time"` // Commits are accepted before this time, reveals from this time until just before EndTime.
+	PricingRule     string     `json:"pricing_rule" yaml:"pricing_rule"`           // Whether the winner pays their own bid or the second highest bid.
+	SealedBids      SealedBids `json:"sealed_bids" yaml:"sealed_bids"`
+}
+
+// WithID returns an auction with the ID set.
+func (a SealedBidSurplusAuction) WithID(id uint64) Auction { a.ID = id; return a }
+
+// GetType returns the auction type. Used to identify auctions in event attributes.
+func (a SealedBidSurplusAuction) GetType() string { return SealedBidSurplusAuctionType }
+
+// GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
+// It is used in genesis initialize the module account correctly.
+func (a SealedBidSurplusAuction) GetModuleAccountCoins() sdk.Coins {
+	// deposits are escrowed until the auction closes
+	return sdk.NewCoins(a.Lot).Add(a.SealedBids.TotalDeposits()...)
+}
+
+// GetPhase returns the direction of a sealed bid surplus auction, which never changes.
+func (a SealedBidSurplusAuction) GetPhase() string { return ForwardAuctionPhase }
+
+// IsCommitPeriod returns whether the auction accepts new sealed bids at the given time.
+func (a SealedBidSurplusAuction) IsCommitPeriod(blockTime time.Time) bool {
+	return blockTime.Before(a.RevealStartTime)
+}
+
+// IsRevealPeriod returns whether the auction accepts reveals of sealed bids at the given time.
+// The period ends before the end time, as the auction is closed in the first block at or after it.
+func (a SealedBidSurplusAuction) IsRevealPeriod(blockTime time.Time) bool {
+	return !blockTime.Before(a.RevealStartTime) && blockTime.Before(a.EndTime)
+}
+
+// GetSealedBid returns the sealed bid placed by an address, and its index in the auction's bids.
+func (a SealedBidSurplusAuction) GetSealedBid(bidder sdk.AccAddress) (SealedBid, int, bool) {
+	for i, b := range a.SealedBids {
+		if b.Bidder.Equals(bidder) {
+			return b, i, true
+		}
+	}
+	return SealedBid{}, 0, false
+}
+
+// Validate validates the SealedBidSurplusAuction fields values.
+func (a SealedBidSurplusAuction) Validate() error {
+	if err := ValidatePricingRule(a.PricingRule); err != nil {
+		return err
+	}
+	if a.RevealStartTime.Unix() <= 0 {
+		return errors.New("reveal start time cannot be zero")
+	}
+	if a.RevealStartTime.After(a.EndTime) {
+		return fmt.Errorf("EndTime < RevealStartTime (%s < %s)", a.EndTime, a.RevealStartTime)
+	}
+	if err := a.SealedBids.Validate(); err != nil {
+		return fmt.Errorf("invalid sealed bids: %w", err)
+	}
+	for _, b := range a.SealedBids {
+		if b.Deposit.Denom != a.Bid.Denom {
+			return fmt.Errorf("sealed bid deposit denom %s does not match bid denom %s", b.Deposit.Denom, a.Bid.Denom)
+		}
+	}
+	return a.BaseAuction.Validate()
+}
+
+func (a SealedBidSurplusAuction) String() string {
+	return fmt.Sprintf(`Auction %d:
+  Initiator:              %s
+  Lot:                    %s
+  Bid Denom:              %s
+  Reveal Start Time:      %s
+  End Time:               %s
+  Pricing Rule:           %s
+  Sealed Bids:            %d`,
+		a.GetID(), a.Initiator, a.Lot, a.Bid.Denom,
+		a.RevealStartTime.String(), a.GetEndTime().String(),
+		a.PricingRule, len(a.SealedBids),
+	)
+}
+
+// NewSealedBidSurplusAuction returns a new sealed bid surplus auction.
+// Unlike the open auctions, the end time is fixed when the auction is created.
+func NewSealedBidSurplusAuction(seller string, lot sdk.Coin, bidDenom string, revealStartTime, endTime time.Time, pricingRule string) SealedBidSurplusAuction {
+	auction := SealedBidSurplusAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       seller,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             sdk.NewInt64Coin(bidDenom, 0),
+			HasReceivedBids: false, // new auctions don't have any bids
+			EndTime:         endTime,
+			MaxEndTime:      endTime,
+		},
+		RevealStartTime: revealStartTime,
+		PricingRule:     pricingRule,
+		SealedBids:      SealedBids{},
+	}
 	return auction
 }
 
+// SealedBid is a commitment to a bid on a sealed bid auction, along with the escrowed deposit.
+type SealedBid struct {
+	Bidder   sdk.AccAddress   `json:"bidder" yaml:"bidder"`
+	BidHash  tmbytes.HexBytes `json:"bid_hash" yaml:"bid_hash"` // Hash of the bid, see CalculateSealedBidHash.
+	Deposit  sdk.Coin         `json:"deposit" yaml:"deposit"`   // Coins escrowed by the bidder. The revealed bid cannot be larger than this.
+	Revealed bool             `json:"revealed" yaml:"revealed"`
+	Amount   sdk.Coin         `json:"amount" yaml:"amount"` // The revealed bid. Zero until revealed.
+}
+
+// NewSealedBid returns a new unrevealed sealed bid.
+func NewSealedBid(bidder sdk.AccAddress, bidHash tmbytes.HexBytes, deposit sdk.Coin) SealedBid {
+	return SealedBid{
+		Bidder:   bidder,
+		BidHash:  bidHash,
+		Deposit:  deposit,
+		Revealed: false,
+		Amount:   sdk.NewCoin(deposit.Denom, sdk.ZeroInt()),
+	}
+}
+
+// Validate performs a basic validation of the sealed bid fields.
+func (b SealedBid) Validate() error {
+	if b.Bidder.Empty() {
+		return errors.New("bidder cannot be empty")
+	}
+	if len(b.Bidder) != sdk.AddrLen {
+		return fmt.Errorf("the expected bidder address length is %d, actual length is %d", sdk.AddrLen, len(b.Bidder))
+	}
+	if len(b.BidHash) != tmhash.Size {
+		return fmt.Errorf("the expected bid hash length is %d, actual length is %d", tmhash.Size, len(b.BidHash))
+	}
+	if !b.Deposit.IsValid() || !b.Deposit.IsPositive() {
+		return fmt.Errorf("invalid deposit: %s", b.Deposit)
+	}
+	if !b.Amount.IsValid() {
+		return fmt.Errorf("invalid amount: %s", b.Amount)
+	}
+	if b.Amount.Denom != b.Deposit.Denom {
+		return fmt.Errorf("amount denom %s does not match deposit denom %s", b.Amount.Denom, b.Deposit.Denom)
+	}
+	if b.Deposit.IsLT(b.Amount) {
+		return fmt.Errorf("amount %s is greater than deposit %s", b.Amount, b.Deposit)
+	}
+	if !b.Revealed && !b.Amount.IsZero() {
+		return fmt.Errorf("unrevealed bid cannot have an amount: %s", b.Amount)
+	}
+	return nil
+}
+
+// MatchesHash returns whether a bid and salt hash to the commitment of a sealed bid.
+func (b SealedBid) MatchesHash(auctionID uint64, bid sdk.Coin, salt []byte) bool {
+	return bytes.Equal(b.BidHash, CalculateSealedBidHash(auctionID, b.Bidder, bid, salt))
+}
+
+// SealedBids is a slice of sealed bids.
+type SealedBids []SealedBid
+
+// Validate checks each sealed bid is valid and that no bidder has more than one bid.
+func (bs SealedBids) Validate() error {
+	bidders := make(map[string]bool)
+	for _, b := range bs {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		if bidders[b.Bidder.String()] {
+			return fmt.Errorf("duplicate sealed bid from %s", b.Bidder)
+		}
+		bidders[b.Bidder.String()] = true
+	}
+	return nil
+}
+
+// TotalDeposits returns the sum of the deposits held for all the sealed bids.
+func (bs SealedBids) TotalDeposits() sdk.Coins {
+	total := sdk.NewCoins()
+	for _, b := range bs {
+		total = total.Add(b.Deposit)
+	}
+	return total
+}
+
+// Revealed returns the revealed bids ordered from highest to lowest amount.
+// Equal bids are kept in the order they were committed, so the earliest commitment wins ties.
+func (bs SealedBids) Revealed() SealedBids {
+	revealed := SealedBids{}
+	for _, b := range bs {
+		if b.Revealed {
+			revealed = append(revealed, b)
+		}
+	}
+	sort.SliceStable(revealed, func(i, j int) bool {
+		return revealed[j].Amount.IsLT(revealed[i].Amount)
+	})
+	return revealed
+}
+
+// Unrevealed returns the bids that have not been revealed.
+func (bs SealedBids) Unrevealed() SealedBids {
+	unrevealed := SealedBids{}
+	for _, b := range bs {
+		if !b.Revealed {
+			unrevealed = append(unrevealed, b)
+		}
+	}
+	return unrevealed
+}
+
+// ValidatePricingRule checks a sealed bid pricing rule is one of the known rules.
+func ValidatePricingRule(rule string) error {
+	switch rule {
+	case FirstPriceSealedBid, SecondPriceSealedBid:
+		return nil
+	default:
+		return fmt.Errorf("invalid sealed bid pricing rule: %s", rule)
+	}
+}
+
 // DebtAuction is a reverse auction that mints what it pays out.
 // It is normally used to acquire pegged asset to cover the CDP system's debts that were not covered by selling collateral.
 type DebtAuction struct {
 	BaseAuction `json:"base_auction" yaml:"base_auction"`
 
-	CorrespondingDebt sdk.Coin `json:"corresponding_debt" yaml:"corresponding_debt"`
+	CorrespondingDebt sdk.Coin   `json:"corresponding_debt" yaml:"corresponding_debt"`
+	Params            DenomParam `json:"params" yaml:"params"` // Durations and increments for the auction, fixed when it is started.
 }
 
 // WithID returns an auction with the ID set.
@@ -180,11 +428,14 @@ func (a DebtAuction) Validate() error {
 	if !a.CorrespondingDebt.IsValid() {
 		return fmt.Errorf("invalid corresponding debt: %s", a.CorrespondingDebt)
 	}
+	if err := validateAuctionParams(a.Params, a.Lot.Denom); err != nil {
+		return err
+	}
 	return a.BaseAuction.Validate()
 }
 
 // NewDebtAuction returns a new debt auction.
-func NewDebtAuction(buyerModAccName string, bid sdk.Coin, initialLot sdk.Coin, endTime time.Time, debt sdk.Coin) DebtAuction {
+func NewDebtAuction(buyerModAccName string, bid sdk.Coin, initialLot sdk.Coin, endTime time.Time, debt sdk.Coin, params DenomParam) DebtAuction {
 	// Note: Bidder is set to the initiator's module account address instead of module name. (when the first bid is placed, it is paid out to the initiator)
 	// Setting to the module account address bypasses calling supply.SendCoinsFromModuleToModule, instead calls SendCoinsFromModuleToAccount.
 	// This isn't a problem currently, but if additional logic/validation was added for sending to coins to Module Accounts, it would be bypassed.
@@ -200,6 +451,7 @@ func NewDebtAuction(buyerModAccName string, bid sdk.Coin, initialLot sdk.Coin, e
 			MaxEndTime:      endTime,
 		},
 		CorrespondingDebt: debt,
+		Params:            params,
 	}
 	return auction
 }
@@ -215,6 +467,7 @@ type CollateralAuction struct {
 	CorrespondingDebt sdk.Coin          `json:"corresponding_debt" yaml:"corresponding_debt"`
 	MaxBid            sdk.Coin          `json:"max_bid" yaml:"max_bid"`
 	LotReturns        WeightedAddresses `json:"lot_returns" yaml:"lot_returns"`
+	Params            DenomParam        `json:"params" yaml:"params"` // Durations and increments for the auction, fixed when it is started.
 }
 
 // WithID returns an auction with the ID set.
@@ -260,6 +513,9 @@ func (a CollateralAuction) Validate() error {
 	if err := a.LotReturns.Validate(); err != nil {
 		return fmt.Errorf("invalid lot returns: %w", err)
 	}
+	if err := validateAuctionParams(a.Params, a.Lot.Denom); err != nil {
+		return err
+	}
 	return a.BaseAuction.Validate()
 }
 
@@ -281,7 +537,7 @@ func (a CollateralAuction) String() string {
 }
 
 // NewCollateralAuction returns a new collateral auction.
-func NewCollateralAuction(seller string, lot sdk.Coin, endTime time.Time, maxBid sdk.Coin, lotReturns WeightedAddresses, debt sdk.Coin) CollateralAuction {
+func NewCollateralAuction(seller string, lot sdk.Coin, endTime time.Time, maxBid sdk.Coin, lotReturns WeightedAddresses, debt sdk.Coin, params DenomParam) CollateralAuction {
 	auction := CollateralAuction{
 		BaseAuction: BaseAuction{
 			// no ID
@@ -295,10 +551,22 @@ func NewCollateralAuction(seller string, lot sdk.Coin, endTime time.Time, maxBid
 		CorrespondingDebt: debt,
 		MaxBid:            maxBid,
 		LotReturns:        lotReturns,
+		Params:            params,
 	}
 	return auction
 }
 
+// validateAuctionParams checks the durations and increments fixed on an auction are valid and apply to its lot denom.
+func validateAuctionParams(params DenomParam, lotDenom string) error {
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("invalid auction params: %w", err)
+	}
+	if params.Denom != lotDenom {
+		return fmt.Errorf("auction params denom %s does not match lot denom %s", params.Denom, lotDenom)
+	}
+	return nil
+}
+
 // WeightedAddresses is a type for storing some addresses and associated weights.
 type WeightedAddresses struct {
 	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
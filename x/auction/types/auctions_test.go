@@ -235,6 +235,7 @@ func TestDebtAuctionValidate(t *testing.T) {
 					HasReceivedBids: true,
 				},
 				CorrespondingDebt: c("kava", 1),
+				Params:            DefaultParams().ForDenom("kava"),
 			},
 			true,
 		},
@@ -252,6 +253,42 @@ func TestDebtAuctionValidate(t *testing.T) {
 					HasReceivedBids: true,
 				},
 				CorrespondingDebt: sdk.Coin{Denom: "DENOM", Amount: sdk.NewInt(1)},
+				Params:            DefaultParams().ForDenom("kava"),
+			},
+			false,
+		},
+		{
+			"params for another denom",
+			DebtAuction{
+				BaseAuction: BaseAuction{
+					ID:              1,
+					Initiator:       testAccAddress1,
+					Lot:             c("kava", 1),
+					Bidder:          addr1,
+					Bid:             c("kava", 1),
+					EndTime:         now,
+					MaxEndTime:      now,
+					HasReceivedBids: true,
+				},
+				CorrespondingDebt: c("kava", 1),
+				Params:            DefaultParams().ForDenom("hard"),
+			},
+			false,
+		},
+		{
+			"missing params",
+			DebtAuction{
+				BaseAuction: BaseAuction{
+					ID:              1,
+					Initiator:       testAccAddress1,
+					Lot:             c("kava", 1),
+					Bidder:          addr1,
+					Bid:             c("kava", 1),
+					EndTime:         now,
+					MaxEndTime:      now,
+					HasReceivedBids: true,
+				},
+				CorrespondingDebt: c("kava", 1),
 			},
 			false,
 		},
@@ -294,6 +331,7 @@ func TestCollateralAuctionValidate(t *testing.T) {
 					HasReceivedBids: true,
 				},
 				CorrespondingDebt: c("kava", 1),
+				Params:            DefaultParams().ForDenom("kava"),
 				MaxBid:            c("kava", 1),
 				LotReturns: WeightedAddresses{
 					Addresses: []sdk.AccAddress{addr1},
@@ -316,6 +354,7 @@ func TestCollateralAuctionValidate(t *testing.T) {
 					HasReceivedBids: true,
 				},
 				CorrespondingDebt: sdk.Coin{Denom: "DENOM", Amount: sdk.NewInt(1)},
+				Params:            DefaultParams().ForDenom("kava"),
 			},
 			false,
 		},
@@ -333,6 +372,7 @@ func TestCollateralAuctionValidate(t *testing.T) {
 					HasReceivedBids: true,
 				},
 				CorrespondingDebt: c("kava", 1),
+				Params:            DefaultParams().ForDenom("kava"),
 				MaxBid:            sdk.Coin{Denom: "DENOM", Amount: sdk.NewInt(1)},
 			},
 			false,
@@ -351,6 +391,7 @@ func TestCollateralAuctionValidate(t *testing.T) {
 					HasReceivedBids: true,
 				},
 				CorrespondingDebt: c("kava", 1),
+				Params:            DefaultParams().ForDenom("kava"),
 				MaxBid:            c("kava", 1),
 				LotReturns: WeightedAddresses{
 					Addresses: []sdk.AccAddress{nil},
@@ -373,6 +414,116 @@ func TestCollateralAuctionValidate(t *testing.T) {
 	}
 }
 
+func TestSealedBidSurplusAuctionPeriods(t *testing.T) {
+	now := time.Now()
+	auction := SealedBidSurplusAuction{
+		BaseAuction:     BaseAuction{EndTime: now.Add(time.Hour)},
+		RevealStartTime: now,
+	}
+
+	testCases := []struct {
+		msg          string
+		blockTime    time.Time
+		commitPeriod bool
+		revealPeriod bool
+	}{
+		{"before reveal start", now.Add(-time.Second), true, false},
+		{"at reveal start", now, false, true},
+		{"before end time", now.Add(time.Hour - time.Second), false, true},
+		{"at end time", now.Add(time.Hour), false, false},
+		{"after end time", now.Add(time.Hour + time.Second), false, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.commitPeriod, auction.IsCommitPeriod(tc.blockTime), tc.msg)
+		require.Equal(t, tc.revealPeriod, auction.IsRevealPeriod(tc.blockTime), tc.msg)
+	}
+}
+
+func TestSealedBidSurplusAuctionValidate(t *testing.T) {
+	now := time.Now()
+	addr1, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+	addr2, err := sdk.AccAddressFromBech32(testAccAddress2)
+	require.NoError(t, err)
+	salt := make([]byte, SealedBidSaltLength)
+
+	revealed := NewSealedBid(addr2, CalculateSealedBidHash(1, addr2, c(TestBidDenom, 5), salt), c(TestBidDenom, 10))
+	revealed.Revealed = true
+	revealed.Amount = c(TestBidDenom, 5)
+
+	testCases := []struct {
+		msg     string
+		auction SealedBidSurplusAuction
+		expPass bool
+	}{
+		{
+			"valid auction",
+			SealedBidSurplusAuction{
+				BaseAuction:     NewSurplusAuction(TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), TestBidDenom, now, DefaultParams().ForDenom(TestLotDenom)).BaseAuction,
+				RevealStartTime: now.Add(-time.Hour),
+				PricingRule:     SecondPriceSealedBid,
+				SealedBids: SealedBids{
+					NewSealedBid(addr1, CalculateSealedBidHash(1, addr1, c(TestBidDenom, 10), salt), c(TestBidDenom, 10)),
+					revealed,
+				},
+			},
+			true,
+		},
+		{
+			"invalid pricing rule",
+			SealedBidSurplusAuction{
+				BaseAuction:     NewSurplusAuction(TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), TestBidDenom, now, DefaultParams().ForDenom(TestLotDenom)).BaseAuction,
+				RevealStartTime: now.Add(-time.Hour),
+				PricingRule:     "",
+			},
+			false,
+		},
+		{
+			"reveal start after end time",
+			SealedBidSurplusAuction{
+				BaseAuction:     NewSurplusAuction(TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), TestBidDenom, now, DefaultParams().ForDenom(TestLotDenom)).BaseAuction,
+				RevealStartTime: now.Add(time.Hour),
+				PricingRule:     FirstPriceSealedBid,
+			},
+			false,
+		},
+		{
+			"duplicate bidder",
+			SealedBidSurplusAuction{
+				BaseAuction:     NewSurplusAuction(TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), TestBidDenom, now, DefaultParams().ForDenom(TestLotDenom)).BaseAuction,
+				RevealStartTime: now.Add(-time.Hour),
+				PricingRule:     FirstPriceSealedBid,
+				SealedBids:      SealedBids{revealed, revealed},
+			},
+			false,
+		},
+		{
+			"wrong deposit denom",
+			SealedBidSurplusAuction{
+				BaseAuction:     NewSurplusAuction(TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), TestBidDenom, now, DefaultParams().ForDenom(TestLotDenom)).BaseAuction,
+				RevealStartTime: now.Add(-time.Hour),
+				PricingRule:     FirstPriceSealedBid,
+				SealedBids: SealedBids{
+					NewSealedBid(addr1, CalculateSealedBidHash(1, addr1, c(TestLotDenom, 10), salt), c(TestLotDenom, 10)),
+				},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.msg, func(t *testing.T) {
+			err := tc.auction.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestBaseAuctionGetters(t *testing.T) {
 	endTime := time.Now().Add(TestExtraEndTime)
 
@@ -381,6 +532,7 @@ func TestBaseAuctionGetters(t *testing.T) {
 		TestInitiatorModuleName,
 		c(TestLotDenom, TestLotAmount),
 		TestBidDenom, endTime,
+		DefaultParams().ForDenom(TestLotDenom),
 	)
 
 	auctionID := auction.GetID()
@@ -404,6 +556,7 @@ func TestNewSurplusAuction(t *testing.T) {
 		TestInitiatorModuleName,
 		c(TestLotDenom, TestLotAmount),
 		TestBidDenom, endTime,
+		DefaultParams().ForDenom(TestLotDenom),
 	)
 
 	require.Equal(t, surplusAuction.Initiator, TestInitiatorModuleName)
@@ -423,6 +576,7 @@ func TestNewDebtAuction(t *testing.T) {
 		c(TestLotDenom, TestLotAmount),
 		endTime,
 		c(TestDebtDenom, TestDebtAmount1),
+		DefaultParams().ForDenom(TestLotDenom),
 	)
 
 	require.Equal(t, debtAuction.Initiator, TestInitiatorModuleName)
@@ -456,6 +610,7 @@ func TestNewCollateralAuction(t *testing.T) {
 		c(TestBidDenom, TestBidAmount),
 		weightedAddresses,
 		c(TestDebtDenom, TestDebtAmount2),
+		DefaultParams().ForDenom(TestLotDenom),
 	)
 
 	require.Equal(t, collateralAuction.BaseAuction.Initiator, TestInitiatorModuleName)
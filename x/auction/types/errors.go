@@ -27,4 +27,20 @@ var (
 	ErrLotTooSmall = sdkerrors.Register(ModuleName, 11, "lot is not greater than auction's min new lot amount")
 	// ErrLotTooLarge error for when lot is not smaller than auction's max new lot amount
 	ErrLotTooLarge = sdkerrors.Register(ModuleName, 12, "lot is greater than auction's max new lot amount")
+	// ErrNotSealedBidAuction error for when a sealed bid is placed on an open auction
+	ErrNotSealedBidAuction = sdkerrors.Register(ModuleName, 13, "auction does not accept sealed bids")
+	// ErrSealedBidAuction error for when an open bid is placed on a sealed bid auction
+	ErrSealedBidAuction = sdkerrors.Register(ModuleName, 14, "sealed bid auctions only accept sealed bids")
+	// ErrCommitPeriodEnded error for when a sealed bid is committed after the commit period
+	ErrCommitPeriodEnded = sdkerrors.Register(ModuleName, 15, "auction commit period has ended")
+	// ErrNotRevealPeriod error for when a sealed bid is revealed outside of the reveal period
+	ErrNotRevealPeriod = sdkerrors.Register(ModuleName, 16, "auction is not in its reveal period")
+	// ErrDuplicateSealedBid error for when a bidder commits more than one sealed bid to an auction
+	ErrDuplicateSealedBid = sdkerrors.Register(ModuleName, 17, "bidder has already committed a sealed bid")
+	// ErrSealedBidNotFound error for when a bidder reveals a bid they did not commit
+	ErrSealedBidNotFound = sdkerrors.Register(ModuleName, 18, "sealed bid not found")
+	// ErrSealedBidAlreadyRevealed error for when a sealed bid is revealed twice
+	ErrSealedBidAlreadyRevealed = sdkerrors.Register(ModuleName, 19, "sealed bid has already been revealed")
+	// ErrInvalidReveal error for when a revealed bid does not match its commitment
+	ErrInvalidReveal = sdkerrors.Register(ModuleName, 20, "revealed bid does not match commitment")
 )
@@ -2,9 +2,11 @@ package types
 
 // Events for the module
 const (
-	EventTypeAuctionStart = "auction_start"
-	EventTypeAuctionBid   = "auction_bid"
-	EventTypeAuctionClose = "auction_close"
+	EventTypeAuctionStart  = "auction_start"
+	EventTypeAuctionBid    = "auction_bid"
+	EventTypeAuctionClose  = "auction_close"
+	EventTypeAuctionCommit = "auction_commit"
+	EventTypeAuctionReveal = "auction_reveal"
 
 	AttributeValueCategory  = ModuleName
 	AttributeKeyAuctionID   = "auction_id"
@@ -15,4 +17,7 @@ const (
 	AttributeKeyBid         = "bid"
 	AttributeKeyEndTime     = "end_time"
 	AttributeKeyCloseBlock  = "close_block"
+	AttributeKeyDeposit     = "deposit"
+	AttributeKeyBidHash     = "bid_hash"
+	AttributeKeyRevealStart = "reveal_start_time"
 )
@@ -18,13 +18,13 @@ func TestGenesisState_Validate(t *testing.T) {
 		expectPass bool
 	}{
 		{"default", DefaultGenesisState().NextAuctionID, DefaultGenesisState().Auctions, true},
-		{"invalid next ID", 54, GenesisAuctions{SurplusAuction{BaseAuction{ID: 105}}}, false},
+		{"invalid next ID", 54, GenesisAuctions{SurplusAuction{BaseAuction: BaseAuction{ID: 105}}}, false},
 		{
 			"repeated ID",
 			1000,
 			GenesisAuctions{
-				SurplusAuction{BaseAuction{ID: 105}},
-				DebtAuction{BaseAuction{ID: 105}, testCoin},
+				SurplusAuction{BaseAuction: BaseAuction{ID: 105}},
+				DebtAuction{BaseAuction: BaseAuction{ID: 105}, CorrespondingDebt: testCoin},
 			},
 			false,
 		},
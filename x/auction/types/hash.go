@@ -0,0 +1,31 @@
+package types
+
+import (
+	"crypto/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// SealedBidSaltLength is the expected length of the salt used to blind a sealed bid.
+const SealedBidSaltLength = 32
+
+// CalculateSealedBidHash calculates the commitment hash of a sealed bid from the auction ID, bidder, bid and salt.
+// The auction ID and bidder are included so a commitment cannot be copied onto another auction or by another bidder.
+func CalculateSealedBidHash(auctionID uint64, bidder sdk.AccAddress, bid sdk.Coin, salt []byte) []byte {
+	data := Uint64ToBytes(auctionID)
+	data = append(data, bidder.Bytes()...)
+	data = append(data, []byte(bid.String())...)
+	data = append(data, salt...)
+	return tmhash.Sum(data)
+}
+
+// GenerateSealedBidSalt generates a cryptographically strong random salt to blind a sealed bid.
+func GenerateSealedBidSalt() ([]byte, error) {
+	bytes := make([]byte, SealedBidSaltLength)
+	if _, err := rand.Read(bytes); err != nil {
+		return []byte{}, err
+	}
+	return bytes, nil
+}
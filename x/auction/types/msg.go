@@ -6,10 +6,17 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPlaceBid{}
+var (
+	_ sdk.Msg = &MsgPlaceBid{}
+	_ sdk.Msg = &MsgCommitBid{}
+	_ sdk.Msg = &MsgRevealBid{}
+)
 
 // MsgPlaceBid is the message type used to place a bid on any type of auction.
 type MsgPlaceBid struct {
@@ -69,3 +76,132 @@ func (msg MsgPlaceBid) String() string {
 	Amount: %s
 `, msg.AuctionID, msg.Bidder, msg.Amount)
 }
+
+// MsgCommitBid is the message type used to commit a sealed bid on a sealed bid auction.
+type MsgCommitBid struct {
+	AuctionID uint64           `json:"auction_id" yaml:"auction_id"`
+	Bidder    sdk.AccAddress   `json:"bidder" yaml:"bidder"`
+	BidHash   tmbytes.HexBytes `json:"bid_hash" yaml:"bid_hash"` // Hash of the bid, see CalculateSealedBidHash.
+	Deposit   sdk.Coin         `json:"deposit" yaml:"deposit"`   // Coins escrowed until the auction closes. Must cover the revealed bid.
+}
+
+// NewMsgCommitBid returns a new MsgCommitBid.
+func NewMsgCommitBid(auctionID uint64, bidder sdk.AccAddress, bidHash tmbytes.HexBytes, deposit sdk.Coin) MsgCommitBid {
+	return MsgCommitBid{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		BidHash:   bidHash,
+		Deposit:   deposit,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCommitBid) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgCommitBid) Type() string { return "commit_bid" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgCommitBid) ValidateBasic() error {
+	if msg.AuctionID == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	if msg.Bidder.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "bidder address cannot be empty")
+	}
+	if len(msg.Bidder) != sdk.AddrLen {
+		return fmt.Errorf("the expected bidder address length is %d, actual length is %d", sdk.AddrLen, len(msg.Bidder))
+	}
+	if len(msg.BidHash) != tmhash.Size {
+		return fmt.Errorf("the expected bid hash length is %d, actual length is %d", tmhash.Size, len(msg.BidHash))
+	}
+	if !msg.Deposit.IsValid() || !msg.Deposit.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "deposit amount %s", msg.Deposit)
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCommitBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCommitBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+func (msg MsgCommitBid) String() string {
+	// String implements the Stringer interface
+	return fmt.Sprintf(`Commit Bid Message:
+	Auction ID:         %d
+	Bidder: %s
+	Bid Hash: %s
+	Deposit: %s
+`, msg.AuctionID, msg.Bidder, msg.BidHash, msg.Deposit)
+}
+
+// MsgRevealBid is the message type used to reveal a sealed bid previously committed with MsgCommitBid.
+type MsgRevealBid struct {
+	AuctionID uint64           `json:"auction_id" yaml:"auction_id"`
+	Bidder    sdk.AccAddress   `json:"bidder" yaml:"bidder"`
+	Amount    sdk.Coin         `json:"amount" yaml:"amount"` // The bid that was committed to.
+	Salt      tmbytes.HexBytes `json:"salt" yaml:"salt"`     // The salt used to blind the committed bid hash.
+}
+
+// NewMsgRevealBid returns a new MsgRevealBid.
+func NewMsgRevealBid(auctionID uint64, bidder sdk.AccAddress, amt sdk.Coin, salt tmbytes.HexBytes) MsgRevealBid {
+	return MsgRevealBid{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		Amount:    amt,
+		Salt:      salt,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRevealBid) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRevealBid) Type() string { return "reveal_bid" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgRevealBid) ValidateBasic() error {
+	if msg.AuctionID == 0 {
+		return errors.New("auction id cannot be zero")
+	}
+	if msg.Bidder.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "bidder address cannot be empty")
+	}
+	if len(msg.Bidder) != sdk.AddrLen {
+		return fmt.Errorf("the expected bidder address length is %d, actual length is %d", sdk.AddrLen, len(msg.Bidder))
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "bid amount %s", msg.Amount)
+	}
+	if len(msg.Salt) != SealedBidSaltLength {
+		return fmt.Errorf("the expected salt length is %d, actual length is %d", SealedBidSaltLength, len(msg.Salt))
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRevealBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRevealBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+func (msg MsgRevealBid) String() string {
+	// String implements the Stringer interface
+	return fmt.Sprintf(`Reveal Bid Message:
+	Auction ID:         %d
+	Bidder: %s
+	Amount: %s
+`, msg.AuctionID, msg.Bidder, msg.Amount)
+}
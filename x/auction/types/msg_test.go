@@ -57,3 +57,95 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgCommitBid_ValidateBasic(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+	bidHash := CalculateSealedBidHash(1, addr, c("token", 10), make([]byte, SealedBidSaltLength))
+
+	tests := []struct {
+		name       string
+		msg        MsgCommitBid
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgCommitBid(1, addr, bidHash, c("token", 10)),
+			true,
+		},
+		{
+			"zero id",
+			NewMsgCommitBid(0, addr, bidHash, c("token", 10)),
+			false,
+		},
+		{
+			"empty address ",
+			NewMsgCommitBid(1, nil, bidHash, c("token", 10)),
+			false,
+		},
+		{
+			"invalid hash",
+			NewMsgCommitBid(1, addr, bidHash[:10], c("token", 10)),
+			false,
+		},
+		{
+			"zero deposit",
+			NewMsgCommitBid(1, addr, bidHash, c("token", 0)),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
+
+func TestMsgRevealBid_ValidateBasic(t *testing.T) {
+	addr, err := sdk.AccAddressFromBech32(testAccAddress1)
+	require.NoError(t, err)
+	salt := make([]byte, SealedBidSaltLength)
+
+	tests := []struct {
+		name       string
+		msg        MsgRevealBid
+		expectPass bool
+	}{
+		{
+			"normal",
+			NewMsgRevealBid(1, addr, c("token", 10), salt),
+			true,
+		},
+		{
+			"zero id",
+			NewMsgRevealBid(0, addr, c("token", 10), salt),
+			false,
+		},
+		{
+			"invalid address",
+			NewMsgRevealBid(1, addr[:10], c("token", 10), salt),
+			false,
+		},
+		{
+			"zero amount",
+			NewMsgRevealBid(1, addr, c("token", 0), salt),
+			false,
+		},
+		{
+			"short salt",
+			NewMsgRevealBid(1, addr, c("token", 10), salt[:16]),
+			false,
+		},
+	}
+
+	for _, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.msg.ValidateBasic(), tc.name)
+		} else {
+			require.Error(t, tc.msg.ValidateBasic(), tc.name)
+		}
+	}
+}
@@ -19,38 +19,60 @@ const (
 	DefaultMaxAuctionDuration time.Duration = 2 * 24 * time.Hour
 	// DefaultBidDuration how long an auction gets extended when someone bids
 	DefaultBidDuration time.Duration = 1 * time.Hour
+	// DefaultSealedBidCommitDuration how long sealed bid auctions accept commitments
+	DefaultSealedBidCommitDuration time.Duration = 1 * 24 * time.Hour
+	// DefaultSealedBidRevealDuration how long sealed bid auctions accept reveals after the commit period
+	DefaultSealedBidRevealDuration time.Duration = 6 * time.Hour
+	// DefaultSealedBidPricingRule the price paid by the winner of a sealed bid auction
+	DefaultSealedBidPricingRule = SecondPriceSealedBid
 )
 
 var (
 	// DefaultIncrement is the smallest percent change a new bid must have from the old one
 	DefaultIncrement sdk.Dec = sdk.MustNewDecFromStr("0.05")
 	// ParamStoreKeyParams Param store key for auction params
-	KeyBidDuration         = []byte("BidDuration")
-	KeyMaxAuctionDuration  = []byte("MaxAuctionDuration")
-	KeyIncrementSurplus    = []byte("IncrementSurplus")
-	KeyIncrementDebt       = []byte("IncrementDebt")
-	KeyIncrementCollateral = []byte("IncrementCollateral")
+	KeyBidDuration             = []byte("BidDuration")
+	KeyMaxAuctionDuration      = []byte("MaxAuctionDuration")
+	KeyIncrementSurplus        = []byte("IncrementSurplus")
+	KeyIncrementDebt           = []byte("IncrementDebt")
+	KeyIncrementCollateral     = []byte("IncrementCollateral")
+	KeySealedBidCommitDuration = []byte("SealedBidCommitDuration")
+	KeySealedBidRevealDuration = []byte("SealedBidRevealDuration")
+	KeySealedBidPricingRule    = []byte("SealedBidPricingRule")
+	KeyDenomParams             = []byte("DenomParams")
 )
 
 var _ subspace.ParamSet = &Params{}
 
 // Params is the governance parameters for the auction module.
 type Params struct {
-	MaxAuctionDuration  time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"` // max length of auction
-	BidDuration         time.Duration `json:"bid_duration" yaml:"bid_duration"`                 // additional time added to the auction end time after each bid, capped by the expiry.
-	IncrementSurplus    sdk.Dec       `json:"increment_surplus" yaml:"increment_surplus"`       // percentage change (of auc.Bid) required for a new bid on a surplus auction
-	IncrementDebt       sdk.Dec       `json:"increment_debt" yaml:"increment_debt"`             // percentage change (of auc.Lot) required for a new bid on a debt auction
-	IncrementCollateral sdk.Dec       `json:"increment_collateral" yaml:"increment_collateral"` // percentage change (of auc.Bid or auc.Lot) required for a new bid on a collateral auction
+	MaxAuctionDuration      time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"`             // max length of auction
+	BidDuration             time.Duration `json:"bid_duration" yaml:"bid_duration"`                             // additional time added to the auction end time after each bid, capped by the expiry.
+	IncrementSurplus        sdk.Dec       `json:"increment_surplus" yaml:"increment_surplus"`                   // percentage change (of auc.Bid) required for a new bid on a surplus auction
+	IncrementDebt           sdk.Dec       `json:"increment_debt" yaml:"increment_debt"`                         // percentage change (of auc.Lot) required for a new bid on a debt auction
+	IncrementCollateral     sdk.Dec       `json:"increment_collateral" yaml:"increment_collateral"`             // percentage change (of auc.Bid or auc.Lot) required for a new bid on a collateral auction
+	SealedBidCommitDuration time.Duration `json:"sealed_bid_commit_duration" yaml:"sealed_bid_commit_duration"` // length of the commit period of a sealed bid auction
+	SealedBidRevealDuration time.Duration `json:"sealed_bid_reveal_duration" yaml:"sealed_bid_reveal_duration"` // length of the reveal period of a sealed bid auction, following the commit period
+	SealedBidPricingRule    string        `json:"sealed_bid_pricing_rule" yaml:"sealed_bid_pricing_rule"`       // whether the winner of a sealed bid auction pays the first or second price
+	DenomParams             DenomParams   `json:"denom_params" yaml:"denom_params"`                             // overrides of the durations and increments for auctions of specific lot denoms
 }
 
 // NewParams returns a new Params object.
-func NewParams(maxAuctionDuration, bidDuration time.Duration, incrementSurplus, incrementDebt, incrementCollateral sdk.Dec) Params {
+func NewParams(
+	maxAuctionDuration, bidDuration time.Duration, incrementSurplus, incrementDebt, incrementCollateral sdk.Dec,
+	sealedBidCommitDuration, sealedBidRevealDuration time.Duration, sealedBidPricingRule string,
+	denomParams DenomParams,
+) Params {
 	return Params{
-		MaxAuctionDuration:  maxAuctionDuration,
-		BidDuration:         bidDuration,
-		IncrementSurplus:    incrementSurplus,
-		IncrementDebt:       incrementDebt,
-		IncrementCollateral: incrementCollateral,
+		MaxAuctionDuration:      maxAuctionDuration,
+		BidDuration:             bidDuration,
+		IncrementSurplus:        incrementSurplus,
+		IncrementDebt:           incrementDebt,
+		IncrementCollateral:     incrementCollateral,
+		SealedBidCommitDuration: sealedBidCommitDuration,
+		SealedBidRevealDuration: sealedBidRevealDuration,
+		SealedBidPricingRule:    sealedBidPricingRule,
+		DenomParams:             denomParams,
 	}
 }
 
@@ -62,6 +84,10 @@ func DefaultParams() Params {
 		DefaultIncrement,
 		DefaultIncrement,
 		DefaultIncrement,
+		DefaultSealedBidCommitDuration,
+		DefaultSealedBidRevealDuration,
+		DefaultSealedBidPricingRule,
+		nil,
 	)
 }
 
@@ -78,6 +104,10 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		params.NewParamSetPair(KeyIncrementSurplus, &p.IncrementSurplus, validateIncrementSurplusParam),
 		params.NewParamSetPair(KeyIncrementDebt, &p.IncrementDebt, validateIncrementDebtParam),
 		params.NewParamSetPair(KeyIncrementCollateral, &p.IncrementCollateral, validateIncrementCollateralParam),
+		params.NewParamSetPair(KeySealedBidCommitDuration, &p.SealedBidCommitDuration, validateSealedBidDurationParam),
+		params.NewParamSetPair(KeySealedBidRevealDuration, &p.SealedBidRevealDuration, validateSealedBidDurationParam),
+		params.NewParamSetPair(KeySealedBidPricingRule, &p.SealedBidPricingRule, validateSealedBidPricingRuleParam),
+		params.NewParamSetPair(KeyDenomParams, &p.DenomParams, validateDenomParamsParam),
 	}
 }
 
@@ -95,8 +125,13 @@ func (p Params) String() string {
 	Bid Duration: %s
 	Increment Surplus: %s
 	Increment Debt: %s
-	Increment Collateral: %s`,
-		p.MaxAuctionDuration, p.BidDuration, p.IncrementSurplus, p.IncrementDebt, p.IncrementCollateral)
+	Increment Collateral: %s
+	Sealed Bid Commit Duration: %s
+	Sealed Bid Reveal Duration: %s
+	Sealed Bid Pricing Rule: %s
+	Denom Params: %s`,
+		p.MaxAuctionDuration, p.BidDuration, p.IncrementSurplus, p.IncrementDebt, p.IncrementCollateral,
+		p.SealedBidCommitDuration, p.SealedBidRevealDuration, p.SealedBidPricingRule, p.DenomParams)
 }
 
 // Validate checks that the parameters have valid values.
@@ -121,7 +156,128 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateIncrementCollateralParam(p.IncrementCollateral)
+	if err := validateIncrementCollateralParam(p.IncrementCollateral); err != nil {
+		return err
+	}
+
+	if err := validateSealedBidDurationParam(p.SealedBidCommitDuration); err != nil {
+		return err
+	}
+
+	if err := validateSealedBidDurationParam(p.SealedBidRevealDuration); err != nil {
+		return err
+	}
+
+	if err := validateSealedBidPricingRuleParam(p.SealedBidPricingRule); err != nil {
+		return err
+	}
+
+	return validateDenomParamsParam(p.DenomParams)
+}
+
+// ForDenom returns the auction durations and increments that apply to auctions with a lot of the given denom.
+// If there is no override for the denom, the global values are returned.
+func (p Params) ForDenom(denom string) DenomParam {
+	for _, dp := range p.DenomParams {
+		if dp.Denom == denom {
+			return dp
+		}
+	}
+	return NewDenomParam(denom, p.MaxAuctionDuration, p.BidDuration, p.IncrementSurplus, p.IncrementDebt, p.IncrementCollateral)
+}
+
+// DenomParam overrides the global auction durations and increments for auctions with a lot of a specific denom.
+type DenomParam struct {
+	Denom               string        `json:"denom" yaml:"denom"`
+	MaxAuctionDuration  time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"`
+	BidDuration         time.Duration `json:"bid_duration" yaml:"bid_duration"`
+	IncrementSurplus    sdk.Dec       `json:"increment_surplus" yaml:"increment_surplus"`
+	IncrementDebt       sdk.Dec       `json:"increment_debt" yaml:"increment_debt"`
+	IncrementCollateral sdk.Dec       `json:"increment_collateral" yaml:"increment_collateral"`
+}
+
+// NewDenomParam returns a new DenomParam.
+func NewDenomParam(denom string, maxAuctionDuration, bidDuration time.Duration, incrementSurplus, incrementDebt, incrementCollateral sdk.Dec) DenomParam {
+	return DenomParam{
+		Denom:               denom,
+		MaxAuctionDuration:  maxAuctionDuration,
+		BidDuration:         bidDuration,
+		IncrementSurplus:    incrementSurplus,
+		IncrementDebt:       incrementDebt,
+		IncrementCollateral: incrementCollateral,
+	}
+}
+
+// Validate checks that the denom param has valid values.
+func (dp DenomParam) Validate() error {
+	if err := sdk.ValidateDenom(dp.Denom); err != nil {
+		return fmt.Errorf("invalid denom param: %w", err)
+	}
+	if err := validateBidDurationParam(dp.BidDuration); err != nil {
+		return err
+	}
+	if err := validateMaxAuctionDurationParam(dp.MaxAuctionDuration); err != nil {
+		return err
+	}
+	if dp.BidDuration > dp.MaxAuctionDuration {
+		return fmt.Errorf("bid duration cannot be larger than max auction duration for denom %s", dp.Denom)
+	}
+	if err := validateIncrementSurplusParam(dp.IncrementSurplus); err != nil {
+		return err
+	}
+	if err := validateIncrementDebtParam(dp.IncrementDebt); err != nil {
+		return err
+	}
+	return validateIncrementCollateralParam(dp.IncrementCollateral)
+}
+
+// Equal returns a boolean determining if two DenomParam types are identical.
+func (dp DenomParam) Equal(dp2 DenomParam) bool {
+	return dp.Denom == dp2.Denom &&
+		dp.MaxAuctionDuration == dp2.MaxAuctionDuration &&
+		dp.BidDuration == dp2.BidDuration &&
+		dp.IncrementSurplus.Equal(dp2.IncrementSurplus) &&
+		dp.IncrementDebt.Equal(dp2.IncrementDebt) &&
+		dp.IncrementCollateral.Equal(dp2.IncrementCollateral)
+}
+
+// String implements stringer interface
+func (dp DenomParam) String() string {
+	return fmt.Sprintf(`Denom Param:
+	Denom: %s
+	Max Auction Duration: %s
+	Bid Duration: %s
+	Increment Surplus: %s
+	Increment Debt: %s
+	Increment Collateral: %s`,
+		dp.Denom, dp.MaxAuctionDuration, dp.BidDuration, dp.IncrementSurplus, dp.IncrementDebt, dp.IncrementCollateral)
+}
+
+// DenomParams is a slice of DenomParam.
+type DenomParams []DenomParam
+
+// Validate checks each denom param is valid and that there is at most one per denom.
+func (dps DenomParams) Validate() error {
+	denoms := make(map[string]bool)
+	for _, dp := range dps {
+		if err := dp.Validate(); err != nil {
+			return err
+		}
+		if denoms[dp.Denom] {
+			return fmt.Errorf("duplicate denom param for denom %s", dp.Denom)
+		}
+		denoms[dp.Denom] = true
+	}
+	return nil
+}
+
+// String implements stringer interface
+func (dps DenomParams) String() string {
+	out := "Denom Params\n"
+	for _, dp := range dps {
+		out += fmt.Sprintf("%s\n", dp)
+	}
+	return out
 }
 
 func validateBidDurationParam(i interface{}) error {
@@ -200,3 +356,34 @@ func validateIncrementCollateralParam(i interface{}) error {
 
 	return nil
 }
+
+func validateSealedBidDurationParam(i interface{}) error {
+	duration, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if duration <= 0 {
+		return fmt.Errorf("sealed bid duration must be positive %d", duration)
+	}
+
+	return nil
+}
+
+func validateSealedBidPricingRuleParam(i interface{}) error {
+	rule, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidatePricingRule(rule)
+}
+
+func validateDenomParamsParam(i interface{}) error {
+	denomParams, ok := i.(DenomParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return denomParams.Validate()
+}
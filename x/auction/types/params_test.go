@@ -86,6 +86,101 @@ func TestParams_Validate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"zero sealed bid commit duration",
+			Params{
+				MaxAuctionDuration:      24 * time.Hour,
+				BidDuration:             1 * time.Hour,
+				IncrementSurplus:        d("0.05"),
+				IncrementDebt:           d("0.05"),
+				IncrementCollateral:     d("0.05"),
+				SealedBidCommitDuration: 0,
+				SealedBidRevealDuration: 1 * time.Hour,
+				SealedBidPricingRule:    FirstPriceSealedBid,
+			},
+			true,
+		},
+		{
+			"invalid sealed bid pricing rule",
+			Params{
+				MaxAuctionDuration:      24 * time.Hour,
+				BidDuration:             1 * time.Hour,
+				IncrementSurplus:        d("0.05"),
+				IncrementDebt:           d("0.05"),
+				IncrementCollateral:     d("0.05"),
+				SealedBidCommitDuration: 1 * time.Hour,
+				SealedBidRevealDuration: 1 * time.Hour,
+				SealedBidPricingRule:    "third_price",
+			},
+			true,
+		},
+		{
+			"first price sealed bid",
+			Params{
+				MaxAuctionDuration:      24 * time.Hour,
+				BidDuration:             1 * time.Hour,
+				IncrementSurplus:        d("0.05"),
+				IncrementDebt:           d("0.05"),
+				IncrementCollateral:     d("0.05"),
+				SealedBidCommitDuration: 1 * time.Hour,
+				SealedBidRevealDuration: 1 * time.Hour,
+				SealedBidPricingRule:    FirstPriceSealedBid,
+			},
+			false,
+		},
+		{
+			"duplicate denom params",
+			Params{
+				MaxAuctionDuration:      24 * time.Hour,
+				BidDuration:             1 * time.Hour,
+				IncrementSurplus:        d("0.05"),
+				IncrementDebt:           d("0.05"),
+				IncrementCollateral:     d("0.05"),
+				SealedBidCommitDuration: 1 * time.Hour,
+				SealedBidRevealDuration: 1 * time.Hour,
+				SealedBidPricingRule:    FirstPriceSealedBid,
+				DenomParams: DenomParams{
+					NewDenomParam("bnb", 24*time.Hour, 1*time.Hour, d("0.05"), d("0.05"), d("0.05")),
+					NewDenomParam("bnb", 12*time.Hour, 1*time.Hour, d("0.05"), d("0.05"), d("0.05")),
+				},
+			},
+			true,
+		},
+		{
+			"denom params bid>auction",
+			Params{
+				MaxAuctionDuration:      24 * time.Hour,
+				BidDuration:             1 * time.Hour,
+				IncrementSurplus:        d("0.05"),
+				IncrementDebt:           d("0.05"),
+				IncrementCollateral:     d("0.05"),
+				SealedBidCommitDuration: 1 * time.Hour,
+				SealedBidRevealDuration: 1 * time.Hour,
+				SealedBidPricingRule:    FirstPriceSealedBid,
+				DenomParams: DenomParams{
+					NewDenomParam("bnb", 1*time.Hour, 24*time.Hour, d("0.05"), d("0.05"), d("0.05")),
+				},
+			},
+			true,
+		},
+		{
+			"valid denom params",
+			Params{
+				MaxAuctionDuration:      24 * time.Hour,
+				BidDuration:             1 * time.Hour,
+				IncrementSurplus:        d("0.05"),
+				IncrementDebt:           d("0.05"),
+				IncrementCollateral:     d("0.05"),
+				SealedBidCommitDuration: 1 * time.Hour,
+				SealedBidRevealDuration: 1 * time.Hour,
+				SealedBidPricingRule:    FirstPriceSealedBid,
+				DenomParams: DenomParams{
+					NewDenomParam("bnb", 12*time.Hour, 10*time.Minute, d("0.1"), d("0.1"), d("0.1")),
+					NewDenomParam("busd", 48*time.Hour, 4*time.Hour, d("0.01"), d("0.01"), d("0.01")),
+				},
+			},
+			false,
+		},
 		{
 			"zero value",
 			Params{},
@@ -103,3 +198,15 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_ForDenom(t *testing.T) {
+	override := NewDenomParam("bnb", 12*time.Hour, 10*time.Minute, d("0.1"), d("0.2"), d("0.3"))
+	params := DefaultParams()
+	params.DenomParams = DenomParams{override}
+
+	require.Equal(t, override, params.ForDenom("bnb"))
+	require.Equal(t,
+		NewDenomParam("busd", params.MaxAuctionDuration, params.BidDuration, params.IncrementSurplus, params.IncrementDebt, params.IncrementCollateral),
+		params.ForDenom("busd"),
+	)
+}
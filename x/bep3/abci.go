@@ -16,6 +16,8 @@ func BeginBlocker(ctx sdk.Context, k Keeper) {
 		}
 	}
 	k.UpdateTimeBasedSupplyLimits(ctx)
+	k.UpdateDeputyRotations(ctx)
 	k.UpdateExpiredAtomicSwaps(ctx)
 	k.DeleteClosedAtomicSwapsFromLongtermStorage(ctx)
+	k.PruneSwapQuotaUsages(ctx)
 }
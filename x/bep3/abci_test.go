@@ -59,9 +59,9 @@ func (suite *ABCITestSuite) ResetKeeper() {
 		randomNumberHash := bep3.CalculateRandomHash(randomNumber[:], timestamp)
 
 		// Create atomic swap and check err to confirm creation
-		err := suite.keeper.CreateAtomicSwap(suite.ctx, randomNumberHash, timestamp, expireHeight,
+		err := suite.keeper.CreateAtomicSwap(suite.ctx, randomNumberHash, timestamp, expireHeight, 0,
 			suite.addrs[11], suite.addrs[i], TestSenderOtherChain, TestRecipientOtherChain,
-			amount, true)
+			amount, true, "")
 		suite.Nil(err)
 
 		// Store swap's calculated ID and secret random number
@@ -12,61 +12,83 @@ import (
 // ALIASGEN: github.com/kava-labs/kava/x/bep3/types
 
 const (
-	EventTypeCreateAtomicSwap      = types.EventTypeCreateAtomicSwap
-	EventTypeClaimAtomicSwap       = types.EventTypeClaimAtomicSwap
-	EventTypeRefundAtomicSwap      = types.EventTypeRefundAtomicSwap
-	EventTypeSwapsExpired          = types.EventTypeSwapsExpired
-	AttributeValueCategory         = types.AttributeValueCategory
-	AttributeKeySender             = types.AttributeKeySender
-	AttributeKeyRecipient          = types.AttributeKeyRecipient
-	AttributeKeyAtomicSwapID       = types.AttributeKeyAtomicSwapID
-	AttributeKeyRandomNumberHash   = types.AttributeKeyRandomNumberHash
-	AttributeKeyTimestamp          = types.AttributeKeyTimestamp
-	AttributeKeySenderOtherChain   = types.AttributeKeySenderOtherChain
-	AttributeKeyExpireHeight       = types.AttributeKeyExpireHeight
-	AttributeKeyAmount             = types.AttributeKeyAmount
-	AttributeKeyDirection          = types.AttributeKeyDirection
-	AttributeKeyClaimSender        = types.AttributeKeyClaimSender
-	AttributeKeyRandomNumber       = types.AttributeKeyRandomNumber
-	AttributeKeyRefundSender       = types.AttributeKeyRefundSender
-	AttributeKeyAtomicSwapIDs      = types.AttributeKeyAtomicSwapIDs
-	AttributeExpirationBlock       = types.AttributeExpirationBlock
-	ModuleName                     = types.ModuleName
-	StoreKey                       = types.StoreKey
-	RouterKey                      = types.RouterKey
-	QuerierRoute                   = types.QuerierRoute
-	DefaultParamspace              = types.DefaultParamspace
-	DefaultLongtermStorageDuration = types.DefaultLongtermStorageDuration
-	CreateAtomicSwap               = types.CreateAtomicSwap
-	ClaimAtomicSwap                = types.ClaimAtomicSwap
-	RefundAtomicSwap               = types.RefundAtomicSwap
-	CalcSwapID                     = types.CalcSwapID
-	Int64Size                      = types.Int64Size
-	RandomNumberHashLength         = types.RandomNumberHashLength
-	RandomNumberLength             = types.RandomNumberLength
-	AddrByteCount                  = types.AddrByteCount
-	MaxOtherChainAddrLength        = types.MaxOtherChainAddrLength
-	SwapIDLength                   = types.SwapIDLength
-	MaxExpectedIncomeLength        = types.MaxExpectedIncomeLength
-	QueryGetAssetSupply            = types.QueryGetAssetSupply
-	QueryGetAssetSupplies          = types.QueryGetAssetSupplies
-	QueryGetAtomicSwap             = types.QueryGetAtomicSwap
-	QueryGetAtomicSwaps            = types.QueryGetAtomicSwaps
-	QueryGetParams                 = types.QueryGetParams
-	NULL                           = types.NULL
-	Open                           = types.Open
-	Completed                      = types.Completed
-	Expired                        = types.Expired
-	INVALID                        = types.INVALID
-	Incoming                       = types.Incoming
-	Outgoing                       = types.Outgoing
+	EventTypeCreateAtomicSwap        = types.EventTypeCreateAtomicSwap
+	EventTypeClaimAtomicSwap         = types.EventTypeClaimAtomicSwap
+	EventTypeRefundAtomicSwap        = types.EventTypeRefundAtomicSwap
+	EventTypeSwapsExpired            = types.EventTypeSwapsExpired
+	EventTypeSwapExpired             = types.EventTypeSwapExpired
+	EventTypeSwapDeleted             = types.EventTypeSwapDeleted
+	EventTypeDeputyRotationActivated = types.EventTypeDeputyRotationActivated
+	EventTypeDeputyRotationCompleted = types.EventTypeDeputyRotationCompleted
+	AttributeValueCategory           = types.AttributeValueCategory
+	AttributeKeySender               = types.AttributeKeySender
+	AttributeKeyRecipient            = types.AttributeKeyRecipient
+	AttributeKeyAtomicSwapID         = types.AttributeKeyAtomicSwapID
+	AttributeKeyRandomNumberHash     = types.AttributeKeyRandomNumberHash
+	AttributeKeyTimestamp            = types.AttributeKeyTimestamp
+	AttributeKeySenderOtherChain     = types.AttributeKeySenderOtherChain
+	AttributeKeyExpireHeight         = types.AttributeKeyExpireHeight
+	AttributeKeyExpireTime           = types.AttributeKeyExpireTime
+	AttributeKeyAmount               = types.AttributeKeyAmount
+	AttributeKeyDirection            = types.AttributeKeyDirection
+	AttributeKeyStatus               = types.AttributeKeyStatus
+	AttributeKeyClosedBlock          = types.AttributeKeyClosedBlock
+	AttributeKeyChainID              = types.AttributeKeyChainID
+	AttributeKeyClaimSender          = types.AttributeKeyClaimSender
+	AttributeKeyRandomNumber         = types.AttributeKeyRandomNumber
+	AttributeKeyRefundSender         = types.AttributeKeyRefundSender
+	AttributeKeyAtomicSwapIDs        = types.AttributeKeyAtomicSwapIDs
+	AttributeExpirationBlock         = types.AttributeExpirationBlock
+	AttributeKeyDenom                = types.AttributeKeyDenom
+	AttributeKeyOldDeputy            = types.AttributeKeyOldDeputy
+	AttributeKeyNewDeputy            = types.AttributeKeyNewDeputy
+	AttributeKeyOverlapEndHeight     = types.AttributeKeyOverlapEndHeight
+	ModuleName                       = types.ModuleName
+	StoreKey                         = types.StoreKey
+	RouterKey                        = types.RouterKey
+	QuerierRoute                     = types.QuerierRoute
+	DefaultParamspace                = types.DefaultParamspace
+	DefaultLongtermStorageDuration   = types.DefaultLongtermStorageDuration
+	AbsoluteMaxTimeLock              = types.AbsoluteMaxTimeLock
+	CreateAtomicSwap                 = types.CreateAtomicSwap
+	ClaimAtomicSwap                  = types.ClaimAtomicSwap
+	RefundAtomicSwap                 = types.RefundAtomicSwap
+	CalcSwapID                       = types.CalcSwapID
+	Int64Size                        = types.Int64Size
+	RandomNumberHashLength           = types.RandomNumberHashLength
+	RandomNumberLength               = types.RandomNumberLength
+	AddrByteCount                    = types.AddrByteCount
+	MaxOtherChainAddrLength          = types.MaxOtherChainAddrLength
+	SwapIDLength                     = types.SwapIDLength
+	MaxExpectedIncomeLength          = types.MaxExpectedIncomeLength
+	MaxTimeSpan                      = types.MaxTimeSpan
+	QueryGetAssetSupply              = types.QueryGetAssetSupply
+	QueryGetAssetSupplies            = types.QueryGetAssetSupplies
+	QueryGetAtomicSwap               = types.QueryGetAtomicSwap
+	QueryGetAtomicSwaps              = types.QueryGetAtomicSwaps
+	QueryGetParams                   = types.QueryGetParams
+	QueryGetDeputyRotations          = types.QueryGetDeputyRotations
+	QueryGetAddressQuota             = types.QueryGetAddressQuota
+	QueryGetAtomicSwapUpdates        = types.QueryGetAtomicSwapUpdates
+	NULL                             = types.NULL
+	Open                             = types.Open
+	Completed                        = types.Completed
+	Expired                          = types.Expired
+	INVALID                          = types.INVALID
+	Incoming                         = types.Incoming
+	Outgoing                         = types.Outgoing
 )
 
 var (
 	// functions aliases
 	NewKeeper                  = keeper.NewKeeper
 	NewQuerier                 = keeper.NewQuerier
+	RegisterInvariants         = keeper.RegisterInvariants
+	ModuleAccountInvariant     = keeper.ModuleAccountInvariant
+	AssetSuppliesInvariant     = keeper.AssetSuppliesInvariant
+	SupplyLimitsInvariant      = keeper.SupplyLimitsInvariant
 	NewAssetSupply             = types.NewAssetSupply
+	NewChainSupply             = types.NewChainSupply
 	RegisterCodec              = types.RegisterCodec
 	NewGenesisState            = types.NewGenesisState
 	DefaultGenesisState        = types.DefaultGenesisState
@@ -74,21 +96,44 @@ var (
 	CalculateRandomHash        = types.CalculateRandomHash
 	CalculateSwapID            = types.CalculateSwapID
 	GetAtomicSwapByHeightKey   = types.GetAtomicSwapByHeightKey
+	GetAtomicSwapByTimeKey     = types.GetAtomicSwapByTimeKey
+	GetAddressQuotaUsageKey    = types.GetAddressQuotaUsageKey
+	GetChainSupplyKey          = types.GetChainSupplyKey
+	SplitAddressQuotaUsageKey  = types.SplitAddressQuotaUsageKey
 	NewMsgCreateAtomicSwap     = types.NewMsgCreateAtomicSwap
 	NewMsgClaimAtomicSwap      = types.NewMsgClaimAtomicSwap
 	NewMsgRefundAtomicSwap     = types.NewMsgRefundAtomicSwap
 	NewParams                  = types.NewParams
 	DefaultParams              = types.DefaultParams
 	NewAssetParam              = types.NewAssetParam
+	NewDeputyRotation          = types.NewDeputyRotation
+	NewChainParam              = types.NewChainParam
+	NewTimeExpiry              = types.NewTimeExpiry
 	ParamKeyTable              = types.ParamKeyTable
 	NewQueryAssetSupply        = types.NewQueryAssetSupply
 	NewQueryAssetSupplies      = types.NewQueryAssetSupplies
 	NewQueryAtomicSwapByID     = types.NewQueryAtomicSwapByID
 	NewQueryAtomicSwaps        = types.NewQueryAtomicSwaps
+	NewQueryAtomicSwapUpdates  = types.NewQueryAtomicSwapUpdates
+	NewAtomicSwapUpdate        = types.NewAtomicSwapUpdate
+	NewDeletedAtomicSwapUpdate = types.NewDeletedAtomicSwapUpdate
+	NewPendingDeputyRotation   = types.NewPendingDeputyRotation
+	NewQueryAddressQuota       = types.NewQueryAddressQuota
+	NewSwapQuota               = types.NewSwapQuota
+	NewQuotaLimit              = types.NewQuotaLimit
+	NewQuotaRecord             = types.NewQuotaRecord
+	NewQuotaUsage              = types.NewQuotaUsage
+	NewAddressQuotaUsage       = types.NewAddressQuotaUsage
+	NewOutgoingQuotaUsage      = types.NewOutgoingQuotaUsage
+	NewAddressQuota            = types.NewAddressQuota
 	NewAtomicSwap              = types.NewAtomicSwap
 	NewSwapStatusFromString    = types.NewSwapStatusFromString
 	NewSwapDirectionFromString = types.NewSwapDirectionFromString
 	NewAugmentedAtomicSwap     = types.NewAugmentedAtomicSwap
+	UnsettledSwapAmounts       = types.UnsettledSwapAmounts
+	ReconcileModuleAccount     = types.ReconcileModuleAccount
+	ReconcileAssetSupplies     = types.ReconcileAssetSupplies
+	ReconcileSupplyLimits      = types.ReconcileSupplyLimits
 
 	// variable aliases
 	ModuleCdc                       = types.ModuleCdc
@@ -110,11 +155,29 @@ var (
 	ErrSwapNotClaimable             = types.ErrSwapNotClaimable
 	ErrInvalidAmount                = types.ErrInvalidAmount
 	ErrInvalidSwapAccount           = types.ErrInvalidSwapAccount
+	ErrExceedsTimeBasedSupplyLimit  = types.ErrExceedsTimeBasedSupplyLimit
+	ErrChainNotSupported            = types.ErrChainNotSupported
+	ErrAssetNotSupportedOnChain     = types.ErrAssetNotSupportedOnChain
+	ErrInvalidOtherChainAddress     = types.ErrInvalidOtherChainAddress
+	ErrExceedsAddressQuota          = types.ErrExceedsAddressQuota
+	ErrExceedsOutgoingQuota         = types.ErrExceedsOutgoingQuota
+	ErrInvalidTimeSpan              = types.ErrInvalidTimeSpan
+	ErrExceedsChainSupplyLimit      = types.ErrExceedsChainSupplyLimit
+	ErrExceedsAvailableChainSupply  = types.ErrExceedsAvailableChainSupply
 	AtomicSwapKeyPrefix             = types.AtomicSwapKeyPrefix
 	AtomicSwapByBlockPrefix         = types.AtomicSwapByBlockPrefix
 	AtomicSwapLongtermStoragePrefix = types.AtomicSwapLongtermStoragePrefix
+	AddressQuotaUsagePrefix         = types.AddressQuotaUsagePrefix
+	OutgoingQuotaUsagePrefix        = types.OutgoingQuotaUsagePrefix
+	AtomicSwapByUpdatePrefix        = types.AtomicSwapByUpdatePrefix
+	AtomicSwapUpdateHeightPrefix    = types.AtomicSwapUpdateHeightPrefix
+	AtomicSwapByTimePrefix          = types.AtomicSwapByTimePrefix
+	ChainSupplyPrefix               = types.ChainSupplyPrefix
+	DeputyRotationActivationPrefix  = types.DeputyRotationActivationPrefix
+	AtomicSwapTombstonePrefix       = types.AtomicSwapTombstonePrefix
 	AtomicSwapCoinsAccAddr          = types.AtomicSwapCoinsAccAddr
 	KeyAssetParams                  = types.KeyAssetParams
+	KeyChainParams                  = types.KeyChainParams
 	DefaultBnbDeputyFixedFee        = types.DefaultBnbDeputyFixedFee
 	DefaultMinAmount                = types.DefaultMinAmount
 	DefaultMaxAmount                = types.DefaultMaxAmount
@@ -122,28 +185,51 @@ var (
 	DefaultMaxBlockLock             = types.DefaultMaxBlockLock
 	DefaultPreviousBlockTime        = types.DefaultPreviousBlockTime
 	ModulePermissionsUpgradeTime    = types.ModulePermissionsUpgradeTime
+	MaxQuotaRemaining               = types.MaxQuotaRemaining
 )
 
 type (
-	Keeper               = keeper.Keeper
-	AssetSupply          = types.AssetSupply
-	AssetSupplies        = types.AssetSupplies
-	GenesisState         = types.GenesisState
-	MsgCreateAtomicSwap  = types.MsgCreateAtomicSwap
-	MsgClaimAtomicSwap   = types.MsgClaimAtomicSwap
-	MsgRefundAtomicSwap  = types.MsgRefundAtomicSwap
-	Params               = types.Params
-	AssetParam           = types.AssetParam
-	AssetParams          = types.AssetParams
-	QueryAssetSupply     = types.QueryAssetSupply
-	QueryAssetSupplies   = types.QueryAssetSupplies
-	QueryAtomicSwapByID  = types.QueryAtomicSwapByID
-	QueryAtomicSwaps     = types.QueryAtomicSwaps
-	AtomicSwap           = types.AtomicSwap
-	AtomicSwaps          = types.AtomicSwaps
-	SwapStatus           = types.SwapStatus
-	SwapDirection        = types.SwapDirection
-	SupplyLimit          = types.SupplyLimit
-	AugmentedAtomicSwap  = types.AugmentedAtomicSwap
-	AugmentedAtomicSwaps = types.AugmentedAtomicSwaps
+	Keeper                 = keeper.Keeper
+	AssetSupply            = types.AssetSupply
+	AssetSupplies          = types.AssetSupplies
+	ChainSupply            = types.ChainSupply
+	ChainSupplies          = types.ChainSupplies
+	GenesisState           = types.GenesisState
+	MsgCreateAtomicSwap    = types.MsgCreateAtomicSwap
+	MsgClaimAtomicSwap     = types.MsgClaimAtomicSwap
+	MsgRefundAtomicSwap    = types.MsgRefundAtomicSwap
+	Params                 = types.Params
+	AssetParam             = types.AssetParam
+	AssetParams            = types.AssetParams
+	DeputyRotation         = types.DeputyRotation
+	ChainParam             = types.ChainParam
+	ChainParams            = types.ChainParams
+	TimeExpiry             = types.TimeExpiry
+	QueryAssetSupply       = types.QueryAssetSupply
+	QueryAssetSupplies     = types.QueryAssetSupplies
+	QueryAtomicSwapByID    = types.QueryAtomicSwapByID
+	QueryAtomicSwaps       = types.QueryAtomicSwaps
+	QueryAtomicSwapUpdates = types.QueryAtomicSwapUpdates
+	AtomicSwapUpdate       = types.AtomicSwapUpdate
+	AtomicSwapUpdates      = types.AtomicSwapUpdates
+	PendingDeputyRotation  = types.PendingDeputyRotation
+	PendingDeputyRotations = types.PendingDeputyRotations
+	QueryAddressQuota      = types.QueryAddressQuota
+	SwapQuota              = types.SwapQuota
+	QuotaLimit             = types.QuotaLimit
+	QuotaRecord            = types.QuotaRecord
+	QuotaRecords           = types.QuotaRecords
+	QuotaUsage             = types.QuotaUsage
+	AddressQuotaUsage      = types.AddressQuotaUsage
+	AddressQuotaUsages     = types.AddressQuotaUsages
+	OutgoingQuotaUsage     = types.OutgoingQuotaUsage
+	OutgoingQuotaUsages    = types.OutgoingQuotaUsages
+	AddressQuota           = types.AddressQuota
+	AtomicSwap             = types.AtomicSwap
+	AtomicSwaps            = types.AtomicSwaps
+	SwapStatus             = types.SwapStatus
+	SwapDirection          = types.SwapDirection
+	SupplyLimit            = types.SupplyLimit
+	AugmentedAtomicSwap    = types.AugmentedAtomicSwap
+	AugmentedAtomicSwaps   = types.AugmentedAtomicSwaps
 )
@@ -44,7 +44,10 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		QueryGetAssetSuppliesCmd(queryRoute, cdc),
 		QueryGetAtomicSwapCmd(queryRoute, cdc),
 		QueryGetAtomicSwapsCmd(queryRoute, cdc),
+		QueryGetAtomicSwapUpdatesCmd(queryRoute, cdc),
 		QueryParamsCmd(queryRoute, cdc),
+		QueryDeputyRotationsCmd(queryRoute, cdc),
+		QueryGetAddressQuotaCmd(queryRoute, cdc),
 	)...)
 
 	return bep3QueryCmd
@@ -311,6 +314,54 @@ $ kvcli q bep3 swaps --page=2 --limit=100
 	return cmd
 }
 
+// QueryGetAtomicSwapUpdatesCmd queries the atomic swaps updated at or after a block height
+func QueryGetAtomicSwapUpdatesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap-updates [height]",
+		Short: "query atomic swaps updated at or after a block height",
+		Long: strings.TrimSpace(`Query for all paginated atomic swaps that were created or changed status at or after a block height, ordered by the height they were last updated at.
+Swaps removed from the store are not returned.
+Example:
+$ kvcli q bep3 swap-updates 2000
+$ kvcli q bep3 swap-updates 2000 --page=2 --limit=100
+`,
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// Prepare query params
+			params := types.NewQueryAtomicSwapUpdates(viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit), height)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			res, resHeight, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAtomicSwapUpdates), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var updates types.AtomicSwapUpdates
+			cdc.MustUnmarshalJSON(res, &updates)
+			cliCtx = cliCtx.WithHeight(resHeight)
+			return cliCtx.PrintOutput(updates)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of atomic swaps to to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of atomic swaps to query for")
+
+	return cmd
+}
+
 // QueryParamsCmd queries the bep3 module parameters
 func QueryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -335,3 +386,65 @@ func QueryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// QueryDeputyRotationsCmd queries the scheduled bep3 deputy rotations
+func QueryDeputyRotationsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "deputy-rotations",
+		Short:   "get the scheduled deputy rotations of all assets",
+		Example: "bep3 deputy-rotations",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetDeputyRotations)
+			res, height, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.PendingDeputyRotations
+			cdc.MustUnmarshalJSON(res, &out)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// QueryGetAddressQuotaCmd queries the amounts an address can still swap in the current quota window
+func QueryGetAddressQuotaCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:     "quota [denom] [address]",
+		Short:   "get the remaining swap quota of an address",
+		Example: "bep3 quota bnb kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			address, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			// Prepare query params
+			bz, err := cdc.MarshalJSON(types.NewQueryAddressQuota(args[0], address))
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAddressQuota), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var quota types.AddressQuota
+			cdc.MustUnmarshalJSON(res, &quota)
+			cliCtx = cliCtx.WithHeight(height)
+			return cliCtx.PrintOutput(quota)
+		},
+	}
+}
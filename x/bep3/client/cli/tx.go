@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -23,6 +24,12 @@ import (
 	"github.com/kava-labs/kava/x/bep3/types"
 )
 
+// Create atomic swap flags
+const (
+	flagTargetChain = "target-chain"
+	flagTimeSpan    = "time-span"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	bep3TxCmd := &cobra.Command{
@@ -44,7 +51,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 // GetCmdCreateAtomicSwap cli command for creating atomic swaps
 func GetCmdCreateAtomicSwap(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "create [to] [recipient-other-chain] [sender-other-chain] [timestamp] [coins] [height-span]",
 		Short: "create a new atomic swap",
 		Example: fmt.Sprintf("%s tx %s create kava1xy7hrjy9r0algz9w3gzm8u6mrpq97kwta747gj bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7 bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7 now 100bnb 270 --from validator",
@@ -98,10 +105,13 @@ func GetCmdCreateAtomicSwap(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			targetChain := viper.GetString(flagTargetChain)
+
 			msg := types.NewMsgCreateAtomicSwap(
 				from, to, recipientOtherChain, senderOtherChain,
-				randomNumberHash, timestamp, coins, heightSpan,
+				randomNumberHash, timestamp, coins, heightSpan, targetChain,
 			)
+			msg.TimeSpan = viper.GetUint64(flagTimeSpan)
 
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -111,6 +121,10 @@ func GetCmdCreateAtomicSwap(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().String(flagTargetChain, "", "(optional) chain ID of the counterparty chain, defaults to the asset's own deputy")
+	cmd.Flags().Uint64(flagTimeSpan, 0, "(optional) seconds until the swap expires, required for assets with time based expiry")
+	return cmd
 }
 
 // GetCmdClaimAtomicSwap cli command for claiming an atomic swap
@@ -17,6 +17,8 @@ const (
 	RestInvolve    = "involve"
 	RestStatus     = "status"
 	RestDirection  = "direction"
+	RestSince      = "since"
+	RestTimeout    = "timeout"
 )
 
 // RegisterRoutes registers bep3-related REST handlers to a router
@@ -37,6 +39,8 @@ type PostCreateSwapReq struct {
 	Amount              sdk.Coins        `json:"amount" yaml:"amount"`
 	HeightSpan          uint64           `json:"height_span" yaml:"height_span"`
 	CrossChain          bool             `json:"cross_chain" yaml:"cross_chain"`
+	ChainID             string           `json:"chain_id" yaml:"chain_id"`
+	TimeSpan            uint64           `json:"time_span" yaml:"time_span"`
 }
 
 // PostClaimSwapReq defines the properties of a swap claim request's body
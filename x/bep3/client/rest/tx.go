@@ -42,7 +42,9 @@ func postCreateHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			req.Timestamp,
 			req.Amount,
 			req.HeightSpan,
+			req.ChainID,
 		)
+		msg.TimeSpan = req.TimeSpan
 		if err := msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
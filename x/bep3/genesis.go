@@ -26,9 +26,20 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	for _, supply := range gs.Supplies {
 		keeper.SetAssetSupply(ctx, supply, supply.GetDenom())
 	}
+	for _, supply := range gs.ChainSupplies {
+		keeper.SetChainSupply(ctx, supply)
+	}
+	for _, usage := range gs.AddressQuotaUsages {
+		keeper.SetAddressQuotaUsage(ctx, usage.Denom, usage.Address, usage.Usage)
+	}
+	for _, usage := range gs.OutgoingQuotaUsages {
+		keeper.SetOutgoingQuotaUsage(ctx, usage.Denom, usage.Usage)
+	}
 
 	var incomingSupplies sdk.Coins
 	var outgoingSupplies sdk.Coins
+	chainIncomingSupplies := make(map[string]sdk.Coins)
+	chainOutgoingSupplies := make(map[string]sdk.Coins)
 	for _, swap := range gs.AtomicSwaps {
 		if swap.Validate() != nil {
 			panic(fmt.Sprintf("invalid swap %s", swap.GetSwapID()))
@@ -42,17 +53,19 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 
 		keeper.SetAtomicSwap(ctx, swap)
 
-		// Add swap to block index or longterm storage based on swap.Status
+		// Add swap to expiry index or longterm storage based on swap.Status
 		// Increment incoming or outgoing supply based on swap.Direction
 		switch swap.Direction {
 		case Incoming:
 			switch swap.Status {
 			case Open:
 				// This index expires unclaimed swaps
-				keeper.InsertIntoByBlockIndex(ctx, swap)
+				keeper.InsertIntoExpiryIndex(ctx, swap)
 				incomingSupplies = incomingSupplies.Add(swap.Amount...)
+				chainIncomingSupplies[swap.ChainID] = chainIncomingSupplies[swap.ChainID].Add(swap.Amount...)
 			case Expired:
 				incomingSupplies = incomingSupplies.Add(swap.Amount...)
+				chainIncomingSupplies[swap.ChainID] = chainIncomingSupplies[swap.ChainID].Add(swap.Amount...)
 			case Completed:
 				// This index stores swaps until deletion
 				keeper.InsertIntoLongtermStorage(ctx, swap)
@@ -62,10 +75,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 		case Outgoing:
 			switch swap.Status {
 			case Open:
-				keeper.InsertIntoByBlockIndex(ctx, swap)
+				keeper.InsertIntoExpiryIndex(ctx, swap)
 				outgoingSupplies = outgoingSupplies.Add(swap.Amount...)
+				chainOutgoingSupplies[swap.ChainID] = chainOutgoingSupplies[swap.ChainID].Add(swap.Amount...)
 			case Expired:
 				outgoingSupplies = outgoingSupplies.Add(swap.Amount...)
+				chainOutgoingSupplies[swap.ChainID] = chainOutgoingSupplies[swap.ChainID].Add(swap.Amount...)
 			case Completed:
 				keeper.InsertIntoLongtermStorage(ctx, swap)
 			default:
@@ -107,6 +122,38 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 		}
 
 	}
+
+	// Chain's incoming/outgoing supply must match the amount of coins in the chain's incoming/outgoing atomic swaps
+	for _, supply := range keeper.GetAllChainSupplies(ctx) {
+		chain, err := keeper.GetChain(ctx, supply.ChainID)
+		if err != nil {
+			panic(err)
+		}
+		incomingSupply := chainIncomingSupplies[supply.ChainID].AmountOf(supply.GetDenom())
+		if !supply.IncomingSupply.Amount.Equal(incomingSupply) {
+			panic(fmt.Sprintf("chain %s incoming supply %s does not match amount %s in incoming atomic swaps",
+				supply.ChainID, supply.IncomingSupply, incomingSupply))
+		}
+		outgoingSupply := chainOutgoingSupplies[supply.ChainID].AmountOf(supply.GetDenom())
+		if !supply.OutgoingSupply.Amount.Equal(outgoingSupply) {
+			panic(fmt.Sprintf("chain %s outgoing supply %s does not match amount %s in outgoing atomic swaps",
+				supply.ChainID, supply.OutgoingSupply, outgoingSupply))
+		}
+		limit, found := chain.SupplyLimit(supply.GetDenom())
+		if found && supply.IncomingSupply.Amount.Add(supply.CurrentSupply.Amount).GT(limit) {
+			panic(fmt.Sprintf("chain %s incoming supply + current supply %s is over the supply limit %s",
+				supply.ChainID, supply.IncomingSupply.Add(supply.CurrentSupply), limit))
+		}
+	}
+	// Swaps relayed by an asset's own deputy have no chain ID and are not tracked per chain
+	for _, swap := range gs.AtomicSwaps {
+		if swap.ChainID == "" || swap.Status == Completed {
+			continue
+		}
+		if _, found := keeper.GetChainSupply(ctx, swap.ChainID, swap.Amount[0].Denom); !found {
+			panic(fmt.Sprintf("swap %s has no supply for chain %s", swap.GetSwapID(), swap.ChainID))
+		}
+	}
 }
 
 // ExportGenesis writes the current store values to a genesis file, which can be imported again with InitGenesis
@@ -114,9 +161,12 @@ func ExportGenesis(ctx sdk.Context, k Keeper) (data GenesisState) {
 	params := k.GetParams(ctx)
 	swaps := k.GetAllAtomicSwaps(ctx)
 	supplies := k.GetAllAssetSupplies(ctx)
+	chainSupplies := k.GetAllChainSupplies(ctx)
+	addressQuotaUsages := k.GetAllAddressQuotaUsages(ctx)
+	outgoingQuotaUsages := k.GetAllOutgoingQuotaUsages(ctx)
 	previousBlockTime, found := k.GetPreviousBlockTime(ctx)
 	if !found {
 		previousBlockTime = DefaultPreviousBlockTime
 	}
-	return NewGenesisState(params, swaps, supplies, previousBlockTime)
+	return NewGenesisState(params, swaps, supplies, chainSupplies, addressQuotaUsages, outgoingQuotaUsages, previousBlockTime)
 }
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/kava-labs/kava/app"
@@ -24,13 +25,14 @@ type GenesisTestSuite struct {
 }
 
 func (suite *GenesisTestSuite) SetupTest() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	suite.addrs = addrs
+
 	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates(NewBep3GenStateMulti(addrs[0]))
 	suite.ctx = tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
 	suite.keeper = tApp.GetBep3Keeper()
 	suite.app = tApp
-
-	_, addrs := app.GeneratePrivKeyAddressPairs(3)
-	suite.addrs = addrs
 }
 
 func (suite *GenesisTestSuite) TestGenesisState() {
@@ -118,7 +120,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := bep3.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := bep3.NewAtomicSwap(cs(c("bnb", overLimitAmount.Int64())), randomNumberHash,
 					bep3.DefaultMinBlockLock, timestamp, suite.addrs[0], addrs[1], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, bep3.Open, true, bep3.Incoming)
+					TestRecipientOtherChain, 0, bep3.Open, true, bep3.Incoming, "")
 				gs.AtomicSwaps = bep3.AtomicSwaps{swap}
 
 				// Set up asset supply with overlimit current supply
@@ -149,7 +151,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := bep3.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := bep3.NewAtomicSwap(cs(c("bnb", halfLimit)), randomNumberHash,
 					uint64(360), timestamp, suite.addrs[0], addrs[1], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, bep3.Open, true, bep3.Incoming)
+					TestRecipientOtherChain, 0, bep3.Open, true, bep3.Incoming, "")
 				gs.AtomicSwaps = bep3.AtomicSwaps{swap}
 
 				// Set up asset supply with overlimit current supply
@@ -179,7 +181,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := bep3.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := bep3.NewAtomicSwap(cs(c("bnb", overLimitAmount.Int64())), randomNumberHash,
 					bep3.DefaultMinBlockLock, timestamp, addrs[1], suite.addrs[0], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, bep3.Open, true, bep3.Outgoing)
+					TestRecipientOtherChain, 0, bep3.Open, true, bep3.Outgoing, "")
 				gs.AtomicSwaps = bep3.AtomicSwaps{swap}
 
 				// Set up asset supply with overlimit current supply
@@ -219,7 +221,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := bep3.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := bep3.NewAtomicSwap(cs(c("fake", 500000)), randomNumberHash,
 					uint64(360), timestamp, suite.addrs[0], addrs[1], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, bep3.Open, true, bep3.Incoming)
+					TestRecipientOtherChain, 0, bep3.Open, true, bep3.Incoming, "")
 
 				gs.AtomicSwaps = bep3.AtomicSwaps{swap}
 				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(gs)}
@@ -236,7 +238,7 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 				randomNumberHash := bep3.CalculateRandomHash(randomNumber[:], timestamp)
 				swap := bep3.NewAtomicSwap(cs(c("bnb", 5000)), randomNumberHash,
 					uint64(360), timestamp, suite.addrs[0], addrs[1], TestSenderOtherChain,
-					TestRecipientOtherChain, 0, bep3.NULL, true, bep3.Incoming)
+					TestRecipientOtherChain, 0, bep3.NULL, true, bep3.Incoming, "")
 
 				gs.AtomicSwaps = bep3.AtomicSwaps{swap}
 				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(gs)}
@@ -271,6 +273,32 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 			},
 			expectPass: false,
 		},
+		{
+			name: "import quota usages",
+			genState: func() app.GenesisState {
+				gs := baseGenState(suite.addrs[0])
+				record := bep3.NewQuotaRecord(tmhash.Sum([]byte("swap")), tmtime.Now(), i(1000))
+				gs.AddressQuotaUsages = bep3.AddressQuotaUsages{
+					bep3.NewAddressQuotaUsage("bnb", suite.addrs[1], bep3.NewQuotaUsage(bep3.QuotaRecords{record}, nil)),
+				}
+				gs.OutgoingQuotaUsages = bep3.OutgoingQuotaUsages{
+					bep3.NewOutgoingQuotaUsage("bnb", bep3.NewQuotaUsage(nil, bep3.QuotaRecords{record})),
+				}
+				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(gs)}
+			},
+			expectPass: true,
+		},
+		{
+			name: "duplicate address quota usage",
+			genState: func() app.GenesisState {
+				gs := baseGenState(suite.addrs[0])
+				record := bep3.NewQuotaRecord(tmhash.Sum([]byte("swap")), tmtime.Now(), i(1000))
+				usage := bep3.NewAddressQuotaUsage("bnb", suite.addrs[1], bep3.NewQuotaUsage(bep3.QuotaRecords{record}, nil))
+				gs.AddressQuotaUsages = bep3.AddressQuotaUsages{usage, usage}
+				return app.GenesisState{"bep3": bep3.ModuleCdc.MustMarshalJSON(gs)}
+			},
+			expectPass: false,
+		},
 		{
 			name: "duplicate supported asset denom",
 			genState: func() app.GenesisState {
@@ -284,13 +312,15 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
+			// use a new app for each case so the invariants don't see state from earlier cases
+			tApp := app.NewTestApp()
 			if tc.expectPass {
 				suite.NotPanics(func() {
-					suite.app.InitializeFromGenesisStates(tc.genState())
+					tApp.InitializeFromGenesisStates(tc.genState())
 				}, tc.name)
 			} else {
 				suite.Panics(func() {
-					suite.app.InitializeFromGenesisStates(tc.genState())
+					tApp.InitializeFromGenesisStates(tc.genState())
 				}, tc.name)
 			}
 		})
@@ -298,6 +328,17 @@ func (suite *GenesisTestSuite) TestGenesisState() {
 	}
 }
 
+func (suite *GenesisTestSuite) TestExportQuotaUsages() {
+	record := bep3.NewQuotaRecord(tmhash.Sum([]byte("swap")), suite.ctx.BlockTime(), i(1000))
+	usage := bep3.NewQuotaUsage(nil, bep3.QuotaRecords{record})
+	suite.keeper.SetAddressQuotaUsage(suite.ctx, "bnb", suite.addrs[1], usage)
+	suite.keeper.SetOutgoingQuotaUsage(suite.ctx, "bnb", usage)
+
+	gs := bep3.ExportGenesis(suite.ctx, suite.keeper)
+	suite.Equal(bep3.AddressQuotaUsages{bep3.NewAddressQuotaUsage("bnb", suite.addrs[1], usage)}, gs.AddressQuotaUsages)
+	suite.Equal(bep3.OutgoingQuotaUsages{bep3.NewOutgoingQuotaUsage("bnb", usage)}, gs.OutgoingQuotaUsages)
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
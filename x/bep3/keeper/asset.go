@@ -182,3 +182,113 @@ func (k Keeper) UpdateTimeBasedSupplyLimits(ctx sdk.Context) {
 	}
 	k.SetPreviousBlockTime(ctx, ctx.BlockTime())
 }
+
+// getChainSupply returns the supply of an asset that has been swapped with a counterparty chain, or an empty supply if there is none
+func (k Keeper) getChainSupply(ctx sdk.Context, chainID, denom string) types.ChainSupply {
+	supply, found := k.GetChainSupply(ctx, chainID, denom)
+	if !found {
+		zero := sdk.NewCoin(denom, sdk.ZeroInt())
+		supply = types.NewChainSupply(chainID, zero, zero, zero)
+	}
+	return supply
+}
+
+// IncrementIncomingChainSupply increments the incoming supply of an asset from a counterparty chain.
+// Swaps relayed by an asset's own deputy, which have no chain ID, are not tracked per chain.
+func (k Keeper) IncrementIncomingChainSupply(ctx sdk.Context, chainID string, coin sdk.Coin) error {
+	if chainID == "" {
+		return nil
+	}
+	chain, err := k.GetChain(ctx, chainID)
+	if err != nil {
+		return err
+	}
+	supply := k.getChainSupply(ctx, chainID, coin.Denom)
+
+	// Result of (current + incoming + amount) must be under the chain's limit, if it has one
+	if limit, found := chain.SupplyLimit(coin.Denom); found {
+		totalSupply := supply.CurrentSupply.Add(supply.IncomingSupply)
+		supplyLimit := sdk.NewCoin(coin.Denom, limit)
+		if supplyLimit.IsLT(totalSupply.Add(coin)) {
+			return sdkerrors.Wrapf(types.ErrExceedsChainSupplyLimit, "chain %s, increase %s, chain supply %s, limit %s", chainID, coin, totalSupply, supplyLimit)
+		}
+	}
+
+	supply.IncomingSupply = supply.IncomingSupply.Add(coin)
+	k.SetChainSupply(ctx, supply)
+	return nil
+}
+
+// DecrementIncomingChainSupply decrements the incoming supply of an asset from a counterparty chain
+func (k Keeper) DecrementIncomingChainSupply(ctx sdk.Context, chainID string, coin sdk.Coin) error {
+	if chainID == "" {
+		return nil
+	}
+	supply := k.getChainSupply(ctx, chainID, coin.Denom)
+	if supply.IncomingSupply.Amount.Sub(coin.Amount).IsNegative() {
+		return sdkerrors.Wrapf(types.ErrInvalidIncomingSupply, "chain %s, decrease %s, incoming supply %s", chainID, coin, supply.IncomingSupply)
+	}
+
+	supply.IncomingSupply = supply.IncomingSupply.Sub(coin)
+	k.SetChainSupply(ctx, supply)
+	return nil
+}
+
+// IncrementCurrentChainSupply increments the current supply of an asset from a counterparty chain.
+// The chain's limit is checked when the incoming swap is created.
+func (k Keeper) IncrementCurrentChainSupply(ctx sdk.Context, chainID string, coin sdk.Coin) error {
+	if chainID == "" {
+		return nil
+	}
+	supply := k.getChainSupply(ctx, chainID, coin.Denom)
+	supply.CurrentSupply = supply.CurrentSupply.Add(coin)
+	k.SetChainSupply(ctx, supply)
+	return nil
+}
+
+// DecrementCurrentChainSupply decrements the current supply of an asset from a counterparty chain
+func (k Keeper) DecrementCurrentChainSupply(ctx sdk.Context, chainID string, coin sdk.Coin) error {
+	if chainID == "" {
+		return nil
+	}
+	supply := k.getChainSupply(ctx, chainID, coin.Denom)
+	if supply.CurrentSupply.Amount.Sub(coin.Amount).IsNegative() {
+		return sdkerrors.Wrapf(types.ErrInvalidCurrentSupply, "chain %s, decrease %s, chain supply %s", chainID, coin, supply.CurrentSupply)
+	}
+
+	supply.CurrentSupply = supply.CurrentSupply.Sub(coin)
+	k.SetChainSupply(ctx, supply)
+	return nil
+}
+
+// IncrementOutgoingChainSupply increments the outgoing supply of an asset to a counterparty chain.
+// An asset can only be swapped out to a chain up to the amount that was swapped in from it.
+func (k Keeper) IncrementOutgoingChainSupply(ctx sdk.Context, chainID string, coin sdk.Coin) error {
+	if chainID == "" {
+		return nil
+	}
+	supply := k.getChainSupply(ctx, chainID, coin.Denom)
+	if supply.CurrentSupply.IsLT(supply.OutgoingSupply.Add(coin)) {
+		return sdkerrors.Wrapf(types.ErrExceedsAvailableChainSupply, "chain %s, swap amount %s, available supply %s", chainID, coin,
+			supply.CurrentSupply.Amount.Sub(supply.OutgoingSupply.Amount))
+	}
+
+	supply.OutgoingSupply = supply.OutgoingSupply.Add(coin)
+	k.SetChainSupply(ctx, supply)
+	return nil
+}
+
+// DecrementOutgoingChainSupply decrements the outgoing supply of an asset to a counterparty chain
+func (k Keeper) DecrementOutgoingChainSupply(ctx sdk.Context, chainID string, coin sdk.Coin) error {
+	if chainID == "" {
+		return nil
+	}
+	supply := k.getChainSupply(ctx, chainID, coin.Denom)
+	if supply.OutgoingSupply.Amount.Sub(coin.Amount).IsNegative() {
+		return sdkerrors.Wrapf(types.ErrInvalidOutgoingSupply, "chain %s, decrease %s, outgoing supply %s", chainID, coin, supply.OutgoingSupply)
+	}
+
+	supply.OutgoingSupply = supply.OutgoingSupply.Sub(coin)
+	k.SetChainSupply(ctx, supply)
+	return nil
+}
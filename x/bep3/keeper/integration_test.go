@@ -115,7 +115,7 @@ func atomicSwap(ctx sdk.Context, index int) types.AtomicSwap {
 	return types.NewAtomicSwap(cs(c("bnb", 50000)), randomNumberHash,
 		uint64(ctx.BlockHeight())+expireOffset, timestamp, TestUser1, TestUser2,
 		TestSenderOtherChain, TestRecipientOtherChain, 0, types.Open, true,
-		types.Incoming)
+		types.Incoming, "")
 }
 
 func assetSupplies(count int) types.AssetSupplies {
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+// RegisterInvariants registers all bep3 invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+
+	ir.RegisterRoute(types.ModuleName, "module-account",
+		ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "asset-supplies",
+		AssetSuppliesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "supply-limits",
+		SupplyLimitsInvariant(k))
+}
+
+// ModuleAccountInvariant checks that the module account's coins match those locked in outgoing swaps
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		moduleAccCoins := k.supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins()
+		discrepancies := types.ReconcileModuleAccount(moduleAccCoins, k.GetAllAtomicSwaps(ctx))
+		return formatInvariant("module account", discrepancies), len(discrepancies) > 0
+	}
+}
+
+// AssetSuppliesInvariant checks that each asset's incoming and outgoing supply match the coins locked in its swaps
+func AssetSuppliesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		discrepancies := types.ReconcileAssetSupplies(k.GetAllAssetSupplies(ctx), k.GetAllAtomicSwaps(ctx))
+		return formatInvariant("asset supplies", discrepancies), len(discrepancies) > 0
+	}
+}
+
+// SupplyLimitsInvariant checks that no asset's current supply is over its supply limit
+func SupplyLimitsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		discrepancies := types.ReconcileSupplyLimits(k.GetAllAssetSupplies(ctx), k.GetParams(ctx).AssetParams)
+		return formatInvariant("supply limits", discrepancies), len(discrepancies) > 0
+	}
+}
+
+// formatInvariant formats an invariant message listing each discrepancy on its own line
+func formatInvariant(name string, discrepancies []string) string {
+	var msg strings.Builder
+	for _, d := range discrepancies {
+		msg.WriteString(fmt.Sprintf("\t%s\n", d))
+	}
+	return sdk.FormatInvariant(types.ModuleName, name, msg.String())
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/bep3/keeper"
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+type InvariantTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+}
+
+func (suite *InvariantTestSuite) SetupTest() {
+	config := sdk.GetConfig()
+	app.SetBech32AddressPrefixes(config)
+
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	deputy, _ := sdk.AccAddressFromBech32(TestDeputy)
+	tApp.InitializeFromGenesisStates(NewBep3GenStateMulti(deputy))
+
+	suite.app = tApp
+	suite.ctx = ctx
+	suite.keeper = tApp.GetBep3Keeper()
+}
+
+func (suite *InvariantTestSuite) TestSupplyLimitsInvariant() {
+	testCases := []struct {
+		name          string
+		currentSupply sdk.Coin
+		broken        bool
+	}{
+		{"no supply", c("bnb", 0), false},
+		{"supply under limit", c("bnb", 350000000000000-1), false},
+		{"supply at limit", c("bnb", 350000000000000), false},
+		{"supply over limit", c("bnb", 350000000000000+1), true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			supply := types.NewAssetSupply(c("bnb", 0), c("bnb", 0), tc.currentSupply, c("bnb", 0), time.Duration(0))
+			suite.keeper.SetAssetSupply(suite.ctx, supply, "bnb")
+
+			_, broken := keeper.SupplyLimitsInvariant(suite.keeper)(suite.ctx)
+			suite.Equal(tc.broken, broken)
+		})
+	}
+}
+
+func (suite *InvariantTestSuite) TestSupplyLimitsInvariant_LoweredLimit() {
+	supply := types.NewAssetSupply(c("bnb", 0), c("bnb", 0), c("bnb", 1000), c("bnb", 0), time.Duration(0))
+	suite.keeper.SetAssetSupply(suite.ctx, supply, "bnb")
+	_, broken := keeper.SupplyLimitsInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AssetParams[0].SupplyLimit.Limit = sdk.NewInt(999)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	msg, broken := keeper.SupplyLimitsInvariant(suite.keeper)(suite.ctx)
+	suite.True(broken)
+	suite.Contains(msg, "1000bnb is over the supply limit 999")
+}
+
+func TestInvariantTestSuite(t *testing.T) {
+	suite.Run(t, new(InvariantTestSuite))
+}
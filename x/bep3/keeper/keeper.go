@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -69,6 +70,7 @@ func (k Keeper) SetAtomicSwap(ctx sdk.Context, atomicSwap types.AtomicSwap) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapKeyPrefix)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(atomicSwap)
 	store.Set(atomicSwap.GetSwapID(), bz)
+	k.InsertIntoByUpdateIndex(ctx, atomicSwap.GetSwapID())
 }
 
 // GetAtomicSwap gets an AtomicSwap from the store.
@@ -86,9 +88,15 @@ func (k Keeper) GetAtomicSwap(ctx sdk.Context, swapID []byte) (types.AtomicSwap,
 }
 
 // RemoveAtomicSwap removes an AtomicSwap from the AtomicSwapKeyPrefix.
+// The swap is moved to the current height in the byUpdate index, where it remains as a tombstone recording the deletion.
+// The tombstone is also recorded in the tombstone index so it can be pruned without scanning open swaps.
 func (k Keeper) RemoveAtomicSwap(ctx sdk.Context, swapID []byte) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapKeyPrefix)
 	store.Delete(swapID)
+	k.InsertIntoByUpdateIndex(ctx, swapID)
+
+	tombstoneStore := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapTombstonePrefix)
+	tombstoneStore.Set(types.GetAtomicSwapByHeightKey(uint64(ctx.BlockHeight()), swapID), swapID)
 }
 
 // IterateAtomicSwaps provides an iterator over all stored AtomicSwaps.
@@ -152,6 +160,60 @@ func (k Keeper) IterateAtomicSwapsByBlock(ctx sdk.Context, inclusiveCutoffTime u
 	}
 }
 
+// ------------------------------------------
+//			Atomic Swap Time Index
+// ------------------------------------------
+
+// InsertIntoByTimeIndex adds a swap ID and expiration time into the byTime index.
+func (k Keeper) InsertIntoByTimeIndex(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByTimePrefix)
+	store.Set(types.GetAtomicSwapByTimeKey(atomicSwap.ExpireTime, atomicSwap.GetSwapID()), atomicSwap.GetSwapID())
+}
+
+// RemoveFromByTimeIndex removes an AtomicSwap from the byTime index.
+func (k Keeper) RemoveFromByTimeIndex(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByTimePrefix)
+	store.Delete(types.GetAtomicSwapByTimeKey(atomicSwap.ExpireTime, atomicSwap.GetSwapID()))
+}
+
+// IterateAtomicSwapsByTime provides an iterator over AtomicSwaps ordered by AtomicSwap expiration time
+// For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAtomicSwapsByTime(ctx sdk.Context, inclusiveCutoffTime int64, cb func(swapID []byte) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByTimePrefix)
+	iterator := store.Iterator(
+		nil, // start at the very start of the prefix store
+		sdk.PrefixEndBytes(sdk.Uint64ToBigEndian(uint64(inclusiveCutoffTime))), // end of range
+	)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+
+		id := iterator.Value()
+
+		if cb(id) {
+			break
+		}
+	}
+}
+
+// InsertIntoExpiryIndex adds an AtomicSwap to the byTime index if it expires at a block time, otherwise to the byBlock index.
+func (k Keeper) InsertIntoExpiryIndex(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	if atomicSwap.ExpireTime > 0 {
+		k.InsertIntoByTimeIndex(ctx, atomicSwap)
+		return
+	}
+	k.InsertIntoByBlockIndex(ctx, atomicSwap)
+}
+
+// RemoveFromExpiryIndex removes an AtomicSwap from the byTime index if it expires at a block time, otherwise from the byBlock index.
+func (k Keeper) RemoveFromExpiryIndex(ctx sdk.Context, atomicSwap types.AtomicSwap) {
+	if atomicSwap.ExpireTime > 0 {
+		k.RemoveFromByTimeIndex(ctx, atomicSwap)
+		return
+	}
+	k.RemoveFromByBlockIndex(ctx, atomicSwap)
+}
+
 // ------------------------------------------
 //		Atomic Swap Longterm Storage Index
 // ------------------------------------------
@@ -192,6 +254,108 @@ func (k Keeper) IterateAtomicSwapsLongtermStorage(ctx sdk.Context, inclusiveCuto
 	}
 }
 
+// ------------------------------------------
+//			Atomic Swap Update Index
+// ------------------------------------------
+
+// InsertIntoByUpdateIndex records that a swap was updated at the current block height, replacing any earlier record.
+func (k Keeper) InsertIntoByUpdateIndex(ctx sdk.Context, swapID []byte) {
+	k.RemoveFromByUpdateIndex(ctx, swapID)
+
+	height := uint64(ctx.BlockHeight())
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByUpdatePrefix)
+	store.Set(types.GetAtomicSwapByHeightKey(height, swapID), swapID)
+
+	heightStore := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapUpdateHeightPrefix)
+	heightStore.Set(swapID, sdk.Uint64ToBigEndian(height))
+}
+
+// RemoveFromByUpdateIndex removes a swap from the byUpdate index, along with its tombstone if it was deleted.
+func (k Keeper) RemoveFromByUpdateIndex(ctx sdk.Context, swapID []byte) {
+	height, found := k.GetAtomicSwapUpdateHeight(ctx, swapID)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByUpdatePrefix)
+	store.Delete(types.GetAtomicSwapByHeightKey(uint64(height), swapID))
+
+	tombstoneStore := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapTombstonePrefix)
+	tombstoneStore.Delete(types.GetAtomicSwapByHeightKey(uint64(height), swapID))
+
+	heightStore := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapUpdateHeightPrefix)
+	heightStore.Delete(swapID)
+}
+
+// GetAtomicSwapUpdateHeight returns the block height at which a swap was last updated.
+func (k Keeper) GetAtomicSwapUpdateHeight(ctx sdk.Context, swapID []byte) (int64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapUpdateHeightPrefix)
+	bz := store.Get(swapID)
+	if bz == nil {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// IterateAtomicSwapsByUpdate provides an iterator over AtomicSwaps updated at or after the input height, ordered by update height.
+// For each AtomicSwap cb will be called. If cb returns true the iterator will close and stop.
+func (k Keeper) IterateAtomicSwapsByUpdate(ctx sdk.Context, inclusiveStartHeight uint64,
+	cb func(height int64, swapID []byte) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapByUpdatePrefix)
+	iterator := store.Iterator(
+		sdk.Uint64ToBigEndian(inclusiveStartHeight), // start at the input height
+		nil, // end at the very end of the prefix store
+	)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+
+		height := int64(binary.BigEndian.Uint64(iterator.Key()[:8]))
+		id := iterator.Value()
+
+		if cb(height, id) {
+			break
+		}
+	}
+}
+
+// GetAtomicSwapUpdates returns all AtomicSwaps updated or deleted at or after the input height, ordered by update height
+func (k Keeper) GetAtomicSwapUpdates(ctx sdk.Context, height int64) (updates types.AtomicSwapUpdates) {
+	if height < 0 {
+		height = 0
+	}
+	k.IterateAtomicSwapsByUpdate(ctx, uint64(height), func(updateHeight int64, swapID []byte) bool {
+		atomicSwap, found := k.GetAtomicSwap(ctx, swapID)
+		if !found {
+			updates = append(updates, types.NewDeletedAtomicSwapUpdate(swapID, updateHeight))
+			return false
+		}
+		updates = append(updates, types.NewAtomicSwapUpdate(atomicSwap, updateHeight))
+		return false
+	})
+	return
+}
+
+// PruneAtomicSwapTombstones removes deleted swaps from the byUpdate index once they were deleted more than the longterm storage duration ago
+func (k Keeper) PruneAtomicSwapTombstones(ctx sdk.Context) {
+	if uint64(ctx.BlockHeight()) <= types.DefaultLongtermStorageDuration {
+		return
+	}
+	cutoff := uint64(ctx.BlockHeight()) - types.DefaultLongtermStorageDuration
+
+	// only tombstones are iterated, so open swaps that have not been updated for a long time are not rescanned every block
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AtomicSwapTombstonePrefix)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(cutoff))
+	var tombstones [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		tombstones = append(tombstones, iterator.Value())
+	}
+	iterator.Close()
+
+	for _, swapID := range tombstones {
+		k.RemoveFromByUpdateIndex(ctx, swapID)
+	}
+}
+
 // ------------------------------------------
 //				Asset Supplies
 // ------------------------------------------
@@ -238,6 +402,70 @@ func (k Keeper) GetAllAssetSupplies(ctx sdk.Context) (supplies types.AssetSuppli
 	return
 }
 
+// GetChainSupply gets the supply of an asset that has been swapped with a counterparty chain
+func (k Keeper) GetChainSupply(ctx sdk.Context, chainID, denom string) (types.ChainSupply, bool) {
+	var chainSupply types.ChainSupply
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ChainSupplyPrefix)
+	bz := store.Get(types.GetChainSupplyKey(chainID, denom))
+	if bz == nil {
+		return types.ChainSupply{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &chainSupply)
+	return chainSupply, true
+}
+
+// SetChainSupply updates the supply of an asset that has been swapped with a counterparty chain
+func (k Keeper) SetChainSupply(ctx sdk.Context, supply types.ChainSupply) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ChainSupplyPrefix)
+	store.Set(types.GetChainSupplyKey(supply.ChainID, supply.GetDenom()), k.cdc.MustMarshalBinaryBare(supply))
+}
+
+// IterateChainSupplies provides an iterator over all stored ChainSupplies.
+func (k Keeper) IterateChainSupplies(ctx sdk.Context, cb func(supply types.ChainSupply) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.ChainSupplyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var supply types.ChainSupply
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &supply)
+
+		if cb(supply) {
+			break
+		}
+	}
+}
+
+// GetAllChainSupplies returns all chain supplies from the store
+func (k Keeper) GetAllChainSupplies(ctx sdk.Context) (supplies types.ChainSupplies) {
+	k.IterateChainSupplies(ctx, func(supply types.ChainSupply) bool {
+		supplies = append(supplies, supply)
+		return false
+	})
+	return
+}
+
+// GetDeputyRotationActivation returns the height at which an asset's current deputy rotation was activated
+func (k Keeper) GetDeputyRotationActivation(ctx sdk.Context, denom string) (int64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DeputyRotationActivationPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(bz)), true
+}
+
+// SetDeputyRotationActivation records the height at which an asset's deputy rotation was activated
+func (k Keeper) SetDeputyRotationActivation(ctx sdk.Context, denom string, height int64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DeputyRotationActivationPrefix)
+	store.Set([]byte(denom), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// DeleteDeputyRotationActivation removes the record of an asset's deputy rotation activation
+func (k Keeper) DeleteDeputyRotationActivation(ctx sdk.Context, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.DeputyRotationActivationPrefix)
+	store.Delete([]byte(denom))
+}
+
 // GetPreviousBlockTime get the blocktime for the previous block
 func (k Keeper) GetPreviousBlockTime(ctx sdk.Context) (blockTime time.Time, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousBlockTimeKey)
@@ -214,7 +214,7 @@ func (suite *KeeperTestSuite) TestIterateAtomicSwapsByBlock() {
 		atomicSwap := types.NewAtomicSwap(cs(c("bnb", 50000)), randomNumberHash,
 			uint64(blockCtx.BlockHeight()), timestamp, TestUser1, TestUser2,
 			TestSenderOtherChain, TestRecipientOtherChain, 0, types.Open,
-			true, types.Incoming)
+			true, types.Incoming, "")
 
 		// Insert into block index
 		suite.keeper.InsertIntoByBlockIndex(blockCtx, atomicSwap)
@@ -305,7 +305,7 @@ func (suite *KeeperTestSuite) TestIterateAtomicSwapsLongtermStorage() {
 		atomicSwap := types.NewAtomicSwap(cs(c("bnb", 50000)), randomNumberHash,
 			uint64(suite.ctx.BlockHeight()), timestamp, TestUser1, TestUser2,
 			TestSenderOtherChain, TestRecipientOtherChain, 100, types.Open,
-			true, types.Incoming)
+			true, types.Incoming, "")
 
 		// Set closed block staggered by 100 blocks and insert into longterm storage
 		atomicSwap.ClosedBlock = int64(i) * 100
@@ -338,6 +338,80 @@ func (suite *KeeperTestSuite) TestIterateAtomicSwapsLongtermStorage() {
 	suite.Equal(expectedSwapIDs, readSwapIDs)
 }
 
+func (suite *KeeperTestSuite) TestGetAtomicSwapUpdates() {
+	suite.ResetChain()
+
+	// Set up atomic swaps updated at staggered heights
+	var swaps types.AtomicSwaps
+	for i := 0; i < 4; i++ {
+		timestamp := tmtime.Now().Unix()
+		randomNumber, _ := types.GenerateSecureRandomNumber()
+		randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
+
+		atomicSwap := types.NewAtomicSwap(cs(c("bnb", 50000)), randomNumberHash,
+			uint64(suite.ctx.BlockHeight()), timestamp, TestUser1, TestUser2,
+			TestSenderOtherChain, TestRecipientOtherChain, 0, types.Open,
+			true, types.Incoming, "")
+
+		ctx := suite.ctx.WithBlockHeight(int64(i+1) * 10)
+		suite.keeper.SetAtomicSwap(ctx, atomicSwap)
+		swaps = append(swaps, atomicSwap)
+	}
+
+	updates := suite.keeper.GetAtomicSwapUpdates(suite.ctx, 0)
+	suite.Require().Len(updates, 4)
+	for i, update := range updates {
+		suite.Equal(int64(i+1)*10, update.Height)
+		suite.Equal(types.NewAugmentedAtomicSwap(swaps[i]), update.AtomicSwap)
+	}
+
+	// Updating a swap moves it to the new height
+	completedSwap := swaps[0]
+	completedSwap.Status = types.Completed
+	completedSwap.ClosedBlock = 50
+	suite.keeper.SetAtomicSwap(suite.ctx.WithBlockHeight(50), completedSwap)
+
+	updates = suite.keeper.GetAtomicSwapUpdates(suite.ctx, 40)
+	suite.Require().Len(updates, 2)
+	suite.Equal(int64(40), updates[0].Height)
+	suite.Equal(int64(50), updates[1].Height)
+	suite.Equal(types.Completed, updates[1].AtomicSwap.Status)
+
+	height, found := suite.keeper.GetAtomicSwapUpdateHeight(suite.ctx, completedSwap.GetSwapID())
+	suite.True(found)
+	suite.Equal(int64(50), height)
+
+	// Removed swaps are reported as deleted at the height they were removed
+	suite.keeper.RemoveAtomicSwap(suite.ctx.WithBlockHeight(60), completedSwap.GetSwapID())
+	updates = suite.keeper.GetAtomicSwapUpdates(suite.ctx, 50)
+	suite.Require().Len(updates, 1)
+	suite.Equal(types.NewDeletedAtomicSwapUpdate(completedSwap.GetSwapID(), 60), updates[0])
+	height, found = suite.keeper.GetAtomicSwapUpdateHeight(suite.ctx, completedSwap.GetSwapID())
+	suite.True(found)
+	suite.Equal(int64(60), height)
+
+	// Deletion records are pruned once they are older than the longterm storage duration
+	suite.keeper.PruneAtomicSwapTombstones(suite.ctx.WithBlockHeight(60 + int64(types.DefaultLongtermStorageDuration)))
+	suite.Len(suite.keeper.GetAtomicSwapUpdates(suite.ctx, 0), 4)
+	suite.keeper.PruneAtomicSwapTombstones(suite.ctx.WithBlockHeight(61 + int64(types.DefaultLongtermStorageDuration)))
+	updates = suite.keeper.GetAtomicSwapUpdates(suite.ctx, 0)
+	suite.Len(updates, 3)
+	for _, update := range updates {
+		suite.False(update.Deleted)
+	}
+	_, found = suite.keeper.GetAtomicSwapUpdateHeight(suite.ctx, completedSwap.GetSwapID())
+	suite.False(found)
+
+	// A swap stored again after it was removed is no longer a tombstone, so it is not pruned
+	suite.keeper.RemoveAtomicSwap(suite.ctx.WithBlockHeight(70), swaps[1].GetSwapID())
+	suite.keeper.SetAtomicSwap(suite.ctx.WithBlockHeight(80), swaps[1])
+	suite.keeper.PruneAtomicSwapTombstones(suite.ctx.WithBlockHeight(100 + int64(types.DefaultLongtermStorageDuration)))
+	updates = suite.keeper.GetAtomicSwapUpdates(suite.ctx, 0)
+	suite.Require().Len(updates, 3)
+	suite.Equal(int64(80), updates[2].Height)
+	suite.False(updates[2].Deleted)
+}
+
 func (suite *KeeperTestSuite) TestGetSetAssetSupply() {
 	denom := "bnb"
 	// Put asset supply in store
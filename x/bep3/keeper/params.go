@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -50,17 +52,58 @@ func (k Keeper) GetAssets(ctx sdk.Context) (types.AssetParams, bool) {
 	return params.AssetParams, len(params.AssetParams) > 0
 }
 
+// ------------------------------------------
+//				Chain
+// ------------------------------------------
+
+// GetChain returns the chain param associated with the input chain ID
+func (k Keeper) GetChain(ctx sdk.Context, chainID string) (types.ChainParam, error) {
+	params := k.GetParams(ctx)
+	for _, chain := range params.ChainParams {
+		if chainID == chain.ChainID {
+			return chain, nil
+		}
+	}
+	return types.ChainParam{}, sdkerrors.Wrap(types.ErrChainNotSupported, chainID)
+}
+
+// GetChains returns a list containing all supported counterparty chains
+func (k Keeper) GetChains(ctx sdk.Context) (types.ChainParams, bool) {
+	params := k.GetParams(ctx)
+	return params.ChainParams, len(params.ChainParams) > 0
+}
+
+// GetSwapDeputyAddresses returns the deputy addresses that may relay swaps of the input denom with the input counterparty chain.
+// An empty chain ID refers to the asset's own deputies, which include both deputies during a deputy rotation overlap.
+func (k Keeper) GetSwapDeputyAddresses(ctx sdk.Context, denom, chainID string) ([]sdk.AccAddress, error) {
+	asset, err := k.GetAsset(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+	if chainID == "" {
+		return asset.DeputyAddresses(ctx.BlockHeight()), nil
+	}
+	if !asset.SupportsChain(chainID) {
+		return nil, sdkerrors.Wrapf(types.ErrAssetNotSupportedOnChain, "%s on %s", denom, chainID)
+	}
+	chain, err := k.GetChain(ctx, chainID)
+	if err != nil {
+		return nil, err
+	}
+	return []sdk.AccAddress{chain.DeputyAddress}, nil
+}
+
 // ------------------------------------------
 //				Asset-specific getters
 // ------------------------------------------
 
-// GetDeputyAddress returns the deputy address for the input denom
+// GetDeputyAddress returns the deputy address for the input denom, taking any activated deputy rotation into account
 func (k Keeper) GetDeputyAddress(ctx sdk.Context, denom string) (sdk.AccAddress, error) {
 	asset, err := k.GetAsset(ctx, denom)
 	if err != nil {
 		return sdk.AccAddress{}, err
 	}
-	return asset.DeputyAddress, nil
+	return asset.CurrentDeputyAddress(ctx.BlockHeight()), nil
 }
 
 // GetFixedFee returns the fixed fee for incoming swaps
@@ -154,8 +197,19 @@ func (k Keeper) GetAuthorizedAddresses(ctx sdk.Context) []sdk.AccAddress {
 	addresses := []sdk.AccAddress{}
 	uniqueAddresses := map[string]bool{}
 
+	deputies := []sdk.AccAddress{}
 	for _, ap := range assetParams {
-		a := ap.DeputyAddress
+		deputies = append(deputies, ap.DeputyAddress)
+		if ap.DeputyRotation.IsScheduled() {
+			deputies = append(deputies, ap.DeputyRotation.NewDeputyAddress)
+		}
+	}
+	chainParams, _ := k.GetChains(ctx)
+	for _, cp := range chainParams {
+		deputies = append(deputies, cp.DeputyAddress)
+	}
+
+	for _, a := range deputies {
 		// de-dup addresses
 		if _, found := uniqueAddresses[a.String()]; !found {
 			addresses = append(addresses, a)
@@ -164,3 +218,71 @@ func (k Keeper) GetAuthorizedAddresses(ctx sdk.Context) []sdk.AccAddress {
 	}
 	return addresses
 }
+
+// GetPendingDeputyRotations returns the scheduled deputy rotations of all assets
+func (k Keeper) GetPendingDeputyRotations(ctx sdk.Context) types.PendingDeputyRotations {
+	rotations := types.PendingDeputyRotations{}
+	assets, _ := k.GetAssets(ctx)
+	for _, asset := range assets {
+		if asset.DeputyRotation.IsScheduled() {
+			rotations = append(rotations, types.NewPendingDeputyRotation(asset, ctx.BlockHeight()))
+		}
+	}
+	return rotations
+}
+
+// UpdateDeputyRotations emits an event when a scheduled deputy rotation activates, and replaces the
+// asset's deputy with the new deputy once the rotation's overlap window has ended.
+// A rotation scheduled with an activation height that has already passed is activated at the current height,
+// so that the old deputy still gets the full overlap.
+func (k Keeper) UpdateDeputyRotations(ctx sdk.Context) {
+	assets, found := k.GetAssets(ctx)
+	if !found {
+		return
+	}
+	for _, asset := range assets {
+		rotation := asset.DeputyRotation
+		if !rotation.IsScheduled() {
+			// clear the activation of a rotation that was cancelled by governance
+			if _, found := k.GetDeputyRotationActivation(ctx, asset.Denom); found {
+				k.DeleteDeputyRotationActivation(ctx, asset.Denom)
+			}
+			continue
+		}
+
+		activationHeight, activated := k.GetDeputyRotationActivation(ctx, asset.Denom)
+		if (!activated || activationHeight != rotation.ActivationHeight) && ctx.BlockHeight() >= rotation.ActivationHeight {
+			if rotation.ActivationHeight < ctx.BlockHeight() {
+				rotation.ActivationHeight = ctx.BlockHeight()
+				asset.DeputyRotation = rotation
+				k.SetAsset(ctx, asset)
+			}
+			k.SetDeputyRotationActivation(ctx, asset.Denom, rotation.ActivationHeight)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeDeputyRotationActivated,
+					sdk.NewAttribute(types.AttributeKeyDenom, asset.Denom),
+					sdk.NewAttribute(types.AttributeKeyOldDeputy, asset.DeputyAddress.String()),
+					sdk.NewAttribute(types.AttributeKeyNewDeputy, rotation.NewDeputyAddress.String()),
+					sdk.NewAttribute(types.AttributeKeyOverlapEndHeight, fmt.Sprintf("%d", rotation.OverlapEndHeight())),
+				),
+			)
+		}
+
+		if ctx.BlockHeight() >= rotation.OverlapEndHeight() {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeDeputyRotationCompleted,
+					sdk.NewAttribute(types.AttributeKeyDenom, asset.Denom),
+					sdk.NewAttribute(types.AttributeKeyOldDeputy, asset.DeputyAddress.String()),
+					sdk.NewAttribute(types.AttributeKeyNewDeputy, rotation.NewDeputyAddress.String()),
+				),
+			)
+			asset.DeputyAddress = rotation.NewDeputyAddress
+			asset.DeputyRotation = types.DeputyRotation{}
+			k.SetAsset(ctx, asset)
+			k.DeleteDeputyRotationActivation(ctx, asset.Denom)
+		}
+	}
+}
@@ -124,6 +124,96 @@ func (suite *ParamsTestSuite) TestGetAuthorizedAddresses() {
 	suite.Require().ElementsMatch(expectedAddresses, deputyAddresses)
 }
 
+func (suite *ParamsTestSuite) TestDeputyRotation() {
+	oldDeputy := suite.addrs[0]
+	newDeputy := suite.addrs[1]
+
+	asset, err := suite.keeper.GetAsset(suite.ctx, "bnb")
+	suite.Require().NoError(err)
+	asset.DeputyRotation = types.NewDeputyRotation(newDeputy, 10, 5)
+	suite.keeper.SetAsset(suite.ctx, asset)
+
+	suite.Require().Equal(
+		types.PendingDeputyRotations{types.PendingDeputyRotation{
+			Denom:            "bnb",
+			CurrentDeputy:    oldDeputy,
+			NewDeputy:        newDeputy,
+			ActivationHeight: 10,
+			OverlapEndHeight: 15,
+			Active:           false,
+		}},
+		suite.keeper.GetPendingDeputyRotations(suite.ctx),
+	)
+	suite.Require().Contains(suite.keeper.GetAuthorizedAddresses(suite.ctx), newDeputy)
+
+	// before activation only the old deputy is valid
+	ctx := suite.ctx.WithBlockHeight(9)
+	deputies, err := suite.keeper.GetSwapDeputyAddresses(ctx, "bnb", "")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.AccAddress{oldDeputy}, deputies)
+	deputy, err := suite.keeper.GetDeputyAddress(ctx, "bnb")
+	suite.Require().NoError(err)
+	suite.Require().Equal(oldDeputy, deputy)
+
+	// during the overlap both deputies are valid
+	ctx = suite.ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	suite.keeper.UpdateDeputyRotations(ctx)
+	suite.Require().Equal(types.EventTypeDeputyRotationActivated, ctx.EventManager().Events()[0].Type)
+	deputies, err = suite.keeper.GetSwapDeputyAddresses(ctx, "bnb", "")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.AccAddress{newDeputy, oldDeputy}, deputies)
+	deputy, err = suite.keeper.GetDeputyAddress(ctx, "bnb")
+	suite.Require().NoError(err)
+	suite.Require().Equal(newDeputy, deputy)
+
+	// once the overlap ends the rotation is completed
+	ctx = suite.ctx.WithBlockHeight(15).WithEventManager(sdk.NewEventManager())
+	suite.keeper.UpdateDeputyRotations(ctx)
+	suite.Require().Equal(types.EventTypeDeputyRotationCompleted, ctx.EventManager().Events()[0].Type)
+	asset, err = suite.keeper.GetAsset(ctx, "bnb")
+	suite.Require().NoError(err)
+	suite.Require().Equal(newDeputy, asset.DeputyAddress)
+	suite.Require().False(asset.DeputyRotation.IsScheduled())
+	deputies, err = suite.keeper.GetSwapDeputyAddresses(ctx, "bnb", "")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.AccAddress{newDeputy}, deputies)
+	suite.Require().Empty(suite.keeper.GetPendingDeputyRotations(ctx))
+}
+
+func (suite *ParamsTestSuite) TestDeputyRotationPastActivationHeight() {
+	oldDeputy := suite.addrs[0]
+	newDeputy := suite.addrs[1]
+
+	// a rotation scheduled at height 20 with an activation height that has already passed
+	asset, err := suite.keeper.GetAsset(suite.ctx, "bnb")
+	suite.Require().NoError(err)
+	asset.DeputyRotation = types.NewDeputyRotation(newDeputy, 10, 5)
+	suite.keeper.SetAsset(suite.ctx, asset)
+
+	// the overlap starts from the current height instead of being skipped
+	ctx := suite.ctx.WithBlockHeight(21).WithEventManager(sdk.NewEventManager())
+	suite.keeper.UpdateDeputyRotations(ctx)
+	suite.Require().Len(ctx.EventManager().Events(), 1)
+	suite.Require().Equal(types.EventTypeDeputyRotationActivated, ctx.EventManager().Events()[0].Type)
+	asset, err = suite.keeper.GetAsset(ctx, "bnb")
+	suite.Require().NoError(err)
+	suite.Require().Equal(int64(21), asset.DeputyRotation.ActivationHeight)
+	deputies, err := suite.keeper.GetSwapDeputyAddresses(ctx, "bnb", "")
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.AccAddress{newDeputy, oldDeputy}, deputies)
+
+	// an activated rotation is not activated again
+	ctx = suite.ctx.WithBlockHeight(22).WithEventManager(sdk.NewEventManager())
+	suite.keeper.UpdateDeputyRotations(ctx)
+	suite.Require().Empty(ctx.EventManager().Events())
+
+	ctx = suite.ctx.WithBlockHeight(26).WithEventManager(sdk.NewEventManager())
+	suite.keeper.UpdateDeputyRotations(ctx)
+	suite.Require().Equal(types.EventTypeDeputyRotationCompleted, ctx.EventManager().Events()[0].Type)
+	_, found := suite.keeper.GetDeputyRotationActivation(ctx, "bnb")
+	suite.Require().False(found)
+}
+
 func (suite *AssetTestSuite) TestValidateLiveAsset() {
 	type args struct {
 		coin sdk.Coin
@@ -25,6 +25,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryAtomicSwaps(ctx, req, keeper)
 		case types.QueryGetParams:
 			return queryGetParams(ctx, req, keeper)
+		case types.QueryGetDeputyRotations:
+			return queryGetDeputyRotations(ctx, req, keeper)
+		case types.QueryGetAddressQuota:
+			return queryGetAddressQuota(ctx, req, keeper)
+		case types.QueryGetAtomicSwapUpdates:
+			return queryAtomicSwapUpdates(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -119,6 +125,29 @@ func queryAtomicSwaps(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]
 	return bz, nil
 }
 
+func queryAtomicSwapUpdates(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryAtomicSwapUpdates
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	updates := keeper.GetAtomicSwapUpdates(ctx, params.Height)
+	start, end := client.Paginate(len(updates), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		updates = types.AtomicSwapUpdates{}
+	} else {
+		updates = updates[start:end]
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, updates)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 // query params in the bep3 store
 func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	// Get params
@@ -132,6 +161,36 @@ func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]by
 	return bz, nil
 }
 
+// query scheduled deputy rotations
+func queryGetDeputyRotations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	rotations := keeper.GetPendingDeputyRotations(ctx)
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, rotations)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryGetAddressQuota(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var requestParams types.QueryAddressQuota
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	quota, err := keeper.GetAddressQuota(ctx, requestParams.Denom, requestParams.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, quota)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 // filterAtomicSwaps retrieves atomic swaps filtered by a given set of params.
 // If no filters are provided, all atomic swaps will be returned in paginated form.
 func filterAtomicSwaps(ctx sdk.Context, swaps types.AtomicSwaps, params types.QueryAtomicSwaps) types.AtomicSwaps {
@@ -70,8 +70,8 @@ func (suite *QuerierTestSuite) SetupTest() {
 		randomNumberHash := types.CalculateRandomHash(randomNumber[:], timestamp)
 
 		// Create atomic swap and check err
-		err := suite.keeper.CreateAtomicSwap(suite.ctx, randomNumberHash, timestamp, expireHeight,
-			addrs[10], suite.addrs[i], TestSenderOtherChain, TestRecipientOtherChain, amount, true)
+		err := suite.keeper.CreateAtomicSwap(suite.ctx, randomNumberHash, timestamp, expireHeight, 0,
+			addrs[10], suite.addrs[i], TestSenderOtherChain, TestRecipientOtherChain, amount, true, "")
 		suite.Nil(err)
 
 		// Calculate swap ID and save
@@ -171,6 +171,42 @@ func (suite *QuerierTestSuite) TestQueryAtomicSwaps() {
 	}
 }
 
+func (suite *QuerierTestSuite) TestQueryAtomicSwapUpdates() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+
+	testCases := []struct {
+		name          string
+		params        types.QueryAtomicSwapUpdates
+		expectedCount int
+	}{
+		{"all updates", types.NewQueryAtomicSwapUpdates(1, 100, 0), len(suite.swapIDs)},
+		{"updates at creation height", types.NewQueryAtomicSwapUpdates(1, 100, suite.ctx.BlockHeight()), len(suite.swapIDs)},
+		{"no updates after creation height", types.NewQueryAtomicSwapUpdates(1, 100, suite.ctx.BlockHeight()+1), 0},
+		{"paginated updates", types.NewQueryAtomicSwapUpdates(2, 4, 0), 4},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			query := abci.RequestQuery{
+				Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAtomicSwapUpdates}, "/"),
+				Data: types.ModuleCdc.MustMarshalJSON(tc.params),
+			}
+
+			bz, err := suite.querier(ctx, []string{types.QueryGetAtomicSwapUpdates}, query)
+			suite.Nil(err)
+			suite.NotNil(bz)
+
+			var updates types.AtomicSwapUpdates
+			suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &updates))
+
+			suite.Equal(tc.expectedCount, len(updates))
+			for _, update := range updates {
+				suite.True(suite.isSwapID[update.AtomicSwap.ID])
+				suite.Equal(suite.ctx.BlockHeight(), update.Height)
+			}
+		})
+	}
+}
+
 func (suite *QuerierTestSuite) TestQueryParams() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	bz, err := suite.querier(ctx, []string{types.QueryGetParams}, abci.RequestQuery{})
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+// GetAddressQuotaUsage gets an address's quota usage for swaps of the input denom from the store
+func (k Keeper) GetAddressQuotaUsage(ctx sdk.Context, denom string, address sdk.AccAddress) (types.QuotaUsage, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AddressQuotaUsagePrefix)
+	bz := store.Get(types.GetAddressQuotaUsageKey(denom, address))
+	if bz == nil {
+		return types.QuotaUsage{}, false
+	}
+	var usage types.QuotaUsage
+	k.cdc.MustUnmarshalBinaryBare(bz, &usage)
+	return usage, true
+}
+
+// SetAddressQuotaUsage sets an address's quota usage for swaps of the input denom, removing it if no swaps are counted in it
+func (k Keeper) SetAddressQuotaUsage(ctx sdk.Context, denom string, address sdk.AccAddress, usage types.QuotaUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AddressQuotaUsagePrefix)
+	if usage.IsEmpty() {
+		store.Delete(types.GetAddressQuotaUsageKey(denom, address))
+		return
+	}
+	store.Set(types.GetAddressQuotaUsageKey(denom, address), k.cdc.MustMarshalBinaryBare(usage))
+}
+
+// IterateAddressQuotaUsages provides an iterator over the quota usage of all addresses
+func (k Keeper) IterateAddressQuotaUsages(ctx sdk.Context, cb func(denom string, address sdk.AccAddress, usage types.QuotaUsage) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.AddressQuotaUsagePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom, address := types.SplitAddressQuotaUsageKey(iterator.Key()[len(types.AddressQuotaUsagePrefix):])
+		var usage types.QuotaUsage
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &usage)
+		if cb(denom, address, usage) {
+			break
+		}
+	}
+}
+
+// GetAllAddressQuotaUsages returns the quota usage of all addresses
+func (k Keeper) GetAllAddressQuotaUsages(ctx sdk.Context) (usages types.AddressQuotaUsages) {
+	k.IterateAddressQuotaUsages(ctx, func(denom string, address sdk.AccAddress, usage types.QuotaUsage) bool {
+		usages = append(usages, types.NewAddressQuotaUsage(denom, address, usage))
+		return false
+	})
+	return
+}
+
+// GetOutgoingQuotaUsage gets an asset's outgoing quota usage from the store
+func (k Keeper) GetOutgoingQuotaUsage(ctx sdk.Context, denom string) (types.QuotaUsage, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OutgoingQuotaUsagePrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return types.QuotaUsage{}, false
+	}
+	var usage types.QuotaUsage
+	k.cdc.MustUnmarshalBinaryBare(bz, &usage)
+	return usage, true
+}
+
+// SetOutgoingQuotaUsage sets an asset's outgoing quota usage, removing it if no swaps are counted in it
+func (k Keeper) SetOutgoingQuotaUsage(ctx sdk.Context, denom string, usage types.QuotaUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OutgoingQuotaUsagePrefix)
+	if usage.IsEmpty() {
+		store.Delete([]byte(denom))
+		return
+	}
+	store.Set([]byte(denom), k.cdc.MustMarshalBinaryBare(usage))
+}
+
+// GetAllOutgoingQuotaUsages returns the outgoing quota usage of all assets
+func (k Keeper) GetAllOutgoingQuotaUsages(ctx sdk.Context) (usages types.OutgoingQuotaUsages) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.key), types.OutgoingQuotaUsagePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.QuotaUsage
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &usage)
+		denom := string(iterator.Key()[len(types.OutgoingQuotaUsagePrefix):])
+		usages = append(usages, types.NewOutgoingQuotaUsage(denom, usage))
+	}
+	return
+}
+
+// UpdateSwapQuotas checks that a new swap is within the asset's swap quotas and records it against them.
+// Incoming swaps count against the recipient's quota, outgoing swaps count against the sender's quota and the asset's outgoing limit.
+// Swaps count against the quotas for a rolling window of the quota period after they are created.
+func (k Keeper) UpdateSwapQuotas(ctx sdk.Context, asset types.AssetParam, direction types.SwapDirection, address sdk.AccAddress, swapID []byte, coin sdk.Coin) error {
+	quota := asset.SwapQuota
+	if !quota.IsEnabled() {
+		return nil
+	}
+
+	record := types.NewQuotaRecord(swapID, ctx.BlockTime(), coin.Amount)
+	usage, _ := k.GetAddressQuotaUsage(ctx, asset.Denom, address)
+	usage = usage.Current(ctx.BlockTime(), quota.QuotaPeriod)
+
+	switch direction {
+	case types.Incoming:
+		used := usage.Incoming.Total()
+		if quota.AddressIncomingLimit.Exceeded(used.Add(coin.Amount)) {
+			return sdkerrors.Wrapf(types.ErrExceedsAddressQuota, "incoming %s, address %s used %s, limit %s", coin, address, used, quota.AddressIncomingLimit.Limit)
+		}
+		usage.Incoming = append(usage.Incoming, record)
+	case types.Outgoing:
+		used := usage.Outgoing.Total()
+		if quota.AddressOutgoingLimit.Exceeded(used.Add(coin.Amount)) {
+			return sdkerrors.Wrapf(types.ErrExceedsAddressQuota, "outgoing %s, address %s used %s, limit %s", coin, address, used, quota.AddressOutgoingLimit.Limit)
+		}
+
+		assetUsage, _ := k.GetOutgoingQuotaUsage(ctx, asset.Denom)
+		assetUsage = assetUsage.Current(ctx.BlockTime(), quota.QuotaPeriod)
+		assetUsed := assetUsage.Outgoing.Total()
+		if quota.OutgoingLimit.Exceeded(assetUsed.Add(coin.Amount)) {
+			return sdkerrors.Wrapf(types.ErrExceedsOutgoingQuota, "outgoing %s, asset used %s, limit %s", coin, assetUsed, quota.OutgoingLimit.Limit)
+		}
+		assetUsage.Outgoing = append(assetUsage.Outgoing, record)
+		k.SetOutgoingQuotaUsage(ctx, asset.Denom, assetUsage)
+
+		usage.Outgoing = append(usage.Outgoing, record)
+	default:
+		return fmt.Errorf("invalid swap direction: %s", direction.String())
+	}
+
+	k.SetAddressQuotaUsage(ctx, asset.Denom, address, usage)
+	return nil
+}
+
+// ReleaseSwapQuotas removes a refunded swap from the quotas it was counted against, so that the amount can be swapped again
+func (k Keeper) ReleaseSwapQuotas(ctx sdk.Context, swap types.AtomicSwap) {
+	denom := swap.Amount[0].Denom
+	swapID := swap.GetSwapID()
+
+	address := swap.Recipient
+	if swap.Direction == types.Outgoing {
+		address = swap.Sender
+		if usage, found := k.GetOutgoingQuotaUsage(ctx, denom); found {
+			k.SetOutgoingQuotaUsage(ctx, denom, usage.Remove(swapID))
+		}
+	}
+	if usage, found := k.GetAddressQuotaUsage(ctx, denom, address); found {
+		k.SetAddressQuotaUsage(ctx, denom, address, usage.Remove(swapID))
+	}
+}
+
+// GetAddressQuota returns the amounts an address can still swap for the input denom in the current quota window
+func (k Keeper) GetAddressQuota(ctx sdk.Context, denom string, address sdk.AccAddress) (types.AddressQuota, error) {
+	asset, err := k.GetAsset(ctx, denom)
+	if err != nil {
+		return types.AddressQuota{}, err
+	}
+	quota := asset.SwapQuota
+	if !quota.IsEnabled() {
+		return types.NewAddressQuota(denom, address, false, types.MaxQuotaRemaining, types.MaxQuotaRemaining, types.MaxQuotaRemaining, time.Time{}), nil
+	}
+
+	usage, _ := k.GetAddressQuotaUsage(ctx, denom, address)
+	usage = usage.Current(ctx.BlockTime(), quota.QuotaPeriod)
+	assetUsage, _ := k.GetOutgoingQuotaUsage(ctx, denom)
+	assetUsage = assetUsage.Current(ctx.BlockTime(), quota.QuotaPeriod)
+
+	return types.NewAddressQuota(
+		denom, address, true,
+		quota.AddressIncomingLimit.Remaining(usage.Incoming.Total()),
+		quota.AddressOutgoingLimit.Remaining(usage.Outgoing.Total()),
+		quota.OutgoingLimit.Remaining(assetUsage.Outgoing.Total()),
+		usage.NextRelease(quota.QuotaPeriod),
+	), nil
+}
+
+// PruneSwapQuotaUsages removes swaps that are older than their asset's quota period from the stored quota usages,
+// so the usage of addresses that stop swapping does not stay in the store until they swap again.
+// Usages of assets without an enabled swap quota are removed, as they are not counted against any limit.
+func (k Keeper) PruneSwapQuotaUsages(ctx sdk.Context) {
+	quotas := make(map[string]types.SwapQuota)
+	for _, asset := range k.GetParams(ctx).AssetParams {
+		quotas[asset.Denom] = asset.SwapQuota
+	}
+	current := func(denom string, usage types.QuotaUsage) types.QuotaUsage {
+		quota, found := quotas[denom]
+		if !found || !quota.IsEnabled() {
+			return types.QuotaUsage{}
+		}
+		return usage.Current(ctx.BlockTime(), quota.QuotaPeriod)
+	}
+
+	var addressUsages types.AddressQuotaUsages
+	k.IterateAddressQuotaUsages(ctx, func(denom string, address sdk.AccAddress, usage types.QuotaUsage) bool {
+		pruned := current(denom, usage)
+		if len(pruned.Incoming) != len(usage.Incoming) || len(pruned.Outgoing) != len(usage.Outgoing) {
+			addressUsages = append(addressUsages, types.NewAddressQuotaUsage(denom, address, pruned))
+		}
+		return false
+	})
+	for _, u := range addressUsages {
+		k.SetAddressQuotaUsage(ctx, u.Denom, u.Address, u.Usage)
+	}
+
+	for _, u := range k.GetAllOutgoingQuotaUsages(ctx) {
+		pruned := current(u.Denom, u.Usage)
+		if len(pruned.Incoming) != len(u.Usage.Incoming) || len(pruned.Outgoing) != len(u.Usage.Outgoing) {
+			k.SetOutgoingQuotaUsage(ctx, u.Denom, pruned)
+		}
+	}
+}
@@ -13,9 +13,9 @@ import (
 )
 
 // CreateAtomicSwap creates a new atomic swap.
-func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, timestamp int64, heightSpan uint64,
+func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, timestamp int64, heightSpan, timeSpan uint64,
 	sender sdk.AccAddress, recipient sdk.AccAddress, senderOtherChain, recipientOtherChain string,
-	amount sdk.Coins, crossChain bool) error {
+	amount sdk.Coins, crossChain bool, chainID string) error {
 	// Confirm that this is not a duplicate swap
 	swapID := types.CalculateSwapID(randomNumberHash, sender, senderOtherChain)
 	_, found := k.GetAtomicSwap(ctx, swapID)
@@ -53,14 +53,46 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 		return sdkerrors.Wrap(types.ErrInvalidTimestamp, fmt.Sprintf("block time: %s, timestamp: %s", ctx.BlockTime().String(), time.Unix(timestamp, 0).UTC().String()))
 	}
 
+	// Swaps with a registered counterparty chain are relayed by that chain's deputy
+	deputyAddresses, err := k.GetSwapDeputyAddresses(ctx, asset.Denom, chainID)
+	if err != nil {
+		return err
+	}
+	if chainID != "" {
+		chain, err := k.GetChain(ctx, chainID)
+		if err != nil {
+			return err
+		}
+		if err := chain.ValidateAddress(recipientOtherChain); err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidOtherChainAddress, "recipient %s: %s", recipientOtherChain, err)
+		}
+		if senderOtherChain != "" {
+			if err := chain.ValidateAddress(senderOtherChain); err != nil {
+				return sdkerrors.Wrapf(types.ErrInvalidOtherChainAddress, "sender %s: %s", senderOtherChain, err)
+			}
+		}
+	}
+
+	// Assets with time based expiry require a time span in place of a height span
+	if asset.TimeExpiry.Enabled && timeSpan == 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidTimeSpan, "asset %s requires a time span", asset.Denom)
+	}
+	if !asset.TimeExpiry.Enabled && heightSpan == 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidHeightSpan, "asset %s requires a height span", asset.Denom)
+	}
+	// Time spans are bounded before they are converted to a duration or expire time so they can't overflow
+	if timeSpan > types.MaxTimeSpan {
+		return sdkerrors.Wrapf(types.ErrInvalidTimeSpan, "time span %d seconds greater than %d", timeSpan, types.MaxTimeSpan)
+	}
+
 	var direction types.SwapDirection
-	if sender.Equals(asset.DeputyAddress) {
-		if recipient.Equals(asset.DeputyAddress) {
-			return sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "deputy cannot be both sender and receiver: %s", asset.DeputyAddress)
+	if containsAddress(deputyAddresses, sender) {
+		if containsAddress(deputyAddresses, recipient) {
+			return sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "deputy cannot be both sender and receiver: %s", recipient)
 		}
 		direction = types.Incoming
 	} else {
-		if !recipient.Equals(asset.DeputyAddress) {
+		if !containsAddress(deputyAddresses, recipient) {
 			return sdkerrors.Wrapf(types.ErrInvalidSwapAccount, "deputy must be recipient for outgoing account: %s", recipient)
 		}
 		direction = types.Outgoing
@@ -75,22 +107,45 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 			newAcc := k.accountKeeper.NewAccountWithAddress(ctx, recipient)
 			k.accountKeeper.SetAccount(ctx, newAcc)
 		}
+		err = k.UpdateSwapQuotas(ctx, asset, direction, recipient, swapID, amount[0])
+		if err != nil {
+			return err
+		}
 		// Incoming swaps have already had their fees collected by the deputy during the relay process.
 		err = k.IncrementIncomingAssetSupply(ctx, amount[0])
+		if err != nil {
+			return err
+		}
+		err = k.IncrementIncomingChainSupply(ctx, chainID, amount[0])
 	case types.Outgoing:
 
-		// Outgoing swaps must have a height span within the accepted range
-		if heightSpan < asset.MinBlockLock || heightSpan > asset.MaxBlockLock {
+		// Outgoing swaps must have a height span, or time span for time based expiry, within the accepted range
+		if asset.TimeExpiry.Enabled {
+			if timeSpan > uint64(asset.TimeExpiry.MaxTimeLock/time.Second) {
+				return sdkerrors.Wrapf(types.ErrInvalidTimeSpan, "time span %ds outside range [%s, %s]", timeSpan, asset.TimeExpiry.MinTimeLock, asset.TimeExpiry.MaxTimeLock)
+			}
+			if timeLock := time.Duration(timeSpan) * time.Second; timeLock < asset.TimeExpiry.MinTimeLock {
+				return sdkerrors.Wrapf(types.ErrInvalidTimeSpan, "time span %s outside range [%s, %s]", timeLock, asset.TimeExpiry.MinTimeLock, asset.TimeExpiry.MaxTimeLock)
+			}
+		} else if heightSpan < asset.MinBlockLock || heightSpan > asset.MaxBlockLock {
 			return sdkerrors.Wrapf(types.ErrInvalidHeightSpan, "height span %d outside range [%d, %d]", heightSpan, asset.MinBlockLock, asset.MaxBlockLock)
 		}
 		// Amount in outgoing swaps must be able to pay the deputy's fixed fee.
 		if amount[0].Amount.LTE(asset.FixedFee.Add(asset.MinSwapAmount)) {
 			return sdkerrors.Wrap(types.ErrInsufficientAmount, amount[0].String())
 		}
+		err = k.UpdateSwapQuotas(ctx, asset, direction, sender, swapID, amount[0])
+		if err != nil {
+			return err
+		}
 		err = k.IncrementOutgoingAssetSupply(ctx, amount[0])
 		if err != nil {
 			return err
 		}
+		err = k.IncrementOutgoingChainSupply(ctx, chainID, amount[0])
+		if err != nil {
+			return err
+		}
 		// Transfer coins to module - only needed for outgoing swaps
 		err = k.supplyKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, amount)
 	default:
@@ -101,13 +156,20 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 	}
 
 	// Store the details of the swap
-	expireHeight := uint64(ctx.BlockHeight()) + heightSpan
+	var expireHeight uint64
+	var expireTime int64
+	if asset.TimeExpiry.Enabled {
+		expireTime = ctx.BlockTime().Unix() + int64(timeSpan)
+	} else {
+		expireHeight = uint64(ctx.BlockHeight()) + heightSpan
+	}
 	atomicSwap := types.NewAtomicSwap(amount, randomNumberHash, expireHeight, timestamp, sender,
-		recipient, senderOtherChain, recipientOtherChain, 0, types.Open, crossChain, direction)
+		recipient, senderOtherChain, recipientOtherChain, 0, types.Open, crossChain, direction, chainID)
+	atomicSwap.ExpireTime = expireTime
 
 	// Insert the atomic swap under both keys
 	k.SetAtomicSwap(ctx, atomicSwap)
-	k.InsertIntoByBlockIndex(ctx, atomicSwap)
+	k.InsertIntoExpiryIndex(ctx, atomicSwap)
 
 	// Emit 'create_atomic_swap' event
 	ctx.EventManager().EmitEvent(
@@ -120,8 +182,11 @@ func (k Keeper) CreateAtomicSwap(ctx sdk.Context, randomNumberHash []byte, times
 			sdk.NewAttribute(types.AttributeKeyTimestamp, fmt.Sprintf("%d", atomicSwap.Timestamp)),
 			sdk.NewAttribute(types.AttributeKeySenderOtherChain, atomicSwap.SenderOtherChain),
 			sdk.NewAttribute(types.AttributeKeyExpireHeight, fmt.Sprintf("%d", atomicSwap.ExpireHeight)),
+			sdk.NewAttribute(types.AttributeKeyExpireTime, fmt.Sprintf("%d", atomicSwap.ExpireTime)),
 			sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
 			sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, atomicSwap.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyChainID, atomicSwap.ChainID),
 		),
 	)
 
@@ -160,6 +225,14 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 		if err != nil {
 			return err
 		}
+		err = k.DecrementIncomingChainSupply(ctx, atomicSwap.ChainID, atomicSwap.Amount[0])
+		if err != nil {
+			return err
+		}
+		err = k.IncrementCurrentChainSupply(ctx, atomicSwap.ChainID, atomicSwap.Amount[0])
+		if err != nil {
+			return err
+		}
 		// incoming case - coins should be MINTED, then sent to user
 		err = k.supplyKeeper.MintCoins(ctx, types.ModuleName, atomicSwap.Amount)
 		if err != nil {
@@ -179,6 +252,14 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 		if err != nil {
 			return err
 		}
+		err = k.DecrementOutgoingChainSupply(ctx, atomicSwap.ChainID, atomicSwap.Amount[0])
+		if err != nil {
+			return err
+		}
+		err = k.DecrementCurrentChainSupply(ctx, atomicSwap.ChainID, atomicSwap.Amount[0])
+		if err != nil {
+			return err
+		}
 		// outgoing case  - coins should be burned
 		err = k.supplyKeeper.BurnCoins(ctx, types.ModuleName, atomicSwap.Amount)
 		if err != nil {
@@ -193,8 +274,8 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 	atomicSwap.ClosedBlock = ctx.BlockHeight()
 	k.SetAtomicSwap(ctx, atomicSwap)
 
-	// Remove from byBlock index and transition to longterm storage
-	k.RemoveFromByBlockIndex(ctx, atomicSwap)
+	// Remove from expiry index and transition to longterm storage
+	k.RemoveFromExpiryIndex(ctx, atomicSwap)
 	k.InsertIntoLongtermStorage(ctx, atomicSwap)
 
 	// Emit 'claim_atomic_swap' event
@@ -206,6 +287,12 @@ func (k Keeper) ClaimAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []b
 			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
 			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
 			sdk.NewAttribute(types.AttributeKeyRandomNumber, hex.EncodeToString(randomNumber)),
+			sdk.NewAttribute(types.AttributeKeySender, atomicSwap.Sender.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, atomicSwap.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyClosedBlock, fmt.Sprintf("%d", atomicSwap.ClosedBlock)),
+			sdk.NewAttribute(types.AttributeKeyChainID, atomicSwap.ChainID),
 		),
 	)
 
@@ -227,11 +314,19 @@ func (k Keeper) RefundAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []
 	switch atomicSwap.Direction {
 	case types.Incoming:
 		err = k.DecrementIncomingAssetSupply(ctx, atomicSwap.Amount[0])
+		if err != nil {
+			return err
+		}
+		err = k.DecrementIncomingChainSupply(ctx, atomicSwap.ChainID, atomicSwap.Amount[0])
 	case types.Outgoing:
 		err = k.DecrementOutgoingAssetSupply(ctx, atomicSwap.Amount[0])
 		if err != nil {
 			return err
 		}
+		err = k.DecrementOutgoingChainSupply(ctx, atomicSwap.ChainID, atomicSwap.Amount[0])
+		if err != nil {
+			return err
+		}
 		// Refund coins to original swap sender for outgoing swaps
 		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, atomicSwap.Sender, atomicSwap.Amount)
 	default:
@@ -242,6 +337,9 @@ func (k Keeper) RefundAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []
 		return err
 	}
 
+	// Refunded swaps no longer count against the swap quotas
+	k.ReleaseSwapQuotas(ctx, atomicSwap)
+
 	// Complete swap
 	atomicSwap.Status = types.Completed
 	atomicSwap.ClosedBlock = ctx.BlockHeight()
@@ -258,6 +356,12 @@ func (k Keeper) RefundAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []
 			sdk.NewAttribute(types.AttributeKeySender, atomicSwap.Sender.String()),
 			sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
 			sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, atomicSwap.Recipient.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
+			sdk.NewAttribute(types.AttributeKeyStatus, atomicSwap.Status.String()),
+			sdk.NewAttribute(types.AttributeKeyClosedBlock, fmt.Sprintf("%d", atomicSwap.ClosedBlock)),
+			sdk.NewAttribute(types.AttributeKeyChainID, atomicSwap.ChainID),
 		),
 	)
 
@@ -267,7 +371,7 @@ func (k Keeper) RefundAtomicSwap(ctx sdk.Context, from sdk.AccAddress, swapID []
 // UpdateExpiredAtomicSwaps finds all AtomicSwaps that are past (or at) their ending times and expires them.
 func (k Keeper) UpdateExpiredAtomicSwaps(ctx sdk.Context) {
 	var expiredSwapIDs []string
-	k.IterateAtomicSwapsByBlock(ctx, uint64(ctx.BlockHeight()), func(id []byte) bool {
+	expireSwap := func(id []byte) bool {
 		atomicSwap, found := k.GetAtomicSwap(ctx, id)
 		if !found {
 			// NOTE: shouldn't happen. Continue to next item.
@@ -275,12 +379,31 @@ func (k Keeper) UpdateExpiredAtomicSwaps(ctx sdk.Context) {
 		}
 		// Expire the uncompleted swap and update both indexes
 		atomicSwap.Status = types.Expired
-		// Note: claimed swaps have already been removed from byBlock index.
-		k.RemoveFromByBlockIndex(ctx, atomicSwap)
+		// Note: claimed swaps have already been removed from the byBlock and byTime indexes.
+		k.RemoveFromExpiryIndex(ctx, atomicSwap)
 		k.SetAtomicSwap(ctx, atomicSwap)
 		expiredSwapIDs = append(expiredSwapIDs, hex.EncodeToString(atomicSwap.GetSwapID()))
+
+		// Emit 'swap_expired' event
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSwapExpired,
+				sdk.NewAttribute(types.AttributeKeySender, atomicSwap.Sender.String()),
+				sdk.NewAttribute(types.AttributeKeyRecipient, atomicSwap.Recipient.String()),
+				sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(atomicSwap.GetSwapID())),
+				sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(atomicSwap.RandomNumberHash)),
+				sdk.NewAttribute(types.AttributeKeyExpireHeight, fmt.Sprintf("%d", atomicSwap.ExpireHeight)),
+				sdk.NewAttribute(types.AttributeKeyExpireTime, fmt.Sprintf("%d", atomicSwap.ExpireTime)),
+				sdk.NewAttribute(types.AttributeKeyAmount, atomicSwap.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDirection, atomicSwap.Direction.String()),
+				sdk.NewAttribute(types.AttributeKeyStatus, atomicSwap.Status.String()),
+				sdk.NewAttribute(types.AttributeKeyChainID, atomicSwap.ChainID),
+			),
+		)
 		return false
-	})
+	}
+	k.IterateAtomicSwapsByBlock(ctx, uint64(ctx.BlockHeight()), expireSwap)
+	k.IterateAtomicSwapsByTime(ctx, ctx.BlockTime().Unix(), expireSwap)
 
 	// Emit 'swaps_expired' event
 	ctx.EventManager().EmitEvent(
@@ -292,7 +415,7 @@ func (k Keeper) UpdateExpiredAtomicSwaps(ctx sdk.Context) {
 	)
 }
 
-// DeleteClosedAtomicSwapsFromLongtermStorage removes swaps one week after completion.
+// DeleteClosedAtomicSwapsFromLongtermStorage removes swaps one week after completion, and prunes swap deletion records from the byUpdate index one week after deletion.
 func (k Keeper) DeleteClosedAtomicSwapsFromLongtermStorage(ctx sdk.Context) {
 	k.IterateAtomicSwapsLongtermStorage(ctx, uint64(ctx.BlockHeight()), func(id []byte) bool {
 		swap, found := k.GetAtomicSwap(ctx, id)
@@ -302,6 +425,29 @@ func (k Keeper) DeleteClosedAtomicSwapsFromLongtermStorage(ctx sdk.Context) {
 		}
 		k.RemoveAtomicSwap(ctx, swap.GetSwapID())
 		k.RemoveFromLongtermStorage(ctx, swap)
+
+		// Emit 'swap_deleted' event
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSwapDeleted,
+				sdk.NewAttribute(types.AttributeKeyAtomicSwapID, hex.EncodeToString(swap.GetSwapID())),
+				sdk.NewAttribute(types.AttributeKeyRandomNumberHash, hex.EncodeToString(swap.RandomNumberHash)),
+				sdk.NewAttribute(types.AttributeKeyDirection, swap.Direction.String()),
+				sdk.NewAttribute(types.AttributeKeyClosedBlock, fmt.Sprintf("%d", swap.ClosedBlock)),
+				sdk.NewAttribute(types.AttributeKeyChainID, swap.ChainID),
+			),
+		)
 		return false
 	})
+	k.PruneAtomicSwapTombstones(ctx)
+}
+
+// containsAddress returns true if the input address is in the list of addresses
+func containsAddress(addresses []sdk.AccAddress, address sdk.AccAddress) bool {
+	for _, a := range addresses {
+		if a.Equals(address) {
+			return true
+		}
+	}
+	return false
 }
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"errors"
+	"math"
 	"testing"
 	"time"
 
@@ -425,8 +427,8 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 
 			// Create atomic swap
 			err := suite.keeper.CreateAtomicSwap(suite.ctx, tc.args.randomNumberHash, tc.args.timestamp,
-				tc.args.heightSpan, tc.args.sender, tc.args.recipient, tc.args.senderOtherChain,
-				tc.args.recipientOtherChain, tc.args.coins, tc.args.crossChain)
+				tc.args.heightSpan, 0, tc.args.sender, tc.args.recipient, tc.args.senderOtherChain,
+				tc.args.recipientOtherChain, tc.args.coins, tc.args.crossChain, "")
 
 			// Load sender's account after swap creation
 			senderAccPost := ak.GetAccount(suite.ctx, tc.args.sender)
@@ -500,6 +502,396 @@ func (suite *AtomicSwapTestSuite) TestCreateAtomicSwap() {
 	}
 }
 
+func (suite *AtomicSwapTestSuite) TestCreateAtomicSwapWithChain() {
+	chainID := "Binance-Chain-Tigris"
+	chainDeputy := suite.addrs[1]
+	bnbAddr := "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7"
+	bnbDeputyAddr := "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7"
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.ChainParams = types.ChainParams{types.NewChainParam(chainID, "bnb", chainDeputy, nil)}
+	params.AssetParams[0].ChainIDs = []string{chainID}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	type args struct {
+		randomNumberHash    []byte
+		sender              sdk.AccAddress
+		recipient           sdk.AccAddress
+		senderOtherChain    string
+		recipientOtherChain string
+		coins               sdk.Coins
+		chainID             string
+	}
+	testCases := []struct {
+		name        string
+		args        args
+		expectPass  bool
+		expectedErr error
+	}{
+		{
+			"incoming from chain deputy",
+			args{
+				randomNumberHash:    suite.randomNumberHashes[0],
+				sender:              chainDeputy,
+				recipient:           suite.addrs[2],
+				senderOtherChain:    bnbAddr,
+				recipientOtherChain: bnbDeputyAddr,
+				coins:               cs(c(BNB_DENOM, 50000)),
+				chainID:             chainID,
+			},
+			true,
+			nil,
+		},
+		{
+			"asset deputy is not the chain deputy",
+			args{
+				randomNumberHash:    suite.randomNumberHashes[1],
+				sender:              suite.deputy,
+				recipient:           suite.addrs[2],
+				senderOtherChain:    bnbAddr,
+				recipientOtherChain: bnbDeputyAddr,
+				coins:               cs(c(BNB_DENOM, 50000)),
+				chainID:             chainID,
+			},
+			false,
+			types.ErrInvalidSwapAccount,
+		},
+		{
+			"unsupported chain",
+			args{
+				randomNumberHash:    suite.randomNumberHashes[2],
+				sender:              chainDeputy,
+				recipient:           suite.addrs[2],
+				senderOtherChain:    bnbAddr,
+				recipientOtherChain: bnbDeputyAddr,
+				coins:               cs(c(BNB_DENOM, 50000)),
+				chainID:             "Binance-Chain-Ganges",
+			},
+			false,
+			types.ErrAssetNotSupportedOnChain,
+		},
+		{
+			"asset not supported on chain",
+			args{
+				randomNumberHash:    suite.randomNumberHashes[3],
+				sender:              chainDeputy,
+				recipient:           suite.addrs[2],
+				senderOtherChain:    bnbAddr,
+				recipientOtherChain: bnbDeputyAddr,
+				coins:               cs(c(OTHER_DENOM, 50000)),
+				chainID:             chainID,
+			},
+			false,
+			types.ErrAssetNotSupportedOnChain,
+		},
+		{
+			"invalid other chain address",
+			args{
+				randomNumberHash:    suite.randomNumberHashes[4],
+				sender:              chainDeputy,
+				recipient:           suite.addrs[2],
+				senderOtherChain:    bnbAddr,
+				recipientOtherChain: "tbnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7",
+				coins:               cs(c(BNB_DENOM, 50000)),
+				chainID:             chainID,
+			},
+			false,
+			types.ErrInvalidOtherChainAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := suite.keeper.CreateAtomicSwap(suite.ctx, tc.args.randomNumberHash, ts(0),
+				types.DefaultMinBlockLock, 0, tc.args.sender, tc.args.recipient, tc.args.senderOtherChain,
+				tc.args.recipientOtherChain, tc.args.coins, true, tc.args.chainID)
+
+			if tc.expectPass {
+				suite.Require().NoError(err)
+				swapID := types.CalculateSwapID(tc.args.randomNumberHash, tc.args.sender, tc.args.senderOtherChain)
+				swap, found := suite.keeper.GetAtomicSwap(suite.ctx, swapID)
+				suite.Require().True(found)
+				suite.Equal(types.Incoming, swap.Direction)
+				suite.Equal(tc.args.chainID, swap.ChainID)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().True(errors.Is(err, tc.expectedErr))
+			}
+		})
+	}
+}
+
+func (suite *AtomicSwapTestSuite) TestChainSupply() {
+	chainID := "Binance-Chain-Tigris"
+	chainDeputy := suite.addrs[1]
+	bnbAddr := "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7"
+	bnbDeputyAddr := "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7"
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.ChainParams = types.ChainParams{types.NewChainParam(chainID, "bnb", chainDeputy, cs(c(BNB_DENOM, 100000)))}
+	params.AssetParams[0].ChainIDs = []string{chainID}
+	suite.keeper.SetParams(suite.ctx, params)
+
+	createSwap := func(index int, sender, recipient sdk.AccAddress, amount int64) error {
+		return suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[index], suite.timestamps[index],
+			types.DefaultMinBlockLock, 0, sender, recipient, bnbAddr, bnbDeputyAddr, cs(c(BNB_DENOM, amount)), true, chainID)
+	}
+
+	// incoming swaps from the chain are limited by the chain's supply limit
+	suite.Require().NoError(createSwap(0, chainDeputy, suite.addrs[2], 60000))
+	err := createSwap(1, chainDeputy, suite.addrs[2], 50000)
+	suite.Require().True(errors.Is(err, types.ErrExceedsChainSupplyLimit))
+
+	supply, found := suite.keeper.GetChainSupply(suite.ctx, chainID, BNB_DENOM)
+	suite.Require().True(found)
+	suite.Equal(c(BNB_DENOM, 60000), supply.IncomingSupply)
+
+	// claimed swaps move to the chain's current supply
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], chainDeputy, bnbAddr)
+	suite.Require().NoError(suite.keeper.ClaimAtomicSwap(suite.ctx, suite.addrs[2], swapID, suite.randomNumbers[0]))
+	supply, _ = suite.keeper.GetChainSupply(suite.ctx, chainID, BNB_DENOM)
+	suite.Equal(c(BNB_DENOM, 0), supply.IncomingSupply)
+	suite.Equal(c(BNB_DENOM, 60000), supply.CurrentSupply)
+
+	// outgoing swaps to the chain cannot exceed the supply that came from it, even when the asset supply is higher
+	suite.Require().NoError(suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c(BNB_DENOM, 100000)))
+	err = createSwap(2, suite.addrs[2], chainDeputy, 70000)
+	suite.Require().True(errors.Is(err, types.ErrExceedsAvailableChainSupply))
+	suite.Require().NoError(createSwap(3, suite.addrs[2], chainDeputy, 50000))
+	supply, _ = suite.keeper.GetChainSupply(suite.ctx, chainID, BNB_DENOM)
+	suite.Equal(c(BNB_DENOM, 50000), supply.OutgoingSupply)
+
+	// swaps relayed by the asset's own deputy are not tracked per chain
+	err = suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[4], suite.timestamps[4],
+		types.DefaultMinBlockLock, 0, suite.deputy, suite.addrs[2], TestSenderOtherChain, TestRecipientOtherChain,
+		cs(c(BNB_DENOM, 80000)), true, "")
+	suite.Require().NoError(err)
+	supply, _ = suite.keeper.GetChainSupply(suite.ctx, chainID, BNB_DENOM)
+	suite.Equal(c(BNB_DENOM, 0), supply.IncomingSupply)
+}
+
+func (suite *AtomicSwapTestSuite) TestCreateAtomicSwapWithQuota() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AssetParams[0].SwapQuota = types.NewSwapQuota(time.Hour, types.NewQuotaLimit(true, i(100000)), types.NewQuotaLimit(true, i(60000)), types.NewQuotaLimit(true, i(100000)))
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// Increment current asset supply to support outgoing swaps
+	err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c(BNB_DENOM, 1000000))
+	suite.Require().NoError(err)
+
+	createSwap := func(index int, sender, recipient sdk.AccAddress, amount int64) error {
+		return suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[index], suite.ctx.BlockTime().Unix(),
+			types.DefaultMinBlockLock, 0, sender, recipient, TestSenderOtherChain, TestRecipientOtherChain,
+			cs(c(BNB_DENOM, amount)), true, "")
+	}
+
+	// incoming swaps count against the recipient's quota
+	suite.Require().NoError(createSwap(0, suite.deputy, suite.addrs[1], 60000))
+	err = createSwap(1, suite.deputy, suite.addrs[1], 50000)
+	suite.Require().True(errors.Is(err, types.ErrExceedsAddressQuota))
+	suite.Require().NoError(createSwap(2, suite.deputy, suite.addrs[2], 50000))
+
+	quota, err := suite.keeper.GetAddressQuota(suite.ctx, BNB_DENOM, suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.True(quota.Enabled)
+	suite.Equal(i(40000), quota.IncomingRemaining)
+	suite.Equal(i(60000), quota.OutgoingRemaining)
+	suite.Equal(suite.ctx.BlockTime().Add(time.Hour), quota.NextRelease)
+
+	// outgoing swaps count against the sender's quota and the asset's outgoing limit
+	suite.Require().NoError(createSwap(3, suite.addrs[1], suite.deputy, 50000))
+	err = createSwap(4, suite.addrs[1], suite.deputy, 20000)
+	suite.Require().True(errors.Is(err, types.ErrExceedsAddressQuota))
+	suite.Require().NoError(createSwap(5, suite.addrs[2], suite.deputy, 40000))
+	err = createSwap(6, suite.addrs[3], suite.deputy, 20000)
+	suite.Require().True(errors.Is(err, types.ErrExceedsOutgoingQuota))
+
+	quota, err = suite.keeper.GetAddressQuota(suite.ctx, BNB_DENOM, suite.addrs[3])
+	suite.Require().NoError(err)
+	suite.Equal(i(60000), quota.OutgoingRemaining)
+	suite.Equal(i(10000), quota.AssetOutgoingRemaining)
+
+	// swaps keep counting against the quotas until a full period has passed since they were created
+	start := suite.ctx.BlockTime()
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(30 * time.Minute))
+	err = createSwap(7, suite.addrs[3], suite.deputy, 20000)
+	suite.Require().True(errors.Is(err, types.ErrExceedsOutgoingQuota))
+	suite.Require().NoError(createSwap(7, suite.addrs[3], suite.deputy, 10000))
+
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(time.Hour))
+	suite.Require().NoError(createSwap(8, suite.addrs[2], suite.deputy, 60000))
+	err = createSwap(9, suite.addrs[1], suite.deputy, 40000)
+	suite.Require().True(errors.Is(err, types.ErrExceedsOutgoingQuota))
+	suite.Require().NoError(createSwap(9, suite.deputy, suite.addrs[1], 50000))
+
+	quota, err = suite.keeper.GetAddressQuota(suite.ctx, BNB_DENOM, suite.addrs[3])
+	suite.Require().NoError(err)
+	suite.Equal(i(50000), quota.OutgoingRemaining)
+	suite.Equal(i(30000), quota.AssetOutgoingRemaining)
+	suite.Equal(start.Add(90*time.Minute), quota.NextRelease)
+}
+
+func (suite *AtomicSwapTestSuite) TestCreateAtomicSwapWithPartialQuota() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AssetParams[0].SwapQuota = types.NewSwapQuota(time.Hour, types.NewQuotaLimit(false, sdk.ZeroInt()), types.NewQuotaLimit(false, sdk.ZeroInt()), types.NewQuotaLimit(true, i(100000)))
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c(BNB_DENOM, 1000000))
+	suite.Require().NoError(err)
+
+	createSwap := func(index int, sender, recipient sdk.AccAddress, amount int64) error {
+		return suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[index], suite.ctx.BlockTime().Unix(),
+			types.DefaultMinBlockLock, 0, sender, recipient, TestSenderOtherChain, TestRecipientOtherChain,
+			cs(c(BNB_DENOM, amount)), true, "")
+	}
+
+	// disabled address limits do not restrict swaps
+	suite.Require().NoError(createSwap(0, suite.deputy, suite.addrs[1], 500000))
+	suite.Require().NoError(createSwap(1, suite.addrs[1], suite.deputy, 90000))
+
+	// the enabled outgoing limit still applies
+	err = createSwap(2, suite.addrs[2], suite.deputy, 20000)
+	suite.Require().True(errors.Is(err, types.ErrExceedsOutgoingQuota))
+
+	quota, err := suite.keeper.GetAddressQuota(suite.ctx, BNB_DENOM, suite.addrs[2])
+	suite.Require().NoError(err)
+	suite.True(quota.Enabled)
+	suite.Equal(types.MaxQuotaRemaining, quota.IncomingRemaining)
+	suite.Equal(types.MaxQuotaRemaining, quota.OutgoingRemaining)
+	suite.Equal(i(10000), quota.AssetOutgoingRemaining)
+	suite.Contains(quota.String(), "Incoming Remaining: unlimited")
+}
+
+func (suite *AtomicSwapTestSuite) TestPruneSwapQuotaUsages() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AssetParams[0].SwapQuota = types.NewSwapQuota(time.Hour, types.NewQuotaLimit(true, i(100000)), types.NewQuotaLimit(true, i(100000)), types.NewQuotaLimit(true, i(100000)))
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c(BNB_DENOM, 1000000))
+	suite.Require().NoError(err)
+
+	createSwap := func(index int, sender, recipient sdk.AccAddress, amount int64) error {
+		return suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[index], suite.ctx.BlockTime().Unix(),
+			types.DefaultMinBlockLock, 0, sender, recipient, TestSenderOtherChain, TestRecipientOtherChain,
+			cs(c(BNB_DENOM, amount)), true, "")
+	}
+
+	start := suite.ctx.BlockTime()
+	suite.Require().NoError(createSwap(0, suite.addrs[1], suite.deputy, 10000))
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(30 * time.Minute))
+	suite.Require().NoError(createSwap(1, suite.addrs[2], suite.deputy, 10000))
+
+	// usage within the quota period is kept
+	suite.keeper.PruneSwapQuotaUsages(suite.ctx)
+	suite.Len(suite.keeper.GetAllAddressQuotaUsages(suite.ctx), 2)
+
+	// usage of addresses that stop swapping is removed once the quota period has passed
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(time.Hour))
+	suite.keeper.PruneSwapQuotaUsages(suite.ctx)
+	usages := suite.keeper.GetAllAddressQuotaUsages(suite.ctx)
+	suite.Require().Len(usages, 1)
+	suite.Equal(suite.addrs[2], usages[0].Address)
+	outgoingUsage, found := suite.keeper.GetOutgoingQuotaUsage(suite.ctx, BNB_DENOM)
+	suite.Require().True(found)
+	suite.Len(outgoingUsage.Outgoing, 1)
+
+	suite.ctx = suite.ctx.WithBlockTime(start.Add(90 * time.Minute))
+	suite.keeper.PruneSwapQuotaUsages(suite.ctx)
+	suite.Empty(suite.keeper.GetAllAddressQuotaUsages(suite.ctx))
+	suite.Empty(suite.keeper.GetAllOutgoingQuotaUsages(suite.ctx))
+}
+
+func (suite *AtomicSwapTestSuite) TestRefundAtomicSwapReleasesQuota() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AssetParams[0].SwapQuota = types.NewSwapQuota(time.Hour, types.NewQuotaLimit(true, i(100000)), types.NewQuotaLimit(true, i(100000)), types.NewQuotaLimit(true, i(100000)))
+	suite.keeper.SetParams(suite.ctx, params)
+
+	err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c(BNB_DENOM, 1000000))
+	suite.Require().NoError(err)
+
+	err = suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[0], suite.ctx.BlockTime().Unix(),
+		types.DefaultMinBlockLock, 0, suite.addrs[1], suite.deputy, TestSenderOtherChain, TestRecipientOtherChain,
+		cs(c(BNB_DENOM, 80000)), true, "")
+	suite.Require().NoError(err)
+	swapID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.addrs[1], TestSenderOtherChain)
+
+	quota, err := suite.keeper.GetAddressQuota(suite.ctx, BNB_DENOM, suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Equal(i(20000), quota.OutgoingRemaining)
+	suite.Equal(i(20000), quota.AssetOutgoingRemaining)
+
+	// refunding the swap releases the quota it consumed
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + int64(types.DefaultMinBlockLock))
+	suite.keeper.UpdateExpiredAtomicSwaps(suite.ctx)
+	suite.Require().NoError(suite.keeper.RefundAtomicSwap(suite.ctx, suite.addrs[1], swapID))
+
+	quota, err = suite.keeper.GetAddressQuota(suite.ctx, BNB_DENOM, suite.addrs[1])
+	suite.Require().NoError(err)
+	suite.Equal(i(100000), quota.OutgoingRemaining)
+	suite.Equal(i(100000), quota.AssetOutgoingRemaining)
+	_, found := suite.keeper.GetAddressQuotaUsage(suite.ctx, BNB_DENOM, suite.addrs[1])
+	suite.False(found)
+}
+
+func (suite *AtomicSwapTestSuite) TestCreateAtomicSwapWithTimeExpiry() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.AssetParams[0].TimeExpiry = types.NewTimeExpiry(true, time.Minute, time.Hour)
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// Increment current asset supply to support outgoing swaps
+	err := suite.keeper.IncrementCurrentAssetSupply(suite.ctx, c(BNB_DENOM, 1000000))
+	suite.Require().NoError(err)
+
+	createSwap := func(index int, sender, recipient sdk.AccAddress, heightSpan, timeSpan uint64) error {
+		return suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[index], suite.ctx.BlockTime().Unix(),
+			heightSpan, timeSpan, sender, recipient, TestSenderOtherChain, TestRecipientOtherChain,
+			cs(c(BNB_DENOM, 50000)), true, "")
+	}
+
+	// swaps for assets with time based expiry require a time span
+	err = createSwap(0, suite.deputy, suite.addrs[1], types.DefaultMinBlockLock, 0)
+	suite.Require().True(errors.Is(err, types.ErrInvalidTimeSpan))
+	// outgoing swaps must have a time span within the time lock range
+	err = createSwap(0, suite.addrs[1], suite.deputy, 0, 30)
+	suite.Require().True(errors.Is(err, types.ErrInvalidTimeSpan))
+	err = createSwap(0, suite.addrs[1], suite.deputy, 0, 7200)
+	suite.Require().True(errors.Is(err, types.ErrInvalidTimeSpan))
+	// time spans that would overflow a duration are rejected in both directions
+	err = createSwap(0, suite.addrs[1], suite.deputy, 0, math.MaxUint64/1000)
+	suite.Require().True(errors.Is(err, types.ErrInvalidTimeSpan))
+	err = createSwap(0, suite.deputy, suite.addrs[1], 0, types.MaxTimeSpan+1)
+	suite.Require().True(errors.Is(err, types.ErrInvalidTimeSpan))
+
+	suite.Require().NoError(createSwap(0, suite.deputy, suite.addrs[1], 0, 600))
+	suite.Require().NoError(createSwap(1, suite.addrs[1], suite.deputy, 0, 1800))
+
+	incomingID := types.CalculateSwapID(suite.randomNumberHashes[0], suite.deputy, TestSenderOtherChain)
+	outgoingID := types.CalculateSwapID(suite.randomNumberHashes[1], suite.addrs[1], TestSenderOtherChain)
+	swap, found := suite.keeper.GetAtomicSwap(suite.ctx, incomingID)
+	suite.Require().True(found)
+	suite.Equal(uint64(0), swap.ExpireHeight)
+	suite.Equal(suite.ctx.BlockTime().Unix()+600, swap.ExpireTime)
+
+	// swaps do not expire by block height
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + int64(types.DefaultMaxBlockLock))
+	suite.keeper.UpdateExpiredAtomicSwaps(suite.ctx)
+	swap, _ = suite.keeper.GetAtomicSwap(suite.ctx, incomingID)
+	suite.Equal(types.Open, swap.Status)
+
+	// swaps expire once the block time reaches their expire time
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(10 * time.Minute))
+	suite.keeper.UpdateExpiredAtomicSwaps(suite.ctx)
+	swap, _ = suite.keeper.GetAtomicSwap(suite.ctx, incomingID)
+	suite.Equal(types.Expired, swap.Status)
+	swap, _ = suite.keeper.GetAtomicSwap(suite.ctx, outgoingID)
+	suite.Equal(types.Open, swap.Status)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(20 * time.Minute))
+	suite.keeper.UpdateExpiredAtomicSwaps(suite.ctx)
+	swap, _ = suite.keeper.GetAtomicSwap(suite.ctx, outgoingID)
+	suite.Equal(types.Expired, swap.Status)
+}
+
 func (suite *AtomicSwapTestSuite) TestClaimAtomicSwap() {
 	suite.SetupTest()
 	currentTmTime := tmtime.Now()
@@ -600,8 +992,8 @@ func (suite *AtomicSwapTestSuite) TestClaimAtomicSwap() {
 
 			// Create atomic swap
 			err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[i], suite.timestamps[i],
-				types.DefaultMinBlockLock, sender, expectedRecipient, TestSenderOtherChain, TestRecipientOtherChain,
-				tc.args.coins, true)
+				types.DefaultMinBlockLock, 0, sender, expectedRecipient, TestSenderOtherChain, TestRecipientOtherChain,
+				tc.args.coins, true, "")
 			suite.NoError(err)
 
 			realSwapID := types.CalculateSwapID(suite.randomNumberHashes[i], sender, TestSenderOtherChain)
@@ -756,8 +1148,8 @@ func (suite *AtomicSwapTestSuite) TestRefundAtomicSwap() {
 			}
 
 			err := suite.keeper.CreateAtomicSwap(suite.ctx, suite.randomNumberHashes[i], suite.timestamps[i],
-				types.DefaultMinBlockLock, sender, expectedRecipient, TestSenderOtherChain, TestRecipientOtherChain,
-				expectedRefundAmount, true)
+				types.DefaultMinBlockLock, 0, sender, expectedRecipient, TestSenderOtherChain, TestRecipientOtherChain,
+				expectedRefundAmount, true, "")
 			suite.NoError(err)
 
 			realSwapID := types.CalculateSwapID(suite.randomNumberHashes[i], sender, TestSenderOtherChain)
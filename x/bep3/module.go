@@ -98,7 +98,9 @@ func (AppModule) Name() string {
 }
 
 // RegisterInvariants registers the bep3 module invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the bep3 module.
 func (AppModule) Route() string {
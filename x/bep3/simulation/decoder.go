@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"time"
 
@@ -23,15 +24,26 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		return fmt.Sprintf("%v\n%v", swapA, swapB)
 
 	case bytes.Equal(kvA.Key[:1], types.AtomicSwapByBlockPrefix),
-		bytes.Equal(kvA.Key[:1], types.AtomicSwapLongtermStoragePrefix):
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapByTimePrefix),
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapLongtermStoragePrefix),
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapByUpdatePrefix),
+		bytes.Equal(kvA.Key[:1], types.AtomicSwapTombstonePrefix):
 		var bytesA tmbytes.HexBytes = kvA.Value
 		var bytesB tmbytes.HexBytes = kvA.Value
 		return fmt.Sprintf("%s\n%s", bytesA.String(), bytesB.String())
+	case bytes.Equal(kvA.Key[:1], types.AtomicSwapUpdateHeightPrefix):
+		return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
 	case bytes.Equal(kvA.Key[:1], types.AssetSupplyPrefix):
 		var supplyA, supplyB types.AssetSupply
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &supplyA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &supplyB)
 		return fmt.Sprintf("%s\n%s", supplyA, supplyB)
+	case bytes.Equal(kvA.Key[:1], types.AddressQuotaUsagePrefix),
+		bytes.Equal(kvA.Key[:1], types.OutgoingQuotaUsagePrefix):
+		var usageA, usageB types.QuotaUsage
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &usageA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &usageB)
+		return fmt.Sprintf("%s\n%s", usageA, usageB)
 	case bytes.Equal(kvA.Key[:1], types.PreviousBlockTimeKey):
 		var timeA, timeB time.Time
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &timeA)
@@ -28,7 +28,7 @@ func TestDecodeBep3Store(t *testing.T) {
 	prevBlockTime := time.Now().UTC()
 
 	oneCoin := sdk.NewCoin("coin", sdk.OneInt())
-	swap := types.NewAtomicSwap(sdk.Coins{oneCoin}, nil, 10, 100, nil, nil, "otherChainSender", "otherChainRec", 200, types.Completed, true, types.Outgoing)
+	swap := types.NewAtomicSwap(sdk.Coins{oneCoin}, nil, 10, 100, nil, nil, "otherChainSender", "otherChainRec", 200, types.Completed, true, types.Outgoing, "")
 	supply := types.AssetSupply{IncomingSupply: oneCoin, OutgoingSupply: oneCoin, CurrentSupply: oneCoin, TimeLimitedCurrentSupply: oneCoin, TimeElapsed: time.Duration(0)}
 	bz := tmbytes.HexBytes([]byte{1, 2})
 
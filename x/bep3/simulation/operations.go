@@ -168,7 +168,7 @@ func SimulateMsgCreateAtomicSwap(ak types.AccountKeeper, k keeper.Keeper) simula
 
 		msg := types.NewMsgCreateAtomicSwap(
 			sender.Address, recipient.Address, recipientOtherChain, senderOtherChain,
-			randomNumberHash, timestamp, coins, heightSpan,
+			randomNumberHash, timestamp, coins, heightSpan, "",
 		)
 
 		tx := helpers.GenTx(
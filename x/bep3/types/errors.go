@@ -45,4 +45,20 @@ var (
 	ErrInvalidSwapAccount = sdkerrors.Register(ModuleName, 19, "atomic swap has invalid account")
 	// ErrExceedsTimeBasedSupplyLimit error for when the proposed supply increase would put the supply above limit for the current time period
 	ErrExceedsTimeBasedSupplyLimit = sdkerrors.Register(ModuleName, 20, "asset supply over limit for current time period")
+	// ErrChainNotSupported error for when a counterparty chain is not supported
+	ErrChainNotSupported = sdkerrors.Register(ModuleName, 21, "chain not found")
+	// ErrAssetNotSupportedOnChain error for when an asset cannot be swapped with a counterparty chain
+	ErrAssetNotSupportedOnChain = sdkerrors.Register(ModuleName, 22, "asset not supported on chain")
+	// ErrInvalidOtherChainAddress error for when an address does not match the counterparty chain's address format
+	ErrInvalidOtherChainAddress = sdkerrors.Register(ModuleName, 23, "invalid address for counterparty chain")
+	// ErrExceedsAddressQuota error for when a swap would put an address above its quota for the current window
+	ErrExceedsAddressQuota = sdkerrors.Register(ModuleName, 24, "swap exceeds address quota for current window")
+	// ErrExceedsOutgoingQuota error for when an outgoing swap would put the asset above its outgoing limit for the current window
+	ErrExceedsOutgoingQuota = sdkerrors.Register(ModuleName, 25, "outgoing swap exceeds asset outgoing limit for current window")
+	// ErrInvalidTimeSpan error for when a time span is inside an invalid range
+	ErrInvalidTimeSpan = sdkerrors.Register(ModuleName, 26, "time span is outside acceptable range")
+	// ErrExceedsChainSupplyLimit error for when the proposed supply increase would put the supply from a counterparty chain above its limit
+	ErrExceedsChainSupplyLimit = sdkerrors.Register(ModuleName, 27, "asset supply over limit for counterparty chain")
+	// ErrExceedsAvailableChainSupply error for when an outgoing swap exceeds the supply that was swapped in from the counterparty chain
+	ErrExceedsAvailableChainSupply = sdkerrors.Register(ModuleName, 28, "outgoing swap exceeds available supply for counterparty chain")
 )
@@ -6,6 +6,11 @@ const (
 	EventTypeClaimAtomicSwap  = "claim_atomic_swap"
 	EventTypeRefundAtomicSwap = "refund_atomic_swap"
 	EventTypeSwapsExpired     = "swaps_expired"
+	EventTypeSwapExpired      = "swap_expired"
+	EventTypeSwapDeleted      = "swap_deleted"
+
+	EventTypeDeputyRotationActivated = "deputy_rotation_activated"
+	EventTypeDeputyRotationCompleted = "deputy_rotation_completed"
 
 	AttributeValueCategory       = ModuleName
 	AttributeKeySender           = "sender"
@@ -15,11 +20,19 @@ const (
 	AttributeKeyTimestamp        = "timestamp"
 	AttributeKeySenderOtherChain = "sender_other_chain"
 	AttributeKeyExpireHeight     = "expire_height"
+	AttributeKeyExpireTime       = "expire_time"
 	AttributeKeyAmount           = "amount"
 	AttributeKeyDirection        = "direction"
+	AttributeKeyStatus           = "status"
+	AttributeKeyClosedBlock      = "closed_block"
+	AttributeKeyChainID          = "chain_id"
 	AttributeKeyClaimSender      = "claim_sender"
 	AttributeKeyRandomNumber     = "random_number"
 	AttributeKeyRefundSender     = "refund_sender"
 	AttributeKeyAtomicSwapIDs    = "atomic_swap_ids"
 	AttributeExpirationBlock     = "expiration_block"
+	AttributeKeyDenom            = "denom"
+	AttributeKeyOldDeputy        = "old_deputy"
+	AttributeKeyNewDeputy        = "new_deputy"
+	AttributeKeyOverlapEndHeight = "overlap_end_height"
 )
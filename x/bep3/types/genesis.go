@@ -9,19 +9,26 @@ import (
 
 // GenesisState - all bep3 state that must be provided at genesis
 type GenesisState struct {
-	Params            Params        `json:"params" yaml:"params"`
-	AtomicSwaps       AtomicSwaps   `json:"atomic_swaps" yaml:"atomic_swaps"`
-	Supplies          AssetSupplies `json:"supplies" yaml:"supplies"`
-	PreviousBlockTime time.Time     `json:"previous_block_time" yaml:"previous_block_time"`
+	Params              Params              `json:"params" yaml:"params"`
+	AtomicSwaps         AtomicSwaps         `json:"atomic_swaps" yaml:"atomic_swaps"`
+	Supplies            AssetSupplies       `json:"supplies" yaml:"supplies"`
+	ChainSupplies       ChainSupplies       `json:"chain_supplies" yaml:"chain_supplies"`
+	AddressQuotaUsages  AddressQuotaUsages  `json:"address_quota_usages" yaml:"address_quota_usages"`
+	OutgoingQuotaUsages OutgoingQuotaUsages `json:"outgoing_quota_usages" yaml:"outgoing_quota_usages"`
+	PreviousBlockTime   time.Time           `json:"previous_block_time" yaml:"previous_block_time"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(params Params, swaps AtomicSwaps, supplies AssetSupplies, previousBlockTime time.Time) GenesisState {
+func NewGenesisState(params Params, swaps AtomicSwaps, supplies AssetSupplies, chainSupplies ChainSupplies,
+	addressQuotaUsages AddressQuotaUsages, outgoingQuotaUsages OutgoingQuotaUsages, previousBlockTime time.Time) GenesisState {
 	return GenesisState{
-		Params:            params,
-		AtomicSwaps:       swaps,
-		Supplies:          supplies,
-		PreviousBlockTime: previousBlockTime,
+		Params:              params,
+		AtomicSwaps:         swaps,
+		Supplies:            supplies,
+		ChainSupplies:       chainSupplies,
+		AddressQuotaUsages:  addressQuotaUsages,
+		OutgoingQuotaUsages: outgoingQuotaUsages,
+		PreviousBlockTime:   previousBlockTime,
 	}
 }
 
@@ -31,6 +38,9 @@ func DefaultGenesisState() GenesisState {
 		DefaultParams(),
 		AtomicSwaps{},
 		AssetSupplies{},
+		ChainSupplies{},
+		AddressQuotaUsages{},
+		OutgoingQuotaUsages{},
 		DefaultPreviousBlockTime,
 	)
 }
@@ -76,5 +86,40 @@ func (gs GenesisState) Validate() error {
 		}
 		supplyDenoms[supply.GetDenom()] = true
 	}
+
+	chainSupplies := map[string]bool{}
+	for _, supply := range gs.ChainSupplies {
+		if err := supply.Validate(); err != nil {
+			return err
+		}
+		key := string(GetChainSupplyKey(supply.ChainID, supply.GetDenom()))
+		if chainSupplies[key] {
+			return fmt.Errorf("found duplicate chain supply %s %s", supply.ChainID, supply.GetDenom())
+		}
+		chainSupplies[key] = true
+	}
+
+	addressQuotaUsages := map[string]bool{}
+	for _, usage := range gs.AddressQuotaUsages {
+		if err := usage.Validate(); err != nil {
+			return err
+		}
+		key := string(GetAddressQuotaUsageKey(usage.Denom, usage.Address))
+		if addressQuotaUsages[key] {
+			return fmt.Errorf("found duplicate address quota usage %s %s", usage.Denom, usage.Address)
+		}
+		addressQuotaUsages[key] = true
+	}
+
+	outgoingQuotaUsages := map[string]bool{}
+	for _, usage := range gs.OutgoingQuotaUsages {
+		if err := usage.Validate(); err != nil {
+			return err
+		}
+		if outgoingQuotaUsages[usage.Denom] {
+			return fmt.Errorf("found duplicate outgoing quota usage %s", usage.Denom)
+		}
+		outgoingQuotaUsages[usage.Denom] = true
+	}
 	return nil
 }
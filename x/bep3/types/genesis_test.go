@@ -105,7 +105,7 @@ func (suite *GenesisTestSuite) TestValidate() {
 			if tc.name == "default" {
 				gs = types.DefaultGenesisState()
 			} else {
-				gs = types.NewGenesisState(types.DefaultParams(), tc.args.swaps, tc.args.supplies, tc.args.previousBlockTime)
+				gs = types.NewGenesisState(types.DefaultParams(), tc.args.swaps, tc.args.supplies, types.ChainSupplies{}, types.AddressQuotaUsages{}, types.OutgoingQuotaUsages{}, tc.args.previousBlockTime)
 			}
 
 			err := gs.Validate()
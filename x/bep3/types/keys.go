@@ -1,6 +1,7 @@
 package types
 
 import (
+	"bytes"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,9 +37,38 @@ var (
 	AtomicSwapLongtermStoragePrefix = []byte{0x02} // prefix for keys of the AtomicSwapLongtermStorage index
 	AssetSupplyPrefix               = []byte{0x03}
 	PreviousBlockTimeKey            = []byte{0x04}
+	AddressQuotaUsagePrefix         = []byte{0x05} // prefix for keys that store the quota usage of each address
+	OutgoingQuotaUsagePrefix        = []byte{0x06} // prefix for keys that store the outgoing quota usage of each asset
+	AtomicSwapByUpdatePrefix        = []byte{0x07} // prefix for keys of the AtomicSwapByUpdate index
+	AtomicSwapUpdateHeightPrefix    = []byte{0x08} // prefix for keys that store the height each swap was last updated at
+	AtomicSwapByTimePrefix          = []byte{0x09} // prefix for keys of the AtomicSwapByTime index
+	ChainSupplyPrefix               = []byte{0x0A} // prefix for keys that store the supply of each asset swapped with each counterparty chain
+	DeputyRotationActivationPrefix  = []byte{0x0B} // prefix for keys that store the height each asset's deputy rotation was activated at
+	AtomicSwapTombstonePrefix       = []byte{0x0C} // prefix for keys of the AtomicSwapTombstone index
 )
 
-// GetAtomicSwapByHeightKey is used by the AtomicSwapByBlock index and AtomicSwapLongtermStorage index
+// GetAddressQuotaUsageKey is used to store the quota usage of an address for swaps of a denom
+func GetAddressQuotaUsageKey(denom string, address sdk.AccAddress) []byte {
+	return append([]byte(denom+":"), address.Bytes()...)
+}
+
+// SplitAddressQuotaUsageKey returns the denom and address of an address quota usage key
+func SplitAddressQuotaUsageKey(key []byte) (string, sdk.AccAddress) {
+	i := bytes.IndexByte(key, ':')
+	return string(key[:i]), sdk.AccAddress(key[i+1:])
+}
+
+// GetChainSupplyKey is used to store the supply of a denom that has been swapped with a counterparty chain
+func GetChainSupplyKey(chainID, denom string) []byte {
+	return []byte(denom + ":" + chainID)
+}
+
+// GetAtomicSwapByHeightKey is used by the AtomicSwapByBlock, AtomicSwapLongtermStorage, AtomicSwapByUpdate and AtomicSwapTombstone indexes
 func GetAtomicSwapByHeightKey(height uint64, swapID []byte) []byte {
 	return append(sdk.Uint64ToBigEndian(height), swapID...)
 }
+
+// GetAtomicSwapByTimeKey is used by the AtomicSwapByTime index
+func GetAtomicSwapByTimeKey(expireTime int64, swapID []byte) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(expireTime)), swapID...)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/tendermint/tendermint/crypto"
 	tmbytes "github.com/tendermint/tendermint/libs/bytes"
@@ -25,6 +26,7 @@ const (
 	MaxOtherChainAddrLength = 64
 	SwapIDLength            = 32
 	MaxExpectedIncomeLength = 64
+	MaxTimeSpan             = uint64(AbsoluteMaxTimeLock / time.Second)
 )
 
 // ensure Msg interface compliance at compile time
@@ -47,12 +49,14 @@ type MsgCreateAtomicSwap struct {
 	Timestamp           int64            `json:"timestamp"  yaml:"timestamp"`
 	Amount              sdk.Coins        `json:"amount"  yaml:"amount"`
 	HeightSpan          uint64           `json:"height_span"  yaml:"height_span"`
+	ChainID             string           `json:"chain_id,omitempty"  yaml:"chain_id"`
+	TimeSpan            uint64           `json:"time_span,omitempty"  yaml:"time_span"`
 }
 
 // NewMsgCreateAtomicSwap initializes a new MsgCreateAtomicSwap
 func NewMsgCreateAtomicSwap(from sdk.AccAddress, to sdk.AccAddress, recipientOtherChain,
 	senderOtherChain string, randomNumberHash tmbytes.HexBytes, timestamp int64,
-	amount sdk.Coins, heightSpan uint64) MsgCreateAtomicSwap {
+	amount sdk.Coins, heightSpan uint64, chainID string) MsgCreateAtomicSwap {
 	return MsgCreateAtomicSwap{
 		From:                from,
 		To:                  to,
@@ -62,6 +66,7 @@ func NewMsgCreateAtomicSwap(from sdk.AccAddress, to sdk.AccAddress, recipientOth
 		Timestamp:           timestamp,
 		Amount:              amount,
 		HeightSpan:          heightSpan,
+		ChainID:             chainID,
 	}
 }
 
@@ -73,9 +78,9 @@ func (msg MsgCreateAtomicSwap) Type() string { return CreateAtomicSwap }
 
 // String prints the MsgCreateAtomicSwap
 func (msg MsgCreateAtomicSwap) String() string {
-	return fmt.Sprintf("AtomicSwap{%v#%v#%v#%v#%v#%v#%v#%v}",
+	return fmt.Sprintf("AtomicSwap{%v#%v#%v#%v#%v#%v#%v#%v#%v#%v}",
 		msg.From, msg.To, msg.RecipientOtherChain, msg.SenderOtherChain,
-		msg.RandomNumberHash, msg.Timestamp, msg.Amount, msg.HeightSpan)
+		msg.RandomNumberHash, msg.Timestamp, msg.Amount, msg.HeightSpan, msg.ChainID, msg.TimeSpan)
 }
 
 // GetInvolvedAddresses gets the addresses involved in a MsgCreateAtomicSwap
@@ -123,8 +128,14 @@ func (msg MsgCreateAtomicSwap) ValidateBasic() error {
 	if !msg.Amount.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
 	}
-	if msg.HeightSpan <= 0 {
-		return errors.New("height span must be positive")
+	if msg.HeightSpan <= 0 && msg.TimeSpan <= 0 {
+		return errors.New("height span or time span must be positive")
+	}
+	if msg.TimeSpan > MaxTimeSpan {
+		return fmt.Errorf("time span cannot be greater than %d seconds", MaxTimeSpan)
+	}
+	if msg.ChainID != strings.TrimSpace(msg.ChainID) {
+		return errors.New("chain id cannot contain leading or trailing whitespace")
 	}
 	return nil
 }
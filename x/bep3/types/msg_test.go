@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -56,7 +57,7 @@ func TestMsgCreateAtomicSwap(t *testing.T) {
 			tc.randomNumberHash,
 			tc.timestamp,
 			tc.amount,
-			tc.heightSpan,
+			tc.heightSpan, "",
 		)
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
@@ -66,6 +67,30 @@ func TestMsgCreateAtomicSwap(t *testing.T) {
 	}
 }
 
+func TestMsgCreateAtomicSwapTimeSpan(t *testing.T) {
+	tests := []struct {
+		description string
+		timeSpan    uint64
+		expectPass  bool
+	}{
+		{"time span", 600, true},
+		{"max time span", types.MaxTimeSpan, true},
+		{"time span above max", types.MaxTimeSpan + 1, false},
+		{"overflowing time span", math.MaxUint64, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgCreateAtomicSwap(binanceAddrs[0], kavaAddrs[0], kavaAddrs[0].String(), binanceAddrs[0].String(),
+			randomNumberHash, timestampInt64, coinsSingle, 0, "")
+		msg.TimeSpan = tc.timeSpan
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgClaimAtomicSwap(t *testing.T) {
 	swapID := types.CalculateSwapID(randomNumberHash, binanceAddrs[0], "")
 
@@ -2,21 +2,27 @@ package types
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 
+	"github.com/tendermint/tendermint/libs/bech32"
 	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 const (
 	bech32MainPrefix = "kava"
+
+	// AbsoluteMaxTimeLock is the longest time lock an asset's time expiry can allow
+	AbsoluteMaxTimeLock = time.Hour * 24 * 365
 )
 
 // Parameter keys
 var (
 	KeyAssetParams = []byte("AssetParams")
+	KeyChainParams = []byte("ChainParams")
 
 	DefaultBnbDeputyFixedFee sdk.Int = sdk.NewInt(1000) // 0.00001 BNB
 	DefaultMinAmount         sdk.Int = sdk.ZeroInt()
@@ -29,47 +35,54 @@ var (
 // Params governance parameters for bep3 module
 type Params struct {
 	AssetParams AssetParams `json:"asset_params" yaml:"asset_params"`
+	ChainParams ChainParams `json:"chain_params" yaml:"chain_params"`
 }
 
 // String implements fmt.Stringer
 func (p Params) String() string {
 	return fmt.Sprintf(`Params:
-	AssetParams: %s`,
-		p.AssetParams)
+	AssetParams: %s
+	ChainParams: %s`,
+		p.AssetParams, p.ChainParams)
 }
 
 // NewParams returns a new params object
-func NewParams(ap AssetParams,
+func NewParams(ap AssetParams, cp ChainParams,
 ) Params {
 	return Params{
 		AssetParams: ap,
+		ChainParams: cp,
 	}
 }
 
 // DefaultParams returns default params for bep3 module
 func DefaultParams() Params {
-	return NewParams(AssetParams{})
+	return NewParams(AssetParams{}, ChainParams{})
 }
 
 // AssetParam parameters that must be specified for each bep3 asset
 type AssetParam struct {
-	Denom         string         `json:"denom" yaml:"denom"`                     // name of the asset
-	CoinID        int            `json:"coin_id" yaml:"coin_id"`                 // SLIP-0044 registered coin type - see https://github.com/satoshilabs/slips/blob/master/slip-0044.md
-	SupplyLimit   SupplyLimit    `json:"supply_limit" yaml:"supply_limit"`       // asset supply limit
-	Active        bool           `json:"active" yaml:"active"`                   // denotes if asset is available or paused
-	DeputyAddress sdk.AccAddress `json:"deputy_address" yaml:"deputy_address"`   // the address of the relayer process
-	FixedFee      sdk.Int        `json:"fixed_fee" yaml:"fixed_fee"`             // the fixed fee charged by the relayer process for outgoing swaps
-	MinSwapAmount sdk.Int        `json:"min_swap_amount" yaml:"min_swap_amount"` // Minimum swap amount
-	MaxSwapAmount sdk.Int        `json:"max_swap_amount" yaml:"max_swap_amount"` // Maximum swap amount
-	MinBlockLock  uint64         `json:"min_block_lock" yaml:"min_block_lock"`   // Minimum swap block lock
-	MaxBlockLock  uint64         `json:"max_block_lock" yaml:"max_block_lock"`   // Maximum swap block lock
+	Denom          string         `json:"denom" yaml:"denom"`                     // name of the asset
+	CoinID         int            `json:"coin_id" yaml:"coin_id"`                 // SLIP-0044 registered coin type - see https://github.com/satoshilabs/slips/blob/master/slip-0044.md
+	SupplyLimit    SupplyLimit    `json:"supply_limit" yaml:"supply_limit"`       // asset supply limit
+	Active         bool           `json:"active" yaml:"active"`                   // denotes if asset is available or paused
+	DeputyAddress  sdk.AccAddress `json:"deputy_address" yaml:"deputy_address"`   // the address of the relayer process
+	FixedFee       sdk.Int        `json:"fixed_fee" yaml:"fixed_fee"`             // the fixed fee charged by the relayer process for outgoing swaps
+	MinSwapAmount  sdk.Int        `json:"min_swap_amount" yaml:"min_swap_amount"` // Minimum swap amount
+	MaxSwapAmount  sdk.Int        `json:"max_swap_amount" yaml:"max_swap_amount"` // Maximum swap amount
+	MinBlockLock   uint64         `json:"min_block_lock" yaml:"min_block_lock"`   // Minimum swap block lock
+	MaxBlockLock   uint64         `json:"max_block_lock" yaml:"max_block_lock"`   // Maximum swap block lock
+	ChainIDs       []string       `json:"chain_ids" yaml:"chain_ids"`             // counterparty chains the asset can be swapped with, in addition to the asset's own deputy
+	DeputyRotation DeputyRotation `json:"deputy_rotation" yaml:"deputy_rotation"` // scheduled replacement of the asset's deputy, if any
+	SwapQuota      SwapQuota      `json:"swap_quota" yaml:"swap_quota"`           // per-address and outgoing rate limits on swaps
+	TimeExpiry     TimeExpiry     `json:"time_expiry" yaml:"time_expiry"`         // expire swaps at a block time instead of a block height
 }
 
 // NewAssetParam returns a new AssetParam
 func NewAssetParam(
 	denom string, coinID int, limit SupplyLimit, active bool,
 	deputyAddr sdk.AccAddress, fixedFee sdk.Int, minSwapAmount sdk.Int,
-	maxSwapAmount sdk.Int, minBlockLock uint64, maxBlockLock uint64,
+	maxSwapAmount sdk.Int, minBlockLock uint64, maxBlockLock uint64, chainIDs []string,
 ) AssetParam {
 	return AssetParam{
 		Denom:         denom,
@@ -82,6 +95,7 @@ func NewAssetParam(
 		MaxSwapAmount: maxSwapAmount,
 		MinBlockLock:  minBlockLock,
 		MaxBlockLock:  maxBlockLock,
+		ChainIDs:      chainIDs,
 	}
 }
 
@@ -97,9 +111,45 @@ func (ap AssetParam) String() string {
 	Min Swap Amount: %s
 	Max Swap Amount: %s
 	Min Block Lock: %d
-	Max Block Lock: %d`,
+	Max Block Lock: %d
+	Chain IDs: %s
+	Deputy Rotation: %s
+	Swap Quota: %s
+	Time Expiry: %s`,
 		ap.Denom, ap.CoinID, ap.SupplyLimit, ap.Active, ap.DeputyAddress, ap.FixedFee,
-		ap.MinSwapAmount, ap.MaxSwapAmount, ap.MinBlockLock, ap.MaxBlockLock)
+		ap.MinSwapAmount, ap.MaxSwapAmount, ap.MinBlockLock, ap.MaxBlockLock, strings.Join(ap.ChainIDs, ", "),
+		ap.DeputyRotation, ap.SwapQuota, ap.TimeExpiry)
+}
+
+// CurrentDeputyAddress returns the deputy that relays swaps of the asset at the input height
+func (ap AssetParam) CurrentDeputyAddress(height int64) sdk.AccAddress {
+	if ap.DeputyRotation.IsScheduled() && height >= ap.DeputyRotation.ActivationHeight {
+		return ap.DeputyRotation.NewDeputyAddress
+	}
+	return ap.DeputyAddress
+}
+
+// DeputyAddresses returns all deputies that are allowed to relay swaps of the asset at the input height.
+// During a deputy rotation's overlap window both the old and the new deputy are valid.
+func (ap AssetParam) DeputyAddresses(height int64) []sdk.AccAddress {
+	rotation := ap.DeputyRotation
+	if !rotation.IsScheduled() || height < rotation.ActivationHeight {
+		return []sdk.AccAddress{ap.DeputyAddress}
+	}
+	if height < rotation.OverlapEndHeight() {
+		return []sdk.AccAddress{rotation.NewDeputyAddress, ap.DeputyAddress}
+	}
+	return []sdk.AccAddress{rotation.NewDeputyAddress}
+}
+
+// SupportsChain returns true if the asset can be swapped with the input counterparty chain
+func (ap AssetParam) SupportsChain(chainID string) bool {
+	for _, id := range ap.ChainIDs {
+		if id == chainID {
+			return true
+		}
+	}
+	return false
 }
 
 // AssetParams array of AssetParam
@@ -114,6 +164,154 @@ func (aps AssetParams) String() string {
 	return out
 }
 
+// DeputyRotation schedules the replacement of an asset's deputy.
+// The new deputy takes effect at the activation height, and the old deputy remains valid for the overlap
+// so that swaps it is relaying can complete.
+type DeputyRotation struct {
+	NewDeputyAddress sdk.AccAddress `json:"new_deputy_address" yaml:"new_deputy_address"` // the address of the replacement relayer process
+	ActivationHeight int64          `json:"activation_height" yaml:"activation_height"`   // block height at which the new deputy takes effect
+	OverlapBlocks    int64          `json:"overlap_blocks" yaml:"overlap_blocks"`         // number of blocks after activation for which the old deputy remains valid
+}
+
+// NewDeputyRotation returns a new DeputyRotation
+func NewDeputyRotation(newDeputyAddr sdk.AccAddress, activationHeight, overlapBlocks int64) DeputyRotation {
+	return DeputyRotation{
+		NewDeputyAddress: newDeputyAddr,
+		ActivationHeight: activationHeight,
+		OverlapBlocks:    overlapBlocks,
+	}
+}
+
+// IsScheduled returns true if a replacement deputy has been set
+func (dr DeputyRotation) IsScheduled() bool {
+	return !dr.NewDeputyAddress.Empty()
+}
+
+// OverlapEndHeight returns the first block height at which the old deputy is no longer valid
+func (dr DeputyRotation) OverlapEndHeight() int64 {
+	return dr.ActivationHeight + dr.OverlapBlocks
+}
+
+// Equal returns true if two deputy rotations are equal
+func (dr DeputyRotation) Equal(dr2 DeputyRotation) bool {
+	return dr.NewDeputyAddress.Equals(dr2.NewDeputyAddress) && dr.ActivationHeight == dr2.ActivationHeight && dr.OverlapBlocks == dr2.OverlapBlocks
+}
+
+// String implements fmt.Stringer
+func (dr DeputyRotation) String() string {
+	return fmt.Sprintf(`Deputy Rotation:
+	New Deputy Address: %s
+	Activation Height: %d
+	Overlap Blocks: %d`,
+		dr.NewDeputyAddress, dr.ActivationHeight, dr.OverlapBlocks)
+}
+
+// TimeExpiry parameters that switch an asset's swaps from height-based to timestamp-based expiry.
+// When enabled, swaps expire at the block time they were created plus a time span, which must be within the time lock range.
+type TimeExpiry struct {
+	Enabled     bool          `json:"enabled" yaml:"enabled"`             // denotes if swaps expire at a block time
+	MinTimeLock time.Duration `json:"min_time_lock" yaml:"min_time_lock"` // minimum swap time lock
+	MaxTimeLock time.Duration `json:"max_time_lock" yaml:"max_time_lock"` // maximum swap time lock
+}
+
+// NewTimeExpiry returns a new TimeExpiry
+func NewTimeExpiry(enabled bool, minTimeLock, maxTimeLock time.Duration) TimeExpiry {
+	return TimeExpiry{
+		Enabled:     enabled,
+		MinTimeLock: minTimeLock,
+		MaxTimeLock: maxTimeLock,
+	}
+}
+
+// Validate performs a basic validation of the time expiry fields
+func (te TimeExpiry) Validate() error {
+	if !te.Enabled {
+		return nil
+	}
+	if te.MinTimeLock < time.Second {
+		return fmt.Errorf("minimum time lock must be at least one second, got %s", te.MinTimeLock)
+	}
+	if te.MinTimeLock > te.MaxTimeLock {
+		return fmt.Errorf("minimum time lock > maximum time lock %s > %s", te.MinTimeLock, te.MaxTimeLock)
+	}
+	if te.MaxTimeLock > AbsoluteMaxTimeLock {
+		return fmt.Errorf("maximum time lock %s cannot be greater than %s", te.MaxTimeLock, AbsoluteMaxTimeLock)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (te TimeExpiry) String() string {
+	return fmt.Sprintf(`Time Expiry:
+	Enabled: %t
+	Min Time Lock: %s
+	Max Time Lock: %s`,
+		te.Enabled, te.MinTimeLock, te.MaxTimeLock)
+}
+
+// ChainParam parameters that must be specified for each counterparty chain
+type ChainParam struct {
+	ChainID       string         `json:"chain_id" yaml:"chain_id"`             // chain ID of the counterparty chain
+	AddressPrefix string         `json:"address_prefix" yaml:"address_prefix"` // bech32 human readable part of addresses on the counterparty chain
+	DeputyAddress sdk.AccAddress `json:"deputy_address" yaml:"deputy_address"` // the address of the relayer process for the counterparty chain
+	SupplyLimits  sdk.Coins      `json:"supply_limits" yaml:"supply_limits"`   // the maximum supply of each asset that can be swapped in from the counterparty chain
+}
+
+// NewChainParam returns a new ChainParam
+func NewChainParam(chainID, addressPrefix string, deputyAddr sdk.AccAddress, supplyLimits sdk.Coins) ChainParam {
+	return ChainParam{
+		ChainID:       chainID,
+		AddressPrefix: addressPrefix,
+		DeputyAddress: deputyAddr,
+		SupplyLimits:  supplyLimits,
+	}
+}
+
+// String implements fmt.Stringer
+func (cp ChainParam) String() string {
+	return fmt.Sprintf(`Chain:
+	Chain ID: %s
+	Address Prefix: %s
+	Deputy Address: %s
+	Supply Limits: %s`,
+		cp.ChainID, cp.AddressPrefix, cp.DeputyAddress, cp.SupplyLimits)
+}
+
+// SupplyLimit returns the chain's supply limit for the input denom.
+// Assets without a chain supply limit are only limited by the asset's own supply limit.
+func (cp ChainParam) SupplyLimit(denom string) (sdk.Int, bool) {
+	for _, limit := range cp.SupplyLimits {
+		if limit.Denom == denom {
+			return limit.Amount, true
+		}
+	}
+	return sdk.ZeroInt(), false
+}
+
+// ValidateAddress checks that the input address is a valid bech32 address on the counterparty chain
+func (cp ChainParam) ValidateAddress(address string) error {
+	hrp, _, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return err
+	}
+	if hrp != cp.AddressPrefix {
+		return fmt.Errorf("invalid address prefix for chain %s: expected %s, got %s", cp.ChainID, cp.AddressPrefix, hrp)
+	}
+	return nil
+}
+
+// ChainParams array of ChainParam
+type ChainParams []ChainParam
+
+// String implements fmt.Stringer
+func (cps ChainParams) String() string {
+	out := "Chain Params\n"
+	for _, cp := range cps {
+		out += fmt.Sprintf("%s\n", cp)
+	}
+	return out
+}
+
 // SupplyLimit parameters that control the absolute and time-based limits for an assets's supply
 type SupplyLimit struct {
 	Limit          sdk.Int       `json:"limit" yaml:"limit"`                       // the absolute supply limit for an asset
@@ -147,12 +345,41 @@ func ParamKeyTable() params.KeyTable {
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyAssetParams, &p.AssetParams, validateAssetParams),
+		params.NewParamSetPair(KeyChainParams, &p.ChainParams, validateChainParams),
 	}
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateAssetParams(p.AssetParams)
+	if err := validateAssetParams(p.AssetParams); err != nil {
+		return err
+	}
+	if err := validateChainParams(p.ChainParams); err != nil {
+		return err
+	}
+
+	chainIDs := make(map[string]bool)
+	for _, chain := range p.ChainParams {
+		chainIDs[chain.ChainID] = true
+	}
+	assets := make(map[string]AssetParam)
+	for _, asset := range p.AssetParams {
+		assets[asset.Denom] = asset
+		for _, chainID := range asset.ChainIDs {
+			if !chainIDs[chainID] {
+				return fmt.Errorf("asset %s references chain %s that is not in the chain params", asset.Denom, chainID)
+			}
+		}
+	}
+	for _, chain := range p.ChainParams {
+		for _, limit := range chain.SupplyLimits {
+			asset, found := assets[limit.Denom]
+			if !found || !asset.SupportsChain(chain.ChainID) {
+				return fmt.Errorf("chain %s has a supply limit for %s, which cannot be swapped with the chain", chain.ChainID, limit.Denom)
+			}
+		}
+	}
+	return nil
 }
 
 func validateAssetParams(i interface{}) error {
@@ -198,6 +425,18 @@ func validateAssetParams(i interface{}) error {
 			return fmt.Errorf("%s deputy address invalid bytes length got %d, want %d", asset.Denom, len(asset.DeputyAddress.Bytes()), sdk.AddrLen)
 		}
 
+		if err := validateDeputyRotation(asset); err != nil {
+			return err
+		}
+
+		if err := asset.SwapQuota.Validate(); err != nil {
+			return fmt.Errorf("asset %s has invalid swap quota: %s", asset.Denom, err)
+		}
+
+		if err := asset.TimeExpiry.Validate(); err != nil {
+			return fmt.Errorf("asset %s has invalid time expiry: %s", asset.Denom, err)
+		}
+
 		if asset.FixedFee.IsNegative() {
 			return fmt.Errorf("asset %s cannot have a negative fixed fee %s", asset.Denom, asset.FixedFee)
 		}
@@ -217,6 +456,82 @@ func validateAssetParams(i interface{}) error {
 		if asset.MinSwapAmount.GT(asset.MaxSwapAmount) {
 			return fmt.Errorf("asset %s has minimum swap amount > maximum swap amount %s > %s", asset.Denom, asset.MinSwapAmount, asset.MaxSwapAmount)
 		}
+
+		assetChainIDs := make(map[string]bool)
+		for _, chainID := range asset.ChainIDs {
+			if strings.TrimSpace(chainID) == "" {
+				return fmt.Errorf("asset %s cannot have a blank chain id", asset.Denom)
+			}
+			if assetChainIDs[chainID] {
+				return fmt.Errorf("asset %s cannot have duplicate chain id %s", asset.Denom, chainID)
+			}
+			assetChainIDs[chainID] = true
+		}
+	}
+
+	return nil
+}
+
+func validateDeputyRotation(asset AssetParam) error {
+	rotation := asset.DeputyRotation
+	if !rotation.IsScheduled() {
+		if rotation.ActivationHeight != 0 || rotation.OverlapBlocks != 0 {
+			return fmt.Errorf("asset %s deputy rotation cannot have an activation height or overlap without a new deputy", asset.Denom)
+		}
+		return nil
+	}
+
+	if len(rotation.NewDeputyAddress.Bytes()) != sdk.AddrLen {
+		return fmt.Errorf("%s new deputy address invalid bytes length got %d, want %d", asset.Denom, len(rotation.NewDeputyAddress.Bytes()), sdk.AddrLen)
+	}
+
+	if rotation.NewDeputyAddress.Equals(asset.DeputyAddress) {
+		return fmt.Errorf("asset %s new deputy address must differ from the current deputy address", asset.Denom)
+	}
+
+	if rotation.ActivationHeight <= 0 {
+		return fmt.Errorf("asset %s deputy rotation must have a positive activation height, got %d", asset.Denom, rotation.ActivationHeight)
+	}
+
+	if rotation.OverlapBlocks < 0 {
+		return fmt.Errorf("asset %s deputy rotation cannot have a negative overlap, got %d", asset.Denom, rotation.OverlapBlocks)
+	}
+
+	return nil
+}
+
+func validateChainParams(i interface{}) error {
+	chainParams, ok := i.(ChainParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	chainIDs := make(map[string]bool)
+	for _, chain := range chainParams {
+		if strings.TrimSpace(chain.ChainID) == "" {
+			return fmt.Errorf("chain id cannot be blank")
+		}
+
+		if chainIDs[chain.ChainID] {
+			return fmt.Errorf("chain %s cannot have duplicate chain id", chain.ChainID)
+		}
+		chainIDs[chain.ChainID] = true
+
+		if strings.TrimSpace(chain.AddressPrefix) == "" {
+			return fmt.Errorf("address prefix cannot be blank for chain %s", chain.ChainID)
+		}
+
+		if chain.DeputyAddress.Empty() {
+			return fmt.Errorf("deputy address cannot be empty for chain %s", chain.ChainID)
+		}
+
+		if len(chain.DeputyAddress.Bytes()) != sdk.AddrLen {
+			return fmt.Errorf("chain %s deputy address invalid bytes length got %d, want %d", chain.ChainID, len(chain.DeputyAddress.Bytes()), sdk.AddrLen)
+		}
+
+		if !chain.SupplyLimits.IsValid() {
+			return fmt.Errorf("chain %s has invalid supply limits %s", chain.ChainID, chain.SupplyLimits)
+		}
 	}
 
 	return nil
@@ -8,6 +8,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/tendermint/tendermint/crypto"
+
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/bep3/types"
 )
@@ -43,6 +45,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 
 	type args struct {
 		assetParams types.AssetParams
+		chainParams types.ChainParams
 	}
 
 	testCases := []struct {
@@ -65,7 +68,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				assetParams: types.AssetParams{types.NewAssetParam(
 					"bnb", 714, suite.supply[0], true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
-					types.DefaultMinBlockLock, types.DefaultMaxBlockLock)},
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)},
 			},
 			expectPass:  true,
 			expectedErr: "",
@@ -76,7 +79,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				assetParams: types.AssetParams{types.NewAssetParam(
 					"bnb", 714, suite.supply[1], true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
-					types.DefaultMinBlockLock, types.DefaultMaxBlockLock)},
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)},
 			},
 			expectPass:  true,
 			expectedErr: "",
@@ -87,11 +90,11 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				assetParams: types.AssetParams{types.NewAssetParam(
 					"bnb", 714, suite.supply[0], true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
-					types.DefaultMinBlockLock, types.DefaultMaxBlockLock),
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil),
 					types.NewAssetParam(
 						"btcb", 0, suite.supply[1], true,
 						suite.addr, sdk.NewInt(1000), sdk.NewInt(10000000), sdk.NewInt(100000000000),
-						types.DefaultMinBlockLock, types.DefaultMaxBlockLock),
+						types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil),
 				},
 			},
 			expectPass:  true,
@@ -103,7 +106,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				assetParams: types.AssetParams{types.NewAssetParam(
 					"", 714, suite.supply[0], true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
-					types.DefaultMinBlockLock, types.DefaultMaxBlockLock)},
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)},
 			},
 			expectPass:  false,
 			expectedErr: "denom invalid",
@@ -114,7 +117,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				assetParams: types.AssetParams{types.NewAssetParam(
 					"BNB", 714, suite.supply[0], true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
-					types.DefaultMinBlockLock, types.DefaultMaxBlockLock)},
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)},
 			},
 			expectPass:  false,
 			expectedErr: "denom invalid",
@@ -125,7 +128,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				assetParams: types.AssetParams{types.NewAssetParam(
 					"bnb", 714, suite.supply[0], true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
-					243, 243)},
+					243, 243, nil)},
 			},
 			expectPass:  true,
 			expectedErr: "",
@@ -136,7 +139,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				assetParams: types.AssetParams{types.NewAssetParam(
 					"bnb", 714, suite.supply[0], true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
-					244, 243)},
+					244, 243, nil)},
 			},
 			expectPass:  false,
 			expectedErr: "minimum block lock > maximum block lock",
@@ -147,7 +150,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				assetParams: types.AssetParams{types.NewAssetParam(
 					"bnb", 714, suite.supply[0], true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(0), sdk.NewInt(10000000000),
-					types.DefaultMinBlockLock, types.DefaultMaxBlockLock)},
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)},
 			},
 			expectPass:  false,
 			expectedErr: "must have a positive minimum swap",
@@ -158,7 +161,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				assetParams: types.AssetParams{types.NewAssetParam(
 					"bnb", 714, suite.supply[0], true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(10000), sdk.NewInt(0),
-					types.DefaultMinBlockLock, types.DefaultMaxBlockLock)},
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)},
 			},
 			expectPass:  false,
 			expectedErr: "must have a positive maximum swap",
@@ -169,7 +172,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				assetParams: types.AssetParams{types.NewAssetParam(
 					"bnb", 714, suite.supply[0], true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000000), sdk.NewInt(10000000000),
-					types.DefaultMinBlockLock, types.DefaultMaxBlockLock)},
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)},
 			},
 			expectPass:  false,
 			expectedErr: "minimum swap amount > maximum swap amount",
@@ -180,7 +183,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				assetParams: types.AssetParams{types.NewAssetParam(
 					"bnb", -714, suite.supply[0], true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
-					types.DefaultMinBlockLock, types.DefaultMaxBlockLock)},
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)},
 			},
 			expectPass:  false,
 			expectedErr: "coin id must be a non negative",
@@ -192,7 +195,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 					"bnb", 714,
 					types.SupplyLimit{sdk.NewInt(-10000000000000), false, time.Hour, sdk.ZeroInt()}, true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
-					types.DefaultMinBlockLock, types.DefaultMaxBlockLock)},
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)},
 			},
 			expectPass:  false,
 			expectedErr: "invalid (negative) supply limit",
@@ -204,7 +207,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 					"bnb", 714,
 					types.SupplyLimit{sdk.NewInt(10000000000000), false, time.Hour, sdk.NewInt(-10000000000000)}, true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
-					types.DefaultMinBlockLock, types.DefaultMaxBlockLock)},
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)},
 			},
 			expectPass:  false,
 			expectedErr: "invalid (negative) supply time limit",
@@ -217,7 +220,7 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 					types.SupplyLimit{sdk.NewInt(10000000000000), true, time.Hour, sdk.NewInt(100000000000000)},
 					true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
-					types.DefaultMinBlockLock, types.DefaultMaxBlockLock)},
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)},
 			},
 			expectPass:  false,
 			expectedErr: "supply time limit > supply limit",
@@ -228,21 +231,249 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 				assetParams: types.AssetParams{types.NewAssetParam(
 					"bnb", 714, suite.supply[0], true,
 					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
-					types.DefaultMinBlockLock, types.DefaultMaxBlockLock),
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil),
 					types.NewAssetParam(
 						"bnb", 0, suite.supply[0], true,
 						suite.addr, sdk.NewInt(1000), sdk.NewInt(10000000), sdk.NewInt(100000000000),
-						types.DefaultMinBlockLock, types.DefaultMaxBlockLock),
+						types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil),
 				},
 			},
 			expectPass:  false,
 			expectedErr: "duplicate denom",
 		},
+		{
+			name: "deputy rotation to same deputy",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					ap := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)
+					ap.DeputyRotation = types.NewDeputyRotation(suite.addr, 100, 10)
+					return ap
+				}()},
+			},
+			expectPass:  false,
+			expectedErr: "must differ from the current deputy",
+		},
+		{
+			name: "deputy rotation negative overlap",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					ap := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)
+					ap.DeputyRotation = types.NewDeputyRotation(sdk.AccAddress(crypto.AddressHash([]byte("newdeputy"))), 100, -1)
+					return ap
+				}()},
+			},
+			expectPass:  false,
+			expectedErr: "negative overlap",
+		},
+		{
+			name: "valid swap quota",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					ap := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)
+					ap.SwapQuota = types.NewSwapQuota(time.Hour, types.NewQuotaLimit(true, sdk.NewInt(1000)), types.NewQuotaLimit(true, sdk.NewInt(1000)), types.NewQuotaLimit(true, sdk.NewInt(10000)))
+					return ap
+				}()},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "swap quota zero period",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					ap := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)
+					ap.SwapQuota = types.NewSwapQuota(0, types.NewQuotaLimit(true, sdk.NewInt(1000)), types.NewQuotaLimit(true, sdk.NewInt(1000)), types.NewQuotaLimit(true, sdk.NewInt(10000)))
+					return ap
+				}()},
+			},
+			expectPass:  false,
+			expectedErr: "quota period must be positive",
+		},
+		{
+			name: "swap quota zero address limit",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					ap := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)
+					ap.SwapQuota = types.NewSwapQuota(time.Hour, types.NewQuotaLimit(true, sdk.ZeroInt()), types.NewQuotaLimit(true, sdk.NewInt(1000)), types.NewQuotaLimit(true, sdk.NewInt(10000)))
+					return ap
+				}()},
+			},
+			expectPass:  false,
+			expectedErr: "address incoming limit: limit must be positive",
+		},
+		{
+			name: "swap quota address limit above outgoing limit",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					ap := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)
+					ap.SwapQuota = types.NewSwapQuota(time.Hour, types.NewQuotaLimit(true, sdk.NewInt(1000)), types.NewQuotaLimit(true, sdk.NewInt(100000)), types.NewQuotaLimit(true, sdk.NewInt(10000)))
+					return ap
+				}()},
+			},
+			expectPass:  false,
+			expectedErr: "cannot be greater than outgoing limit",
+		},
+		{
+			name: "valid time expiry",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					ap := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)
+					ap.TimeExpiry = types.NewTimeExpiry(true, time.Minute, time.Hour)
+					return ap
+				}()},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "time expiry zero min time lock",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					ap := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)
+					ap.TimeExpiry = types.NewTimeExpiry(true, 0, time.Hour)
+					return ap
+				}()},
+			},
+			expectPass:  false,
+			expectedErr: "minimum time lock must be at least one second",
+		},
+		{
+			name: "time expiry min time lock above max",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					ap := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)
+					ap.TimeExpiry = types.NewTimeExpiry(true, time.Hour, time.Minute)
+					return ap
+				}()},
+			},
+			expectPass:  false,
+			expectedErr: "minimum time lock > maximum time lock",
+		},
+		{
+			name: "time expiry max time lock above absolute max",
+			args: args{
+				assetParams: types.AssetParams{func() types.AssetParam {
+					ap := types.NewAssetParam(
+						"bnb", 714, suite.supply[0], true,
+						suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+						types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)
+					ap.TimeExpiry = types.NewTimeExpiry(true, time.Minute, types.AbsoluteMaxTimeLock+time.Second)
+					return ap
+				}()},
+			},
+			expectPass:  false,
+			expectedErr: "maximum time lock",
+		},
+		{
+			name: "valid asset with chain",
+			args: args{
+				assetParams: types.AssetParams{types.NewAssetParam(
+					"bnb", 714, suite.supply[0], true,
+					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, []string{"Binance-Chain-Tigris"})},
+				chainParams: types.ChainParams{types.NewChainParam("Binance-Chain-Tigris", "bnb", suite.addr, nil)},
+			},
+			expectPass:  true,
+			expectedErr: "",
+		},
+		{
+			name: "asset with unknown chain",
+			args: args{
+				assetParams: types.AssetParams{types.NewAssetParam(
+					"bnb", 714, suite.supply[0], true,
+					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, []string{"Binance-Chain-Ganges"})},
+				chainParams: types.ChainParams{types.NewChainParam("Binance-Chain-Tigris", "bnb", suite.addr, nil)},
+			},
+			expectPass:  false,
+			expectedErr: "not in the chain params",
+		},
+		{
+			name: "asset with duplicate chain",
+			args: args{
+				assetParams: types.AssetParams{types.NewAssetParam(
+					"bnb", 714, suite.supply[0], true,
+					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, []string{"Binance-Chain-Tigris", "Binance-Chain-Tigris"})},
+				chainParams: types.ChainParams{types.NewChainParam("Binance-Chain-Tigris", "bnb", suite.addr, nil)},
+			},
+			expectPass:  false,
+			expectedErr: "duplicate chain id",
+		},
+		{
+			name: "duplicate chain",
+			args: args{
+				assetParams: types.AssetParams{},
+				chainParams: types.ChainParams{
+					types.NewChainParam("Binance-Chain-Tigris", "bnb", suite.addr, nil),
+					types.NewChainParam("Binance-Chain-Tigris", "tbnb", suite.addr, nil),
+				},
+			},
+			expectPass:  false,
+			expectedErr: "duplicate chain id",
+		},
+		{
+			name: "chain without address prefix",
+			args: args{
+				assetParams: types.AssetParams{},
+				chainParams: types.ChainParams{types.NewChainParam("Binance-Chain-Tigris", "", suite.addr, nil)},
+			},
+			expectPass:  false,
+			expectedErr: "address prefix cannot be blank",
+		},
+		{
+			name: "chain supply limit for asset not swapped with chain",
+			args: args{
+				assetParams: types.AssetParams{types.NewAssetParam(
+					"bnb", 714, suite.supply[0], true,
+					suite.addr, sdk.NewInt(1000), sdk.NewInt(100000000), sdk.NewInt(100000000000),
+					types.DefaultMinBlockLock, types.DefaultMaxBlockLock, nil)},
+				chainParams: types.ChainParams{types.NewChainParam("Binance-Chain-Tigris", "bnb", suite.addr, sdk.NewCoins(sdk.NewInt64Coin("bnb", 1000)))},
+			},
+			expectPass:  false,
+			expectedErr: "cannot be swapped with the chain",
+		},
+		{
+			name: "chain without deputy",
+			args: args{
+				assetParams: types.AssetParams{},
+				chainParams: types.ChainParams{types.NewChainParam("Binance-Chain-Tigris", "bnb", sdk.AccAddress{}, nil)},
+			},
+			expectPass:  false,
+			expectedErr: "deputy address cannot be empty",
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
-			params := types.NewParams(tc.args.assetParams)
+			params := types.NewParams(tc.args.assetParams, tc.args.chainParams)
 			err := params.Validate()
 			if tc.expectPass {
 				suite.Require().NoError(err, tc.name)
@@ -254,6 +485,15 @@ func (suite *ParamsTestSuite) TestParamValidation() {
 	}
 }
 
+func (suite *ParamsTestSuite) TestChainParamValidateAddress() {
+	chain := types.NewChainParam("Binance-Chain-Tigris", "bnb", suite.addr, nil)
+
+	suite.Require().NoError(chain.ValidateAddress("bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7"))
+	suite.Require().Error(chain.ValidateAddress("tbnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7"))
+	suite.Require().Error(chain.ValidateAddress(suite.addr.String()))
+	suite.Require().Error(chain.ValidateAddress("not an address"))
+}
+
 func TestParamsTestSuite(t *testing.T) {
 	suite.Run(t, new(ParamsTestSuite))
 }
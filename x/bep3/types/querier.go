@@ -16,6 +16,12 @@ const (
 	QueryGetAtomicSwaps = "swaps"
 	// QueryGetParams command for getting module params
 	QueryGetParams = "parameters"
+	// QueryGetDeputyRotations command for getting a list of scheduled deputy rotations
+	QueryGetDeputyRotations = "deputy-rotations"
+	// QueryGetAddressQuota command for getting an address's remaining swap quota
+	QueryGetAddressQuota = "quota"
+	// QueryGetAtomicSwapUpdates command for getting a list of atomic swaps updated since a block height
+	QueryGetAtomicSwapUpdates = "swap-updates"
 )
 
 // QueryAssetSupply contains the params for query 'custom/bep3/supply'
@@ -44,6 +50,20 @@ func NewQueryAssetSupplies(page int, limit int) QueryAssetSupplies {
 	}
 }
 
+// QueryAddressQuota contains the params for query 'custom/bep3/quota'
+type QueryAddressQuota struct {
+	Denom   string         `json:"denom" yaml:"denom"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+}
+
+// NewQueryAddressQuota creates a new QueryAddressQuota
+func NewQueryAddressQuota(denom string, address sdk.AccAddress) QueryAddressQuota {
+	return QueryAddressQuota{
+		Denom:   denom,
+		Address: address,
+	}
+}
+
 // QueryAtomicSwapByID contains the params for query 'custom/bep3/swap'
 type QueryAtomicSwapByID struct {
 	SwapID tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
@@ -78,3 +98,75 @@ func NewQueryAtomicSwaps(page, limit int, involve sdk.AccAddress, expiration uin
 		Direction:  direction,
 	}
 }
+
+// QueryAtomicSwapUpdates contains the params for query 'custom/bep3/swap-updates'
+type QueryAtomicSwapUpdates struct {
+	Page   int   `json:"page" yaml:"page"`
+	Limit  int   `json:"limit" yaml:"limit"`
+	Height int64 `json:"height" yaml:"height"`
+}
+
+// NewQueryAtomicSwapUpdates creates a new instance of QueryAtomicSwapUpdates
+func NewQueryAtomicSwapUpdates(page, limit int, height int64) QueryAtomicSwapUpdates {
+	return QueryAtomicSwapUpdates{
+		Page:   page,
+		Limit:  limit,
+		Height: height,
+	}
+}
+
+// AtomicSwapUpdate is the result of a swap updates query, containing a swap and the block height it was last updated at.
+// Swaps that have been deleted from the store are reported with their swap ID and Deleted set to true.
+type AtomicSwapUpdate struct {
+	Height     int64               `json:"height" yaml:"height"`
+	SwapID     tmbytes.HexBytes    `json:"swap_id" yaml:"swap_id"`
+	Deleted    bool                `json:"deleted" yaml:"deleted"`
+	AtomicSwap AugmentedAtomicSwap `json:"atomic_swap" yaml:"atomic_swap"`
+}
+
+// NewAtomicSwapUpdate creates a new AtomicSwapUpdate
+func NewAtomicSwapUpdate(atomicSwap AtomicSwap, height int64) AtomicSwapUpdate {
+	return AtomicSwapUpdate{
+		Height:     height,
+		SwapID:     atomicSwap.GetSwapID(),
+		Deleted:    false,
+		AtomicSwap: NewAugmentedAtomicSwap(atomicSwap),
+	}
+}
+
+// NewDeletedAtomicSwapUpdate creates a new AtomicSwapUpdate for a swap that was deleted at the input height
+func NewDeletedAtomicSwapUpdate(swapID []byte, height int64) AtomicSwapUpdate {
+	return AtomicSwapUpdate{
+		Height:  height,
+		SwapID:  swapID,
+		Deleted: true,
+	}
+}
+
+// AtomicSwapUpdates is a slice of AtomicSwapUpdate
+type AtomicSwapUpdates []AtomicSwapUpdate
+
+// PendingDeputyRotation is the result of a deputy rotations query, describing an asset's scheduled deputy rotation
+type PendingDeputyRotation struct {
+	Denom            string         `json:"denom" yaml:"denom"`
+	CurrentDeputy    sdk.AccAddress `json:"current_deputy" yaml:"current_deputy"`
+	NewDeputy        sdk.AccAddress `json:"new_deputy" yaml:"new_deputy"`
+	ActivationHeight int64          `json:"activation_height" yaml:"activation_height"`
+	OverlapEndHeight int64          `json:"overlap_end_height" yaml:"overlap_end_height"`
+	Active           bool           `json:"active" yaml:"active"`
+}
+
+// NewPendingDeputyRotation creates a new PendingDeputyRotation from an asset param at the input height
+func NewPendingDeputyRotation(asset AssetParam, height int64) PendingDeputyRotation {
+	return PendingDeputyRotation{
+		Denom:            asset.Denom,
+		CurrentDeputy:    asset.DeputyAddress,
+		NewDeputy:        asset.DeputyRotation.NewDeputyAddress,
+		ActivationHeight: asset.DeputyRotation.ActivationHeight,
+		OverlapEndHeight: asset.DeputyRotation.OverlapEndHeight(),
+		Active:           height >= asset.DeputyRotation.ActivationHeight,
+	}
+}
+
+// PendingDeputyRotations is a slice of PendingDeputyRotation
+type PendingDeputyRotations []PendingDeputyRotation
@@ -0,0 +1,375 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxQuotaRemaining is the amount remaining under a limit that is not enforced, the largest value an sdk.Int can hold
+var MaxQuotaRemaining = sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1)))
+
+// SwapQuota parameters that rate limit an asset's swaps within a rolling time window
+type SwapQuota struct {
+	QuotaPeriod          time.Duration `json:"quota_period" yaml:"quota_period"`                     // the duration of the rolling window swaps are counted over
+	AddressIncomingLimit QuotaLimit    `json:"address_incoming_limit" yaml:"address_incoming_limit"` // the amount a single address can receive through incoming swaps within the window
+	AddressOutgoingLimit QuotaLimit    `json:"address_outgoing_limit" yaml:"address_outgoing_limit"` // the amount a single address can send through outgoing swaps within the window
+	OutgoingLimit        QuotaLimit    `json:"outgoing_limit" yaml:"outgoing_limit"`                 // the amount that can be sent through outgoing swaps by all addresses within the window
+}
+
+// NewSwapQuota returns a new SwapQuota
+func NewSwapQuota(quotaPeriod time.Duration, addressIncomingLimit, addressOutgoingLimit, outgoingLimit QuotaLimit) SwapQuota {
+	return SwapQuota{
+		QuotaPeriod:          quotaPeriod,
+		AddressIncomingLimit: addressIncomingLimit,
+		AddressOutgoingLimit: addressOutgoingLimit,
+		OutgoingLimit:        outgoingLimit,
+	}
+}
+
+// IsEnabled returns true if any of the quota's limits are enforced
+func (sq SwapQuota) IsEnabled() bool {
+	return sq.AddressIncomingLimit.Enabled || sq.AddressOutgoingLimit.Enabled || sq.OutgoingLimit.Enabled
+}
+
+// Validate performs a basic validation of the swap quota fields
+func (sq SwapQuota) Validate() error {
+	if !sq.IsEnabled() {
+		return nil
+	}
+	if sq.QuotaPeriod <= 0 {
+		return fmt.Errorf("quota period must be positive, got %s", sq.QuotaPeriod)
+	}
+	if err := sq.AddressIncomingLimit.Validate(); err != nil {
+		return fmt.Errorf("address incoming limit: %w", err)
+	}
+	if err := sq.AddressOutgoingLimit.Validate(); err != nil {
+		return fmt.Errorf("address outgoing limit: %w", err)
+	}
+	if err := sq.OutgoingLimit.Validate(); err != nil {
+		return fmt.Errorf("outgoing limit: %w", err)
+	}
+	if sq.AddressOutgoingLimit.Enabled && sq.OutgoingLimit.Enabled && sq.AddressOutgoingLimit.Limit.GT(sq.OutgoingLimit.Limit) {
+		return fmt.Errorf("address outgoing limit cannot be greater than outgoing limit: %s > %s", sq.AddressOutgoingLimit.Limit, sq.OutgoingLimit.Limit)
+	}
+	return nil
+}
+
+// Equal returns true if two swap quotas are equal
+func (sq SwapQuota) Equal(other SwapQuota) bool {
+	return sq.QuotaPeriod == other.QuotaPeriod &&
+		sq.AddressIncomingLimit.Equal(other.AddressIncomingLimit) &&
+		sq.AddressOutgoingLimit.Equal(other.AddressOutgoingLimit) &&
+		sq.OutgoingLimit.Equal(other.OutgoingLimit)
+}
+
+// String implements fmt.Stringer
+func (sq SwapQuota) String() string {
+	return fmt.Sprintf(`Swap Quota:
+	Quota Period: %s
+	Address Incoming Limit: %s
+	Address Outgoing Limit: %s
+	Outgoing Limit: %s`,
+		sq.QuotaPeriod, sq.AddressIncomingLimit, sq.AddressOutgoingLimit, sq.OutgoingLimit)
+}
+
+// QuotaLimit is a single limit of a swap quota, which is only enforced when enabled
+type QuotaLimit struct {
+	Enabled bool    `json:"enabled" yaml:"enabled"` // denotes if the limit is enforced
+	Limit   sdk.Int `json:"limit" yaml:"limit"`     // the amount that can be swapped within the quota window
+}
+
+// NewQuotaLimit returns a new QuotaLimit
+func NewQuotaLimit(enabled bool, limit sdk.Int) QuotaLimit {
+	return QuotaLimit{
+		Enabled: enabled,
+		Limit:   limit,
+	}
+}
+
+// Validate performs a basic validation of the quota limit fields
+func (ql QuotaLimit) Validate() error {
+	if !ql.Enabled {
+		return nil
+	}
+	if ql.Limit.IsNil() || !ql.Limit.IsPositive() {
+		return fmt.Errorf("limit must be positive, got %s", ql.Limit)
+	}
+	return nil
+}
+
+// Exceeded returns true if the limit is enforced and the used amount is over it
+func (ql QuotaLimit) Exceeded(used sdk.Int) bool {
+	return ql.Enabled && used.GT(ql.Limit)
+}
+
+// Remaining returns the amount left under the limit, which is never negative.
+// Limits that are not enforced have no limit, so MaxQuotaRemaining is returned.
+func (ql QuotaLimit) Remaining(used sdk.Int) sdk.Int {
+	if !ql.Enabled {
+		return MaxQuotaRemaining
+	}
+	if used.GT(ql.Limit) {
+		return sdk.ZeroInt()
+	}
+	return ql.Limit.Sub(used)
+}
+
+// Equal returns true if two quota limits are equal
+func (ql QuotaLimit) Equal(other QuotaLimit) bool {
+	return ql.Enabled == other.Enabled && intsEqual(ql.Limit, other.Limit)
+}
+
+// String implements fmt.Stringer
+func (ql QuotaLimit) String() string {
+	if !ql.Enabled {
+		return "disabled"
+	}
+	return ql.Limit.String()
+}
+
+// intsEqual compares two sdk.Ints, treating unset values as equal to each other
+func intsEqual(a, b sdk.Int) bool {
+	if a.IsNil() || b.IsNil() {
+		return a.IsNil() == b.IsNil()
+	}
+	return a.Equal(b)
+}
+
+// QuotaRecord is the amount of a single swap counted against a quota
+type QuotaRecord struct {
+	SwapID tmbytes.HexBytes `json:"swap_id" yaml:"swap_id"`
+	Time   time.Time        `json:"time" yaml:"time"`
+	Amount sdk.Int          `json:"amount" yaml:"amount"`
+}
+
+// NewQuotaRecord returns a new QuotaRecord
+func NewQuotaRecord(swapID []byte, t time.Time, amount sdk.Int) QuotaRecord {
+	return QuotaRecord{
+		SwapID: swapID,
+		Time:   t,
+		Amount: amount,
+	}
+}
+
+// Validate performs a basic validation of the quota record fields
+func (qr QuotaRecord) Validate() error {
+	if len(qr.SwapID) != SwapIDLength {
+		return fmt.Errorf("invalid swap id length %d", len(qr.SwapID))
+	}
+	if qr.Time.IsZero() {
+		return errors.New("quota record time cannot be zero")
+	}
+	if qr.Amount.IsNil() || !qr.Amount.IsPositive() {
+		return fmt.Errorf("quota record amount must be positive, got %s", qr.Amount)
+	}
+	return nil
+}
+
+// QuotaRecords is a slice of QuotaRecord, ordered by time
+type QuotaRecords []QuotaRecord
+
+// Total returns the sum of the records' amounts
+func (qrs QuotaRecords) Total() sdk.Int {
+	total := sdk.ZeroInt()
+	for _, qr := range qrs {
+		total = total.Add(qr.Amount)
+	}
+	return total
+}
+
+// After returns the records that were counted after the input time
+func (qrs QuotaRecords) After(t time.Time) QuotaRecords {
+	var records QuotaRecords
+	for _, qr := range qrs {
+		if qr.Time.After(t) {
+			records = append(records, qr)
+		}
+	}
+	return records
+}
+
+// Remove returns the records without the record for the input swap
+func (qrs QuotaRecords) Remove(swapID []byte) QuotaRecords {
+	var records QuotaRecords
+	for _, qr := range qrs {
+		if !bytes.Equal(qr.SwapID, swapID) {
+			records = append(records, qr)
+		}
+	}
+	return records
+}
+
+// QuotaUsage contains the swaps counted against a quota within its rolling window
+type QuotaUsage struct {
+	Incoming QuotaRecords `json:"incoming" yaml:"incoming"`
+	Outgoing QuotaRecords `json:"outgoing" yaml:"outgoing"`
+}
+
+// NewQuotaUsage returns a new QuotaUsage
+func NewQuotaUsage(incoming, outgoing QuotaRecords) QuotaUsage {
+	return QuotaUsage{
+		Incoming: incoming,
+		Outgoing: outgoing,
+	}
+}
+
+// Validate performs a basic validation of the quota usage fields
+func (qu QuotaUsage) Validate() error {
+	for _, qr := range append(append(QuotaRecords{}, qu.Incoming...), qu.Outgoing...) {
+		if err := qr.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (qu QuotaUsage) String() string {
+	return fmt.Sprintf(`Quota Usage:
+	Incoming: %s
+	Outgoing: %s`,
+		qu.Incoming.Total(), qu.Outgoing.Total())
+}
+
+// Current returns the usage within the rolling window ending at the input time, dropping swaps that are older than the quota period
+func (qu QuotaUsage) Current(blockTime time.Time, quotaPeriod time.Duration) QuotaUsage {
+	windowStart := blockTime.Add(-quotaPeriod)
+	return NewQuotaUsage(qu.Incoming.After(windowStart), qu.Outgoing.After(windowStart))
+}
+
+// Remove returns the usage without the input swap
+func (qu QuotaUsage) Remove(swapID []byte) QuotaUsage {
+	return NewQuotaUsage(qu.Incoming.Remove(swapID), qu.Outgoing.Remove(swapID))
+}
+
+// IsEmpty returns true if no swaps are counted in the usage
+func (qu QuotaUsage) IsEmpty() bool {
+	return len(qu.Incoming) == 0 && len(qu.Outgoing) == 0
+}
+
+// NextRelease returns the time at which the oldest swap in the usage stops counting against the quota
+func (qu QuotaUsage) NextRelease(quotaPeriod time.Duration) time.Time {
+	var next time.Time
+	for _, qr := range append(append(QuotaRecords{}, qu.Incoming...), qu.Outgoing...) {
+		if next.IsZero() || qr.Time.Before(next) {
+			next = qr.Time
+		}
+	}
+	if next.IsZero() {
+		return next
+	}
+	return next.Add(quotaPeriod)
+}
+
+// AddressQuotaUsage is the quota usage of an address for swaps of a denom
+type AddressQuotaUsage struct {
+	Denom   string         `json:"denom" yaml:"denom"`
+	Address sdk.AccAddress `json:"address" yaml:"address"`
+	Usage   QuotaUsage     `json:"usage" yaml:"usage"`
+}
+
+// NewAddressQuotaUsage returns a new AddressQuotaUsage
+func NewAddressQuotaUsage(denom string, address sdk.AccAddress, usage QuotaUsage) AddressQuotaUsage {
+	return AddressQuotaUsage{
+		Denom:   denom,
+		Address: address,
+		Usage:   usage,
+	}
+}
+
+// Validate performs a basic validation of the address quota usage fields
+func (aqu AddressQuotaUsage) Validate() error {
+	if err := sdk.ValidateDenom(aqu.Denom); err != nil {
+		return err
+	}
+	if aqu.Address.Empty() {
+		return errors.New("quota usage address cannot be empty")
+	}
+	return aqu.Usage.Validate()
+}
+
+// AddressQuotaUsages is a slice of AddressQuotaUsage
+type AddressQuotaUsages []AddressQuotaUsage
+
+// OutgoingQuotaUsage is the outgoing quota usage of all addresses for swaps of a denom
+type OutgoingQuotaUsage struct {
+	Denom string     `json:"denom" yaml:"denom"`
+	Usage QuotaUsage `json:"usage" yaml:"usage"`
+}
+
+// NewOutgoingQuotaUsage returns a new OutgoingQuotaUsage
+func NewOutgoingQuotaUsage(denom string, usage QuotaUsage) OutgoingQuotaUsage {
+	return OutgoingQuotaUsage{
+		Denom: denom,
+		Usage: usage,
+	}
+}
+
+// Validate performs a basic validation of the outgoing quota usage fields
+func (oqu OutgoingQuotaUsage) Validate() error {
+	if err := sdk.ValidateDenom(oqu.Denom); err != nil {
+		return err
+	}
+	if len(oqu.Usage.Incoming) > 0 {
+		return fmt.Errorf("outgoing quota usage for %s cannot contain incoming swaps", oqu.Denom)
+	}
+	return oqu.Usage.Validate()
+}
+
+// OutgoingQuotaUsages is a slice of OutgoingQuotaUsage
+type OutgoingQuotaUsages []OutgoingQuotaUsage
+
+// AddressQuota is the result of an address quota query, containing the amounts an address can still swap in the current window.
+// The remaining amounts are only set for limits that are enabled.
+type AddressQuota struct {
+	Denom                  string         `json:"denom" yaml:"denom"`
+	Address                sdk.AccAddress `json:"address" yaml:"address"`
+	Enabled                bool           `json:"enabled" yaml:"enabled"`
+	IncomingRemaining      sdk.Int        `json:"incoming_remaining" yaml:"incoming_remaining"`
+	OutgoingRemaining      sdk.Int        `json:"outgoing_remaining" yaml:"outgoing_remaining"`
+	AssetOutgoingRemaining sdk.Int        `json:"asset_outgoing_remaining" yaml:"asset_outgoing_remaining"`
+	NextRelease            time.Time      `json:"next_release" yaml:"next_release"`
+}
+
+// NewAddressQuota returns a new AddressQuota
+func NewAddressQuota(denom string, address sdk.AccAddress, enabled bool, incomingRemaining, outgoingRemaining,
+	assetOutgoingRemaining sdk.Int, nextRelease time.Time) AddressQuota {
+	return AddressQuota{
+		Denom:                  denom,
+		Address:                address,
+		Enabled:                enabled,
+		IncomingRemaining:      incomingRemaining,
+		OutgoingRemaining:      outgoingRemaining,
+		AssetOutgoingRemaining: assetOutgoingRemaining,
+		NextRelease:            nextRelease,
+	}
+}
+
+// String implements fmt.Stringer
+func (aq AddressQuota) String() string {
+	return fmt.Sprintf(`Address Quota:
+	Denom: %s
+	Address: %s
+	Enabled: %t
+	Incoming Remaining: %s
+	Outgoing Remaining: %s
+	Asset Outgoing Remaining: %s
+	Next Release: %s`,
+		aq.Denom, aq.Address, aq.Enabled, formatRemaining(aq.IncomingRemaining), formatRemaining(aq.OutgoingRemaining),
+		formatRemaining(aq.AssetOutgoingRemaining), aq.NextRelease)
+}
+
+// formatRemaining formats a remaining quota amount, showing amounts under limits that are not enforced as unlimited
+func formatRemaining(remaining sdk.Int) string {
+	if !remaining.IsNil() && remaining.Equal(MaxQuotaRemaining) {
+		return "unlimited"
+	}
+	return remaining.String()
+}
@@ -0,0 +1,110 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UnsettledSwapAmounts returns the total amounts locked in incoming and outgoing swaps that have not been claimed or refunded.
+// Open and expired swaps count towards an asset's incoming or outgoing supply, completed swaps do not.
+func UnsettledSwapAmounts(swaps AtomicSwaps) (incoming sdk.Coins, outgoing sdk.Coins) {
+	for _, swap := range swaps {
+		if swap.Status != Open && swap.Status != Expired {
+			continue
+		}
+		switch swap.Direction {
+		case Incoming:
+			incoming = incoming.Add(swap.Amount...)
+		case Outgoing:
+			outgoing = outgoing.Add(swap.Amount...)
+		}
+	}
+	return incoming, outgoing
+}
+
+// ReconcileModuleAccount returns a description of each denom where the bep3 module account's balance
+// differs from the amount locked in unsettled outgoing swaps
+func ReconcileModuleAccount(moduleAccCoins sdk.Coins, swaps AtomicSwaps) []string {
+	_, outgoing := UnsettledSwapAmounts(swaps)
+
+	var discrepancies []string
+	for _, denom := range coinDenoms(moduleAccCoins, outgoing) {
+		balance := moduleAccCoins.AmountOf(denom)
+		locked := outgoing.AmountOf(denom)
+		if !balance.Equal(locked) {
+			discrepancies = append(discrepancies, fmt.Sprintf(
+				"module account balance %s%s does not match amount %s%s in outgoing swaps",
+				balance, denom, locked, denom))
+		}
+	}
+	return discrepancies
+}
+
+// ReconcileAssetSupplies returns a description of each asset whose incoming or outgoing supply
+// differs from the amount locked in its unsettled incoming or outgoing swaps
+func ReconcileAssetSupplies(supplies AssetSupplies, swaps AtomicSwaps) []string {
+	incoming, outgoing := UnsettledSwapAmounts(swaps)
+
+	var discrepancies []string
+	tracked := make(map[string]bool)
+	for _, supply := range supplies {
+		denom := supply.GetDenom()
+		tracked[denom] = true
+		if !supply.IncomingSupply.Amount.Equal(incoming.AmountOf(denom)) {
+			discrepancies = append(discrepancies, fmt.Sprintf(
+				"asset's incoming supply %s does not match amount %s%s in incoming swaps",
+				supply.IncomingSupply, incoming.AmountOf(denom), denom))
+		}
+		if !supply.OutgoingSupply.Amount.Equal(outgoing.AmountOf(denom)) {
+			discrepancies = append(discrepancies, fmt.Sprintf(
+				"asset's outgoing supply %s does not match amount %s%s in outgoing swaps",
+				supply.OutgoingSupply, outgoing.AmountOf(denom), denom))
+		}
+	}
+	for _, denom := range coinDenoms(incoming, outgoing) {
+		if !tracked[denom] {
+			discrepancies = append(discrepancies, fmt.Sprintf("no asset supply found for %s locked in swaps", denom))
+		}
+	}
+	return discrepancies
+}
+
+// ReconcileSupplyLimits returns a description of each asset whose current supply is over its supply limit
+func ReconcileSupplyLimits(supplies AssetSupplies, assetParams AssetParams) []string {
+	limits := make(map[string]sdk.Int)
+	for _, asset := range assetParams {
+		limits[asset.Denom] = asset.SupplyLimit.Limit
+	}
+
+	var discrepancies []string
+	for _, supply := range supplies {
+		limit, found := limits[supply.GetDenom()]
+		if !found {
+			discrepancies = append(discrepancies, fmt.Sprintf("no asset param found for supply %s", supply.CurrentSupply))
+			continue
+		}
+		if supply.CurrentSupply.Amount.GT(limit) {
+			discrepancies = append(discrepancies, fmt.Sprintf(
+				"asset's current supply %s is over the supply limit %s", supply.CurrentSupply, limit))
+		}
+	}
+	return discrepancies
+}
+
+// coinDenoms returns the sorted, unique denoms of the input coins
+func coinDenoms(coinSets ...sdk.Coins) []string {
+	seen := make(map[string]bool)
+	var denoms []string
+	for _, coins := range coinSets {
+		for _, coin := range coins {
+			if !seen[coin.Denom] {
+				seen[coin.Denom] = true
+				denoms = append(denoms, coin.Denom)
+			}
+		}
+	}
+	sort.Strings(denoms)
+	return denoms
+}
@@ -0,0 +1,127 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/bep3/types"
+)
+
+func swapWith(index int, amount int64, direction types.SwapDirection, status types.SwapStatus) types.AtomicSwap {
+	swap := atomicSwap(index)
+	swap.Amount = cs(c("bnb", amount))
+	swap.Direction = direction
+	swap.Status = status
+	return swap
+}
+
+func TestUnsettledSwapAmounts(t *testing.T) {
+	swaps := types.AtomicSwaps{
+		swapWith(0, 100, types.Incoming, types.Open),
+		swapWith(1, 200, types.Incoming, types.Expired),
+		swapWith(2, 400, types.Incoming, types.Completed),
+		swapWith(3, 1000, types.Outgoing, types.Open),
+		swapWith(4, 2000, types.Outgoing, types.Completed),
+	}
+
+	incoming, outgoing := types.UnsettledSwapAmounts(swaps)
+	require.Equal(t, cs(c("bnb", 300)), incoming)
+	require.Equal(t, cs(c("bnb", 1000)), outgoing)
+}
+
+func TestReconcileModuleAccount(t *testing.T) {
+	swaps := types.AtomicSwaps{
+		swapWith(0, 100, types.Incoming, types.Open),
+		swapWith(1, 1000, types.Outgoing, types.Open),
+		swapWith(2, 500, types.Outgoing, types.Expired),
+	}
+
+	testCases := []struct {
+		name          string
+		moduleCoins   sdk.Coins
+		discrepancies int
+	}{
+		{"balance matches outgoing swaps", cs(c("bnb", 1500)), 0},
+		{"balance below outgoing swaps", cs(c("bnb", 1000)), 1},
+		{"unexpected denom in balance", cs(c("bnb", 1500), c("ukava", 1)), 1},
+		{"empty balance", sdk.Coins{}, 1},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Len(t, types.ReconcileModuleAccount(tc.moduleCoins, swaps), tc.discrepancies)
+		})
+	}
+}
+
+func TestReconcileAssetSupplies(t *testing.T) {
+	swaps := types.AtomicSwaps{
+		swapWith(0, 100, types.Incoming, types.Open),
+		swapWith(1, 1000, types.Outgoing, types.Expired),
+		swapWith(2, 5000, types.Outgoing, types.Completed),
+	}
+
+	testCases := []struct {
+		name          string
+		supplies      types.AssetSupplies
+		discrepancies int
+	}{
+		{
+			"supplies match swaps",
+			types.AssetSupplies{types.NewAssetSupply(c("bnb", 100), c("bnb", 1000), c("bnb", 10000), c("bnb", 0), time.Duration(0))},
+			0,
+		},
+		{
+			"incoming and outgoing supply mismatch",
+			types.AssetSupplies{types.NewAssetSupply(c("bnb", 0), c("bnb", 6000), c("bnb", 10000), c("bnb", 0), time.Duration(0))},
+			2,
+		},
+		{
+			"missing asset supply",
+			types.AssetSupplies{},
+			1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Len(t, types.ReconcileAssetSupplies(tc.supplies, swaps), tc.discrepancies)
+		})
+	}
+}
+
+func TestReconcileSupplyLimits(t *testing.T) {
+	assetParams := types.AssetParams{{
+		Denom:       "bnb",
+		SupplyLimit: types.SupplyLimit{Limit: i(10000)},
+	}}
+
+	testCases := []struct {
+		name          string
+		supplies      types.AssetSupplies
+		discrepancies int
+	}{
+		{
+			"current supply at limit",
+			types.AssetSupplies{types.NewAssetSupply(c("bnb", 0), c("bnb", 0), c("bnb", 10000), c("bnb", 0), time.Duration(0))},
+			0,
+		},
+		{
+			"current supply over limit",
+			types.AssetSupplies{types.NewAssetSupply(c("bnb", 0), c("bnb", 0), c("bnb", 10001), c("bnb", 0), time.Duration(0))},
+			1,
+		},
+		{
+			"supply without asset param",
+			types.AssetSupplies{types.NewAssetSupply(c("inc", 0), c("inc", 0), c("inc", 1), c("inc", 0), time.Duration(0))},
+			1,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Len(t, types.ReconcileSupplyLimits(tc.supplies, assetParams), tc.discrepancies)
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -83,3 +85,65 @@ func (a AssetSupply) GetDenom() string {
 
 // AssetSupplies is a slice of AssetSupply
 type AssetSupplies []AssetSupply
+
+// ChainSupply contains information about the supply of an asset that has been swapped with a counterparty chain
+type ChainSupply struct {
+	ChainID        string   `json:"chain_id" yaml:"chain_id"`
+	IncomingSupply sdk.Coin `json:"incoming_supply" yaml:"incoming_supply"`
+	OutgoingSupply sdk.Coin `json:"outgoing_supply" yaml:"outgoing_supply"`
+	CurrentSupply  sdk.Coin `json:"current_supply" yaml:"current_supply"`
+}
+
+// NewChainSupply initializes a new ChainSupply
+func NewChainSupply(chainID string, incomingSupply, outgoingSupply, currentSupply sdk.Coin) ChainSupply {
+	return ChainSupply{
+		ChainID:        chainID,
+		IncomingSupply: incomingSupply,
+		OutgoingSupply: outgoingSupply,
+		CurrentSupply:  currentSupply,
+	}
+}
+
+// Validate performs a basic validation of a chain supply fields.
+func (c ChainSupply) Validate() error {
+	if strings.TrimSpace(c.ChainID) == "" {
+		return errors.New("chain supply chain id cannot be blank")
+	}
+	if !c.IncomingSupply.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "incoming supply %s", c.IncomingSupply)
+	}
+	if !c.OutgoingSupply.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "outgoing supply %s", c.OutgoingSupply)
+	}
+	if !c.CurrentSupply.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "current supply %s", c.CurrentSupply)
+	}
+	denom := c.CurrentSupply.Denom
+	if (c.IncomingSupply.Denom != denom) || (c.OutgoingSupply.Denom != denom) {
+		return fmt.Errorf("chain supply denoms do not match %s %s %s", c.CurrentSupply.Denom, c.IncomingSupply.Denom, c.OutgoingSupply.Denom)
+	}
+	if c.CurrentSupply.IsLT(c.OutgoingSupply) {
+		return fmt.Errorf("chain %s outgoing supply %s exceeds current supply %s", c.ChainID, c.OutgoingSupply, c.CurrentSupply)
+	}
+	return nil
+}
+
+// String implements stringer
+func (c ChainSupply) String() string {
+	return fmt.Sprintf(`
+	chain supply:
+		Chain ID:           %s
+		Incoming supply:    %s
+		Outgoing supply:    %s
+		Current supply:     %s
+		`,
+		c.ChainID, c.IncomingSupply, c.OutgoingSupply, c.CurrentSupply)
+}
+
+// GetDenom getter method for the denom of the chain supply
+func (c ChainSupply) GetDenom() string {
+	return c.CurrentSupply.Denom
+}
+
+// ChainSupplies is a slice of ChainSupply
+type ChainSupplies []ChainSupply
@@ -27,12 +27,14 @@ type AtomicSwap struct {
 	Status              SwapStatus       `json:"status"  yaml:"status"`
 	CrossChain          bool             `json:"cross_chain"  yaml:"cross_chain"`
 	Direction           SwapDirection    `json:"direction"  yaml:"direction"`
+	ChainID             string           `json:"chain_id"  yaml:"chain_id"`
+	ExpireTime          int64            `json:"expire_time"  yaml:"expire_time"`
 }
 
 // NewAtomicSwap returns a new AtomicSwap
 func NewAtomicSwap(amount sdk.Coins, randomNumberHash tmbytes.HexBytes, expireHeight uint64, timestamp int64,
 	sender, recipient sdk.AccAddress, senderOtherChain string, recipientOtherChain string, closedBlock int64,
-	status SwapStatus, crossChain bool, direction SwapDirection) AtomicSwap {
+	status SwapStatus, crossChain bool, direction SwapDirection, chainID string) AtomicSwap {
 	return AtomicSwap{
 		Amount:              amount,
 		RandomNumberHash:    randomNumberHash,
@@ -46,6 +48,7 @@ func NewAtomicSwap(amount sdk.Coins, randomNumberHash tmbytes.HexBytes, expireHe
 		Status:              status,
 		CrossChain:          crossChain,
 		Direction:           direction,
+		ChainID:             chainID,
 	}
 }
 
@@ -70,8 +73,14 @@ func (a AtomicSwap) Validate() error {
 	if len(a.RandomNumberHash) != RandomNumberHashLength {
 		return fmt.Errorf("the length of random number hash should be %d", RandomNumberHashLength)
 	}
-	if a.ExpireHeight == 0 {
-		return errors.New("expire height cannot be 0")
+	if a.ExpireHeight == 0 && a.ExpireTime == 0 {
+		return errors.New("expire height and expire time cannot both be 0")
+	}
+	if a.ExpireTime < 0 {
+		return errors.New("expire time cannot be negative")
+	}
+	if a.ExpireHeight != 0 && a.ExpireTime != 0 {
+		return errors.New("expire height and expire time cannot both be set")
 	}
 	if a.Timestamp == 0 {
 		return errors.New("timestamp cannot be 0")
@@ -122,12 +131,14 @@ func (a AtomicSwap) String() string {
 		"\n    Recipient other chain:    %s"+
 		"\n    Closed block:             %d"+
 		"\n    Cross chain:              %t"+
-		"\n    Direction:                %s",
+		"\n    Direction:                %s"+
+		"\n    Chain ID:                 %s"+
+		"\n    Expire time:              %d",
 		a.GetSwapID(), a.Status.String(), a.Amount.String(),
 		hex.EncodeToString(a.RandomNumberHash), a.ExpireHeight,
 		a.Timestamp, a.Sender.String(), a.Recipient.String(),
 		a.SenderOtherChain, a.RecipientOtherChain, a.ClosedBlock,
-		a.CrossChain, a.Direction)
+		a.CrossChain, a.Direction, a.ChainID, a.ExpireTime)
 }
 
 // AtomicSwaps is a slice of AtomicSwap
@@ -282,6 +293,8 @@ type AugmentedAtomicSwap struct {
 	Status              SwapStatus       `json:"status"  yaml:"status"`
 	CrossChain          bool             `json:"cross_chain"  yaml:"cross_chain"`
 	Direction           SwapDirection    `json:"direction"  yaml:"direction"`
+	ChainID             string           `json:"chain_id,omitempty"  yaml:"chain_id"`
+	ExpireTime          int64            `json:"expire_time,omitempty"  yaml:"expire_time"`
 }
 
 func NewAugmentedAtomicSwap(swap AtomicSwap) AugmentedAtomicSwap {
@@ -299,6 +312,8 @@ func NewAugmentedAtomicSwap(swap AtomicSwap) AugmentedAtomicSwap {
 		Status:              swap.Status,
 		CrossChain:          swap.CrossChain,
 		Direction:           swap.Direction,
+		ChainID:             swap.ChainID,
+		ExpireTime:          swap.ExpireTime,
 	}
 }
 
@@ -98,6 +98,25 @@ func (suite *AtomicSwapTestSuite) TestNewAtomicSwap() {
 			},
 			false,
 		},
+		{
+			"exp height and exp time both set",
+			types.AtomicSwap{
+				Amount:              cs(c("bnb", 50000)),
+				RandomNumberHash:    suite.randomNumberHashes[0],
+				ExpireHeight:        360,
+				ExpireTime:          suite.timestamps[0] + 3600,
+				Timestamp:           suite.timestamps[0],
+				Sender:              suite.addrs[0],
+				Recipient:           suite.addrs[5],
+				RecipientOtherChain: "bnb1urfermcg92dwq36572cx4xg84wpk3lfpksr5g7",
+				SenderOtherChain:    "bnb1uky3me9ggqypmrsvxk7ur6hqkzq7zmv4ed4ng7",
+				ClosedBlock:         1,
+				Status:              types.Open,
+				CrossChain:          true,
+				Direction:           types.Incoming,
+			},
+			false,
+		},
 		{
 			"timestamp 0",
 			types.AtomicSwap{
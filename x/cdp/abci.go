@@ -15,13 +15,8 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 	params := k.GetParams(ctx)
 
 	for _, cp := range params.CollateralParams {
-		ok := k.UpdatePricefeedStatus(ctx, cp.SpotMarketID)
-		if !ok {
-			continue
-		}
-
-		ok = k.UpdatePricefeedStatus(ctx, cp.LiquidationMarketID)
-		if !ok {
+		// market statuses are kept up to date by the pricefeed hooks
+		if !k.GetMarketStatus(ctx, cp.SpotMarketID) || !k.GetMarketStatus(ctx, cp.LiquidationMarketID) {
 			continue
 		}
 
@@ -36,7 +31,7 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 		}
 
 		err = k.LiquidateCdps(ctx, cp.LiquidationMarketID, cp.Type, cp.LiquidationRatio, cp.CheckCollateralizationIndexCount)
-		if err != nil && !errors.Is(err, pricefeedtypes.ErrNoValidPrice) {
+		if err != nil && !errors.Is(err, pricefeedtypes.ErrNoValidPrice) && !errors.Is(err, pricefeedtypes.ErrMarketHalted) {
 			panic(err)
 		}
 	}
@@ -117,7 +117,7 @@ func (suite *ModuleTestSuite) createCdps() {
 func (suite *ModuleTestSuite) setPrice(price sdk.Dec, market string) {
 	pfKeeper := suite.app.GetPriceFeedKeeper()
 
-	pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, market, price, suite.ctx.BlockTime().Add(time.Hour*3))
+	pfKeeper.SetPrice(suite.ctx, oracle, market, price, suite.ctx.BlockTime().Add(time.Hour*3))
 	err := pfKeeper.SetCurrentPrices(suite.ctx, market)
 	suite.NoError(err)
 	pp, err := pfKeeper.GetCurrentPrice(suite.ctx, market)
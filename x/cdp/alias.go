@@ -106,6 +106,7 @@ var (
 	DefaultDebtThreshold       = types.DefaultDebtThreshold
 	DefaultGlobalDebt          = types.DefaultGlobalDebt
 	DefaultGovDenom            = types.DefaultGovDenom
+	DefaultSealedBidSurplus    = types.DefaultSealedBidSurplus
 	DefaultStableDenom         = types.DefaultStableDenom
 	DefaultSurplusLot          = types.DefaultSurplusLot
 	DefaultSurplusThreshold    = types.DefaultSurplusThreshold
@@ -140,6 +141,7 @@ var (
 	KeyDebtParam               = types.KeyDebtParam
 	KeyDebtThreshold           = types.KeyDebtThreshold
 	KeyGlobalDebtLimit         = types.KeyGlobalDebtLimit
+	KeySealedBidSurplus        = types.KeySealedBidSurplus
 	KeySurplusLot              = types.KeySurplusLot
 	KeySurplusThreshold        = types.KeySurplusThreshold
 	MaxSortableDec             = types.MaxSortableDec
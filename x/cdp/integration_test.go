@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/tendermint/tendermint/crypto"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/kava-labs/kava/app"
@@ -13,6 +14,9 @@ import (
 	"github.com/kava-labs/kava/x/pricefeed"
 )
 
+// oracle is the address that posts prices in the pricefeed genesis states used by tests
+var oracle = sdk.AccAddress(crypto.AddressHash([]byte("oracle")))
+
 // Avoid cluttering test cases with long function names
 func i(in int64) sdk.Int                    { return sdk.NewInt(in) }
 func d(str string) sdk.Dec                  { return sdk.MustNewDecFromStr(str) }
@@ -23,13 +27,13 @@ func NewPricefeedGenState(asset string, price sdk.Dec) app.GenesisState {
 	pfGenesis := pricefeed.GenesisState{
 		Params: pricefeed.Params{
 			Markets: []pricefeed.Market{
-				{MarketID: asset + ":usd", BaseAsset: asset, QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: asset + ":usd", BaseAsset: asset, QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
 			},
 		},
 		PostedPrices: []pricefeed.PostedPrice{
 			{
 				MarketID:      asset + ":usd",
-				OracleAddress: sdk.AccAddress{},
+				OracleAddress: oracle,
 				Price:         price,
 				Expiry:        time.Now().Add(1 * time.Hour),
 			},
@@ -88,20 +92,20 @@ func NewPricefeedGenStateMulti() app.GenesisState {
 	pfGenesis := pricefeed.GenesisState{
 		Params: pricefeed.Params{
 			Markets: []pricefeed.Market{
-				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-				{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+				{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
 			},
 		},
 		PostedPrices: []pricefeed.PostedPrice{
 			{
 				MarketID:      "btc:usd",
-				OracleAddress: sdk.AccAddress{},
+				OracleAddress: oracle,
 				Price:         sdk.MustNewDecFromStr("8000.00"),
 				Expiry:        time.Now().Add(1 * time.Hour),
 			},
 			{
 				MarketID:      "xrp:usd",
-				OracleAddress: sdk.AccAddress{},
+				OracleAddress: oracle,
 				Price:         sdk.MustNewDecFromStr("0.25"),
 				Expiry:        time.Now().Add(1 * time.Hour),
 			},
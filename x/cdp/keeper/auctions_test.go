@@ -79,6 +79,25 @@ func (suite *AuctionTestSuite) TestSurplusAuction() {
 	suite.Equal(cs(c("usdx", 490000000000)), acc.GetCoins())
 }
 
+func (suite *AuctionTestSuite) TestSealedBidSurplusAuction() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SealedBidSurplus = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	sk := suite.app.GetSupplyKeeper()
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 600000000000)))
+	suite.NoError(err)
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 100000000000)))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.RunSurplusAndDebtAuctions(suite.ctx))
+	acc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(cs(c("usdx", 10000000000)), acc.GetCoins())
+
+	auctions := suite.app.GetAuctionKeeper().GetAllAuctions(suite.ctx)
+	suite.Require().Len(auctions, 1)
+	suite.Equal(auction.SealedBidSurplusAuctionType, auctions[0].GetType())
+}
+
 func (suite *AuctionTestSuite) TestDebtAuction() {
 	sk := suite.app.GetSupplyKeeper()
 	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 100000000000)))
@@ -9,6 +9,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/kava-labs/kava/x/cdp/types"
+	pftypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // AddCdp adds a cdp for a specific owner and collateral type
@@ -584,6 +585,9 @@ func (k Keeper) SetMarketStatus(ctx sdk.Context, marketID string, up bool) {
 func (k Keeper) GetMarketStatus(ctx sdk.Context, marketID string) (up bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PricefeedStatusKeyPrefix)
 	bz := store.Get([]byte(marketID))
+	if bz == nil {
+		return false
+	}
 	k.cdc.MustUnmarshalBinaryBare(bz, &up)
 	return up
 }
@@ -599,6 +603,74 @@ func (k Keeper) UpdatePricefeedStatus(ctx sdk.Context, marketID string) (ok bool
 	return true
 }
 
+// UpdatePricefeedStatuses updates the status of the spot and liquidation markets of the collateral types that are priced from a pricefeed market,
+// including time weighted average price markets derived from it
+func (k Keeper) UpdatePricefeedStatuses(ctx sdk.Context, marketID string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CollateralMarketIndexPrefix)
+	bz := store.Get([]byte(marketID))
+	if bz == nil {
+		return
+	}
+	var collateralMarketIDs []string
+	k.cdc.MustUnmarshalBinaryBare(bz, &collateralMarketIDs)
+	for _, id := range collateralMarketIDs {
+		k.UpdatePricefeedStatus(ctx, id)
+	}
+}
+
+// IndexCollateralMarkets indexes the spot and liquidation markets of each collateral type by the pricefeed market they are priced from,
+// and updates their statuses. Time weighted average price markets are indexed by the market they are derived from.
+// It is called whenever the collateral params are set, so pricefeed hooks only update the markets priced from the changed market.
+func (k Keeper) IndexCollateralMarkets(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CollateralMarketIndexPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	var oldKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		oldKeys = append(oldKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range oldKeys {
+		store.Delete(key)
+	}
+
+	// the collateral params can be set before the other params
+	var cps types.CollateralParams
+	if k.paramSubspace.Has(ctx, types.KeyCollateralParams) {
+		k.paramSubspace.Get(ctx, types.KeyCollateralParams, &cps)
+	}
+
+	var pricefeedMarketIDs []string
+	index := make(map[string][]string)
+	for _, cp := range cps {
+		for _, id := range []string{cp.SpotMarketID, cp.LiquidationMarketID} {
+			pricefeedMarketID := id
+			if baseMarketID, _, isTWAP := pftypes.ParseTWAPMarketID(id); isTWAP {
+				pricefeedMarketID = baseMarketID
+			}
+			if containsString(index[pricefeedMarketID], id) {
+				continue
+			}
+			if _, found := index[pricefeedMarketID]; !found {
+				pricefeedMarketIDs = append(pricefeedMarketIDs, pricefeedMarketID)
+			}
+			index[pricefeedMarketID] = append(index[pricefeedMarketID], id)
+			k.UpdatePricefeedStatus(ctx, id)
+		}
+	}
+	for _, id := range pricefeedMarketIDs {
+		store.Set([]byte(id), k.cdc.MustMarshalBinaryBare(index[id]))
+	}
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
 // converts the input collateral to base units (ie multiplies the input by 10^(-ConversionFactor))
 func (k Keeper) convertCollateralToBaseUnits(ctx sdk.Context, collateral sdk.Coin, collateralType string) (baseUnits sdk.Dec) {
 	cp, _ := k.GetCollateral(ctx, collateralType)
@@ -15,6 +15,7 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp/keeper"
 	"github.com/kava-labs/kava/x/cdp/types"
+	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 type CdpTestSuite struct {
@@ -107,6 +108,96 @@ func (suite *CdpTestSuite) TestAddCdp() {
 	suite.Require().True(errors.Is(err, types.ErrCdpAlreadyExists))
 }
 
+func (suite *CdpTestSuite) TestUpdatePricefeedStatus_Halted() {
+	pk := suite.app.GetPriceFeedKeeper()
+	params := pk.GetParams(suite.ctx)
+	for i := range params.Markets {
+		if params.Markets[i].MarketID == "xrp:usd" {
+			params.Markets[i].MaxPriceDeviation = d("0.1")
+			params.Markets[i].ResumeUpdates = 3
+		}
+	}
+	pk.SetParams(suite.ctx, params)
+
+	// an outlier price halts the market
+	_, err := pk.SetPrice(suite.ctx, oracle, "xrp:usd", d("0.5"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	err = pk.SetCurrentPrices(suite.ctx, "xrp:usd")
+	suite.Require().True(errors.Is(err, pricefeedtypes.ErrMarketHalted))
+	suite.False(suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd"))
+
+	// the market resumes once the price returns within the allowed deviation
+	_, err = pk.SetPrice(suite.ctx, oracle, "xrp:usd", d("0.26"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "xrp:usd"))
+	suite.True(suite.keeper.UpdatePricefeedStatus(suite.ctx, "xrp:usd"))
+}
+
+func (suite *CdpTestSuite) TestPricefeedHooks() {
+	pk := suite.app.GetPriceFeedKeeper()
+	params := pk.GetParams(suite.ctx)
+	for i := range params.Markets {
+		if params.Markets[i].MarketID == "xrp:usd" {
+			params.Markets[i].MaxPriceDeviation = d("0.1")
+			params.Markets[i].ResumeUpdates = 3
+		}
+	}
+	pk.SetParams(suite.ctx, params)
+	suite.keeper.SetMarketStatus(suite.ctx, "xrp:usd", false)
+
+	// the market status is updated when the price changes
+	_, err := pk.SetPrice(suite.ctx, oracle, "xrp:usd", d("0.26"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "xrp:usd"))
+	suite.True(suite.keeper.GetMarketStatus(suite.ctx, "xrp:usd"))
+
+	// and when the market is halted
+	_, err = pk.SetPrice(suite.ctx, oracle, "xrp:usd", d("0.5"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	err = pk.SetCurrentPrices(suite.ctx, "xrp:usd")
+	suite.Require().True(errors.Is(err, pricefeedtypes.ErrMarketHalted))
+	suite.False(suite.keeper.GetMarketStatus(suite.ctx, "xrp:usd"))
+
+	// or resumed
+	_, err = pk.SetPrice(suite.ctx, oracle, "xrp:usd", d("0.27"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "xrp:usd"))
+	suite.True(suite.keeper.GetMarketStatus(suite.ctx, "xrp:usd"))
+}
+
+func (suite *CdpTestSuite) TestIndexCollateralMarkets() {
+	pk := suite.app.GetPriceFeedKeeper()
+	twapMarketID := pricefeedtypes.TWAPMarketID("xrp:usd", 10*time.Minute)
+	pfParams := pk.GetParams(suite.ctx)
+	for i := range pfParams.Markets {
+		if pfParams.Markets[i].MarketID == "xrp:usd" {
+			pfParams.Markets[i].TWAPWindows = []time.Duration{10 * time.Minute}
+		}
+	}
+	pk.SetParams(suite.ctx, pfParams)
+
+	// setting the params indexes the markets and updates their statuses
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[1].LiquidationMarketID = twapMarketID
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.True(suite.keeper.GetMarketStatus(suite.ctx, "btc:usd"))
+	suite.False(suite.keeper.GetMarketStatus(suite.ctx, twapMarketID))
+
+	// a price change only updates the markets priced from the changed market, including its time weighted average prices
+	suite.keeper.SetMarketStatus(suite.ctx, "btc:usd", false)
+	suite.keeper.SetMarketStatus(suite.ctx, twapMarketID, true)
+	_, err := pk.SetPrice(suite.ctx, oracle, "xrp:usd", d("0.26"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "xrp:usd"))
+	suite.False(suite.keeper.GetMarketStatus(suite.ctx, twapMarketID))
+	suite.False(suite.keeper.GetMarketStatus(suite.ctx, "btc:usd"))
+
+	_, err = pk.SetPrice(suite.ctx, oracle, "btc:usd", d("8100"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(pk.SetCurrentPrices(suite.ctx, "btc:usd"))
+	suite.True(suite.keeper.GetMarketStatus(suite.ctx, "btc:usd"))
+}
+
 func (suite *CdpTestSuite) TestGetSetCollateralTypeByte() {
 	_, found := suite.keeper.GetCollateralTypePrefix(suite.ctx, "lol-a")
 	suite.False(found)
@@ -3,6 +3,7 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/x/cdp/types"
+	pftypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // Implements StakingHooks interface
@@ -21,3 +22,23 @@ func (k Keeper) BeforeCDPModified(ctx sdk.Context, cdp types.CDP) {
 		k.hooks.BeforeCDPModified(ctx, cdp)
 	}
 }
+
+// Hooks wrapper struct for the pricefeed hooks run by the cdp keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ pftypes.PricefeedHooks = Hooks{}
+
+// Hooks create new cdp hooks
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// AfterPriceChanged updates the status of the collateral markets priced from the market
+func (h Hooks) AfterPriceChanged(ctx sdk.Context, marketID string, oldPrice, newPrice sdk.Dec) {
+	h.k.UpdatePricefeedStatuses(ctx, marketID)
+}
+
+// AfterMarketStatusChanged updates the status of the collateral markets priced from the market
+func (h Hooks) AfterMarketStatusChanged(ctx sdk.Context, marketID string, halted bool) {
+	h.k.UpdatePricefeedStatuses(ctx, marketID)
+}
@@ -390,8 +390,8 @@ func (suite *InterestTestSuite) TestSynchronizeInterest() {
 
 			// setup pricefeed
 			pk := suite.app.GetPriceFeedKeeper()
-			pk.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", d("17.25"), tc.args.expectedFeesUpdatedTime.Add(time.Second))
-			pk.SetPrice(suite.ctx, sdk.AccAddress{}, "busd:usd", d("1"), tc.args.expectedFeesUpdatedTime.Add(time.Second))
+			pk.SetPrice(suite.ctx, oracle, "bnb:usd", d("17.25"), tc.args.expectedFeesUpdatedTime.Add(time.Second))
+			pk.SetPrice(suite.ctx, oracle, "busd:usd", d("1"), tc.args.expectedFeesUpdatedTime.Add(time.Second))
 
 			// setup cdp state
 			suite.keeper.SetPreviousAccrualTime(suite.ctx, tc.args.ctype, suite.ctx.BlockTime())
@@ -505,7 +505,7 @@ func (suite *InterestTestSuite) TestMultipleCDPInterest() {
 
 			// setup pricefeed
 			pk := suite.app.GetPriceFeedKeeper()
-			pk.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", d("17.25"), tc.args.expectedFeesUpdatedTime.Add(time.Second))
+			pk.SetPrice(suite.ctx, oracle, "bnb:usd", d("17.25"), tc.args.expectedFeesUpdatedTime.Add(time.Second))
 
 			// setup cdp state
 			suite.keeper.SetPreviousAccrualTime(suite.ctx, tc.args.ctype, suite.ctx.BlockTime())
@@ -646,7 +646,7 @@ func (suite *InterestTestSuite) TestCalculateCDPInterest() {
 
 			// setup pricefeed
 			pk := suite.app.GetPriceFeedKeeper()
-			pk.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", d("17.25"), tc.args.initialTime.Add(time.Duration(int(time.Second)*tc.args.timeElapsed)))
+			pk.SetPrice(suite.ctx, oracle, "bnb:usd", d("17.25"), tc.args.initialTime.Add(time.Duration(int(time.Second)*tc.args.timeElapsed)))
 
 			// setup cdp state
 			suite.keeper.SetPreviousAccrualTime(suite.ctx, tc.args.ctype, suite.ctx.BlockTime())
@@ -725,7 +725,7 @@ func (suite *InterestTestSuite) TestSyncInterestForRiskyCDPs() {
 			}
 			// setup pricefeed
 			pk := suite.app.GetPriceFeedKeeper()
-			pk.SetPrice(suite.ctx, sdk.AccAddress{}, "bnb:usd", d("20.0"), tc.args.initialTime.Add(time.Duration(int(time.Second)*tc.args.timeElapsed)))
+			pk.SetPrice(suite.ctx, oracle, "bnb:usd", d("20.0"), tc.args.initialTime.Add(time.Duration(int(time.Second)*tc.args.timeElapsed)))
 
 			// setup cdp state
 			suite.keeper.SetPreviousAccrualTime(suite.ctx, tc.args.ctype, suite.ctx.BlockTime())
@@ -18,6 +18,7 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 // SetParams sets params on the store
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramSubspace.SetParamSet(ctx, &params)
+	k.IndexCollateralMarkets(ctx)
 }
 
 // GetCollateral returns the collateral param with corresponding denom
@@ -121,7 +121,7 @@ func (suite *SeizeTestSuite) createCdps() {
 func (suite *SeizeTestSuite) setPrice(price sdk.Dec, market string) {
 	pfKeeper := suite.app.GetPriceFeedKeeper()
 
-	pfKeeper.SetPrice(suite.ctx, sdk.AccAddress{}, market, price, suite.ctx.BlockTime().Add(time.Hour*3))
+	pfKeeper.SetPrice(suite.ctx, oracle, market, price, suite.ctx.BlockTime().Add(time.Hour*3))
 	err := pfKeeper.SetCurrentPrices(suite.ctx, market)
 	suite.NoError(err)
 	pp, err := pfKeeper.GetCurrentPrice(suite.ctx, market)
@@ -261,6 +261,7 @@ func (suite *SeizeTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: c("debt", 1333330000),
 						MaxBid:            c("usdx", 1366663250),
 						LotReturns:        auction.WeightedAddresses{[]sdk.AccAddress{addr}, []sdk.Int{sdk.NewInt(9900000)}},
+						Params:            auction.DefaultParams().ForDenom("btc"),
 					},
 				},
 			},
@@ -293,7 +294,7 @@ func (suite *SeizeTestSuite) TestKeeperLiquidation() {
 			suite.SetupTest()
 			// setup pricefeed
 			pk := suite.app.GetPriceFeedKeeper()
-			_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "btc:usd", tc.args.initialPrice, suite.ctx.BlockTime().Add(time.Hour*24))
+			_, err := pk.SetPrice(suite.ctx, oracle, "btc:usd", tc.args.initialPrice, suite.ctx.BlockTime().Add(time.Hour*24))
 			suite.Require().NoError(err)
 			err = pk.SetCurrentPrices(suite.ctx, "btc:usd")
 			suite.Require().NoError(err)
@@ -305,7 +306,7 @@ func (suite *SeizeTestSuite) TestKeeperLiquidation() {
 			suite.Require().NoError(err)
 
 			// update pricefeed
-			_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, "btc:usd", tc.args.finalPrice, suite.ctx.BlockTime().Add(time.Hour*24))
+			_, err = pk.SetPrice(suite.ctx, oracle, "btc:usd", tc.args.finalPrice, suite.ctx.BlockTime().Add(time.Hour*24))
 			suite.Require().NoError(err)
 			err = pk.SetCurrentPrices(suite.ctx, "btc:usd")
 			suite.Require().NoError(err)
@@ -386,6 +387,7 @@ func (suite *SeizeTestSuite) TestBeginBlockerLiquidation() {
 						CorrespondingDebt: c("debt", 1000000000),
 						MaxBid:            c("usdx", 1025000000),
 						LotReturns:        auction.WeightedAddresses{[]sdk.AccAddress{addr}, []sdk.Int{sdk.NewInt(10000000)}},
+						Params:            auction.DefaultParams().ForDenom("btc"),
 					},
 				},
 			},
@@ -417,7 +419,7 @@ func (suite *SeizeTestSuite) TestBeginBlockerLiquidation() {
 			suite.SetupTest()
 			// setup pricefeed
 			pk := suite.app.GetPriceFeedKeeper()
-			_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "btc:usd", tc.args.initialPrice, suite.ctx.BlockTime().Add(time.Hour*24))
+			_, err := pk.SetPrice(suite.ctx, oracle, "btc:usd", tc.args.initialPrice, suite.ctx.BlockTime().Add(time.Hour*24))
 			suite.Require().NoError(err)
 			err = pk.SetCurrentPrices(suite.ctx, "btc:usd")
 			suite.Require().NoError(err)
@@ -432,7 +434,7 @@ func (suite *SeizeTestSuite) TestBeginBlockerLiquidation() {
 			}
 
 			// update pricefeed
-			_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, "btc:usd", tc.args.finalPrice, suite.ctx.BlockTime().Add(time.Hour*24))
+			_, err = pk.SetPrice(suite.ctx, oracle, "btc:usd", tc.args.finalPrice, suite.ctx.BlockTime().Add(time.Hour*24))
 			suite.Require().NoError(err)
 			err = pk.SetCurrentPrices(suite.ctx, "btc:usd")
 			suite.Require().NoError(err)
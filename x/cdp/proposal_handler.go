@@ -0,0 +1,26 @@
+package cdp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// NewParamChangeProposalHandler wraps a param change proposal handler to re-index the collateral markets when the cdp params change,
+// so the pricefeed hooks update the statuses of the markets in the new params.
+func NewParamChangeProposalHandler(k Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+		if p, ok := content.(paramstypes.ParameterChangeProposal); ok {
+			for _, change := range p.Changes {
+				if change.Subspace == DefaultParamspace {
+					k.IndexCollateralMarkets(ctx)
+					break
+				}
+			}
+		}
+		return nil
+	}
+}
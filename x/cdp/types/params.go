@@ -19,8 +19,10 @@ var (
 	KeyDebtLot              = []byte("DebtLot")
 	KeySurplusThreshold     = []byte("SurplusThreshold")
 	KeySurplusLot           = []byte("SurplusLot")
+	KeySealedBidSurplus     = []byte("SealedBidSurplus")
 	DefaultGlobalDebt       = sdk.NewCoin(DefaultStableDenom, sdk.ZeroInt())
 	DefaultCircuitBreaker   = false
+	DefaultSealedBidSurplus = false
 	DefaultCollateralParams = CollateralParams{}
 	DefaultDebtParam        = DebtParam{
 		Denom:            "usdx",
@@ -51,6 +53,7 @@ type Params struct {
 	DebtAuctionThreshold    sdk.Int          `json:"debt_auction_threshold" yaml:"debt_auction_threshold"`
 	DebtAuctionLot          sdk.Int          `json:"debt_auction_lot" yaml:"debt_auction_lot"`
 	CircuitBreaker          bool             `json:"circuit_breaker" yaml:"circuit_breaker"`
+	SealedBidSurplus        bool             `json:"sealed_bid_surplus" yaml:"sealed_bid_surplus"` // sell surplus in sealed bid auctions instead of open bid ones
 }
 
 // String implements fmt.Stringer
@@ -63,16 +66,17 @@ func (p Params) String() string {
 	Surplus Auction Lot: %s
 	Debt Auction Threshold: %s
 	Debt Auction Lot: %s
-	Circuit Breaker: %t`,
+	Circuit Breaker: %t
+	Sealed Bid Surplus: %t`,
 		p.GlobalDebtLimit, p.CollateralParams, p.DebtParam, p.SurplusAuctionThreshold, p.SurplusAuctionLot,
-		p.DebtAuctionThreshold, p.DebtAuctionLot, p.CircuitBreaker,
+		p.DebtAuctionThreshold, p.DebtAuctionLot, p.CircuitBreaker, p.SealedBidSurplus,
 	)
 }
 
 // NewParams returns a new params object
 func NewParams(
 	debtLimit sdk.Coin, collateralParams CollateralParams, debtParam DebtParam, surplusThreshold,
-	surplusLot, debtThreshold, debtLot sdk.Int, breaker bool,
+	surplusLot, debtThreshold, debtLot sdk.Int, breaker, sealedBidSurplus bool,
 ) Params {
 	return Params{
 		GlobalDebtLimit:         debtLimit,
@@ -83,6 +87,7 @@ func NewParams(
 		DebtAuctionThreshold:    debtThreshold,
 		DebtAuctionLot:          debtLot,
 		CircuitBreaker:          breaker,
+		SealedBidSurplus:        sealedBidSurplus,
 	}
 }
 
@@ -91,7 +96,7 @@ func DefaultParams() Params {
 	return NewParams(
 		DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParam, DefaultSurplusThreshold,
 		DefaultSurplusLot, DefaultDebtThreshold, DefaultDebtLot,
-		DefaultCircuitBreaker,
+		DefaultCircuitBreaker, DefaultSealedBidSurplus,
 	)
 }
 
@@ -223,6 +228,7 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeySurplusLot, &p.SurplusAuctionLot, validateSurplusAuctionLotParam),
 		params.NewParamSetPair(KeyDebtThreshold, &p.DebtAuctionThreshold, validateDebtAuctionThresholdParam),
 		params.NewParamSetPair(KeyDebtLot, &p.DebtAuctionLot, validateDebtAuctionLotParam),
+		params.NewParamSetPair(KeySealedBidSurplus, &p.SealedBidSurplus, validateSealedBidSurplusParam),
 	}
 }
 
@@ -260,6 +266,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateSealedBidSurplusParam(p.SealedBidSurplus); err != nil {
+		return err
+	}
+
 	if len(p.CollateralParams) == 0 { // default value OK
 		return nil
 	}
@@ -407,6 +417,15 @@ func validateCircuitBreakerParam(i interface{}) error {
 	return nil
 }
 
+func validateSealedBidSurplusParam(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
 func validateSurplusAuctionThresholdParam(i interface{}) error {
 	sat, ok := i.(sdk.Int)
 	if !ok {
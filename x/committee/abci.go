@@ -10,5 +10,7 @@ import (
 func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k Keeper) {
 	// enact proposals ignoring their expiry time - they could have received enough votes last block before expiring this block
 	k.EnactPassedProposals(ctx)
+	// enact queued proposals after passed proposals so that vetoes passed this block take effect first
+	k.EnactQueuedProposals(ctx)
 	k.CloseExpiredProposals(ctx)
 }
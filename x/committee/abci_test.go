@@ -109,9 +109,9 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassed() {
 	suite.NoError(err)
 
 	// add enough votes to make the first proposal pass, but not the second
-	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[0]))
-	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[1]))
-	suite.NoError(suite.keeper.AddVote(suite.ctx, id2, suite.addresses[0]))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[0], committee.Yes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[1], committee.Yes))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id2, suite.addresses[0], committee.Yes))
 
 	// Run BeginBlocker
 	suite.NotPanics(func() {
@@ -127,6 +127,133 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassed() {
 	suite.True(found, "expected non passed proposal to be not closed")
 }
 
+func (suite *ModuleTestSuite) TestBeginBlock_ClosesRejected() {
+	suite.app.InitializeFromGenesisStates()
+
+	normalCom := committee.Committee{
+		ID:               12,
+		Members:          suite.addresses[:2],
+		Permissions:      []committee.Permission{committee.GodPermission{}},
+		VoteThreshold:    d("0.8"),
+		ProposalDuration: time.Hour * 24 * 7,
+	}
+	suite.keeper.SetCommittee(suite.ctx, normalCom)
+
+	pprop1 := gov.NewTextProposal("Title 1", "A description of this proposal.")
+	id1, err := suite.keeper.SubmitProposal(suite.ctx, normalCom.Members[0], normalCom.ID, pprop1)
+	suite.NoError(err)
+
+	pprop2 := gov.NewTextProposal("Title 2", "A description of this proposal.")
+	id2, err := suite.keeper.SubmitProposal(suite.ctx, normalCom.Members[0], normalCom.ID, pprop2)
+	suite.NoError(err)
+
+	// vote no on the first proposal so it can no longer pass, and change a no vote to a yes vote on the second
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id1, suite.addresses[0], committee.No))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id2, suite.addresses[0], committee.No))
+	suite.NoError(suite.keeper.AddVote(suite.ctx, id2, suite.addresses[0], committee.Yes))
+
+	// Run BeginBlocker
+	suite.NotPanics(func() {
+		committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+
+	// Check the rejected proposal is gone
+	_, found := suite.keeper.GetProposal(suite.ctx, id1)
+	suite.False(found, "expected rejected proposal to be closed")
+	_, found = suite.keeper.GetProposal(suite.ctx, id2)
+	suite.True(found, "expected proposal that can still pass to be not closed")
+	vote, found := suite.keeper.GetVote(suite.ctx, id2, suite.addresses[0])
+	suite.True(found)
+	suite.Equal(committee.Yes, vote.VoteType)
+}
+
+func (suite *ModuleTestSuite) TestBeginBlock_EnactsQueuedAfterDelay() {
+	suite.app.InitializeFromGenesisStates()
+
+	// setup a committee with an enactment delay, and its guardian
+	normalCom := committee.Committee{
+		ID:                  12,
+		Members:             suite.addresses[:2],
+		Permissions:         []committee.Permission{committee.GodPermission{}},
+		VoteThreshold:       d("0.8"),
+		ProposalDuration:    time.Hour * 24 * 7,
+		EnactmentDelay:      time.Hour * 24,
+		GuardianCommitteeID: 13,
+	}
+	guardianCom := committee.Committee{
+		ID:               13,
+		Members:          suite.addresses[2:3],
+		VoteThreshold:    d("1.0"),
+		ProposalDuration: time.Hour * 24 * 7,
+	}
+	suite.keeper.SetCommittee(suite.ctx, normalCom)
+	suite.keeper.SetCommittee(suite.ctx, guardianCom)
+
+	// setup 2 proposals
+	previousCDPDebtThreshold := suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold
+	newDebtThreshold := previousCDPDebtThreshold.Add(i(1000000))
+	evenNewerDebtThreshold := newDebtThreshold.Add(i(1000000))
+
+	var ids []uint64
+	for _, threshold := range []sdk.Int{newDebtThreshold, evenNewerDebtThreshold} {
+		pprop := params.NewParameterChangeProposal("A Title", "A description of this proposal.",
+			[]params.ParamChange{{
+				Subspace: cdptypes.ModuleName,
+				Key:      string(cdp.KeyDebtThreshold),
+				Value:    string(cdp.ModuleCdc.MustMarshalJSON(threshold)),
+			}},
+		)
+		id, err := suite.keeper.SubmitProposal(suite.ctx, normalCom.Members[0], normalCom.ID, pprop)
+		suite.NoError(err)
+		suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[0], committee.Yes))
+		suite.NoError(suite.keeper.AddVote(suite.ctx, id, suite.addresses[1], committee.Yes))
+		ids = append(ids, id)
+	}
+
+	// Run BeginBlocker, passing both proposals
+	suite.NotPanics(func() {
+		committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+
+	// Check the passed proposals are queued and not enacted
+	suite.Equal(previousCDPDebtThreshold, suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold)
+	for _, id := range ids {
+		_, found := suite.keeper.GetProposal(suite.ctx, id)
+		suite.False(found, "expected passed proposal to be closed")
+		queuedProposal, found := suite.keeper.GetQueuedProposal(suite.ctx, id)
+		suite.True(found, "expected passed proposal to be queued")
+		suite.Equal(suite.ctx.BlockTime().Add(normalCom.EnactmentDelay), queuedProposal.EnactmentTime)
+	}
+
+	// Check only the guardian can veto the second proposal
+	veto := committee.NewCommitteeVetoProposal("A Title", "A description of this proposal.", ids[1])
+	_, err := suite.keeper.SubmitProposal(suite.ctx, normalCom.Members[0], normalCom.ID, veto)
+	suite.Error(err)
+	vetoID, err := suite.keeper.SubmitProposal(suite.ctx, guardianCom.Members[0], guardianCom.ID, veto)
+	suite.NoError(err)
+	suite.NoError(suite.keeper.AddVote(suite.ctx, vetoID, guardianCom.Members[0], committee.Yes))
+
+	// Run BeginBlocker, enacting the veto
+	suite.NotPanics(func() {
+		committee.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+	_, found := suite.keeper.GetQueuedProposal(suite.ctx, ids[1])
+	suite.False(found, "expected vetoed proposal to be removed")
+	_, found = suite.keeper.GetQueuedProposal(suite.ctx, ids[0])
+	suite.True(found, "expected non vetoed proposal to be still queued")
+
+	// Run BeginBlocker after the enactment delay
+	delayLaterCtx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(normalCom.EnactmentDelay))
+	suite.NotPanics(func() {
+		committee.BeginBlocker(delayLaterCtx, abci.RequestBeginBlock{}, suite.keeper)
+	})
+
+	// Check only the non vetoed proposal has been enacted
+	suite.Equal(newDebtThreshold, suite.app.GetCDPKeeper().GetParams(suite.ctx).DebtAuctionThreshold)
+	_, found = suite.keeper.GetQueuedProposal(suite.ctx, ids[0])
+	suite.False(found, "expected enacted proposal to be removed")
+}
+
 func (suite *ModuleTestSuite) TestBeginBlock_DoesntEnactFailed() {
 	suite.app.InitializeFromGenesisStates()
 
@@ -154,7 +281,7 @@ func (suite *ModuleTestSuite) TestBeginBlock_DoesntEnactFailed() {
 	suite.NoError(err)
 
 	// add enough votes to make the proposal pass
-	suite.NoError(suite.keeper.AddVote(ctx, id1, suite.addresses[0]))
+	suite.NoError(suite.keeper.AddVote(ctx, id1, suite.addresses[0], committee.Yes))
 
 	// Run BeginBlocker 10 seconds later (5 seconds after upgrade expires)
 	tenSecLaterCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Second * 10))
@@ -203,7 +330,7 @@ func (suite *ModuleTestSuite) TestBeginBlock_EnactsPassedUpgrade() {
 	suite.NoError(err)
 
 	// add enough votes to make the proposal pass
-	suite.NoError(suite.keeper.AddVote(ctx, id1, suite.addresses[0]))
+	suite.NoError(suite.keeper.AddVote(ctx, id1, suite.addresses[0], committee.Yes))
 
 	// Run BeginBlocker
 	fiveSecLaterCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Second * 5))
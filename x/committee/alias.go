@@ -10,67 +10,109 @@ import (
 
 const (
 	AttributeKeyCommitteeID         = types.AttributeKeyCommitteeID
+	AttributeKeyEnactmentTime       = types.AttributeKeyEnactmentTime
+	AttributeKeyGuardianCommitteeID = types.AttributeKeyGuardianCommitteeID
 	AttributeKeyProposalCloseStatus = types.AttributeKeyProposalCloseStatus
 	AttributeKeyProposalID          = types.AttributeKeyProposalID
+	AttributeKeyVote                = types.AttributeKeyVote
 	AttributeKeyVoter               = types.AttributeKeyVoter
 	AttributeValueCategory          = types.AttributeValueCategory
+	AttributeValueProposalEnacted   = types.AttributeValueProposalEnacted
 	AttributeValueProposalFailed    = types.AttributeValueProposalFailed
 	AttributeValueProposalPassed    = types.AttributeValueProposalPassed
+	AttributeValueProposalQueued    = types.AttributeValueProposalQueued
 	AttributeValueProposalTimeout   = types.AttributeValueProposalTimeout
+	BalanceTally                    = types.BalanceTally
 	DefaultNextProposalID           = types.DefaultNextProposalID
 	DefaultParamspace               = types.DefaultParamspace
 	EventTypeProposalClose          = types.EventTypeProposalClose
+	EventTypeProposalEnact          = types.EventTypeProposalEnact
 	EventTypeProposalSubmit         = types.EventTypeProposalSubmit
+	MaxParamChangeKeyLength         = types.MaxParamChangeKeyLength
+	EventTypeProposalVeto           = types.EventTypeProposalVeto
 	EventTypeProposalVote           = types.EventTypeProposalVote
+	Abstain                         = types.Abstain
 	MaxCommitteeDescriptionLength   = types.MaxCommitteeDescriptionLength
+	MaxParamBoundWindow             = types.MaxParamBoundWindow
 	ModuleName                      = types.ModuleName
+	No                              = types.No
+	NullVoteType                    = types.NullVoteType
+	ProposalTypeBundle              = types.ProposalTypeBundle
 	ProposalTypeCommitteeChange     = types.ProposalTypeCommitteeChange
 	ProposalTypeCommitteeDelete     = types.ProposalTypeCommitteeDelete
+	ProposalTypeCommitteeVeto       = types.ProposalTypeCommitteeVeto
+	ProposalTypeMembersChange       = types.ProposalTypeMembersChange
+	ProposalTypeVotingRulesChange   = types.ProposalTypeVotingRulesChange
 	QuerierRoute                    = types.QuerierRoute
 	QueryCommittee                  = types.QueryCommittee
 	QueryCommittees                 = types.QueryCommittees
 	QueryNextProposalID             = types.QueryNextProposalID
 	QueryProposal                   = types.QueryProposal
 	QueryProposals                  = types.QueryProposals
+	QueryQueuedProposals            = types.QueryQueuedProposals
 	QueryRawParams                  = types.QueryRawParams
 	QueryTally                      = types.QueryTally
 	QueryVote                       = types.QueryVote
 	QueryVotes                      = types.QueryVotes
 	RouterKey                       = types.RouterKey
+	StakedTally                     = types.StakedTally
 	StoreKey                        = types.StoreKey
 	TypeMsgSubmitProposal           = types.TypeMsgSubmitProposal
 	TypeMsgVote                     = types.TypeMsgVote
+	Yes                             = types.Yes
 )
 
 var (
 	// function aliases
-	NewKeeper                   = keeper.NewKeeper
-	NewQuerier                  = keeper.NewQuerier
-	RegisterInvariants          = keeper.RegisterInvariants
-	ValidCommitteesInvariant    = keeper.ValidCommitteesInvariant
-	ValidProposalsInvariant     = keeper.ValidProposalsInvariant
-	ValidVotesInvariant         = keeper.ValidVotesInvariant
-	DefaultGenesisState         = types.DefaultGenesisState
-	GetKeyFromID                = types.GetKeyFromID
-	GetVoteKey                  = types.GetVoteKey
-	NewAllowedCollateralParam   = types.NewAllowedCollateralParam
-	NewAllowedMoneyMarket       = types.NewAllowedMoneyMarket
-	NewCommittee                = types.NewCommittee
-	NewCommitteeChangeProposal  = types.NewCommitteeChangeProposal
-	NewCommitteeDeleteProposal  = types.NewCommitteeDeleteProposal
-	NewGenesisState             = types.NewGenesisState
-	NewMsgSubmitProposal        = types.NewMsgSubmitProposal
-	NewMsgVote                  = types.NewMsgVote
-	NewProposal                 = types.NewProposal
-	NewQueryCommitteeParams     = types.NewQueryCommitteeParams
-	NewQueryProposalParams      = types.NewQueryProposalParams
-	NewQueryRawParamsParams     = types.NewQueryRawParamsParams
-	NewQueryVoteParams          = types.NewQueryVoteParams
-	NewVote                     = types.NewVote
-	RegisterCodec               = types.RegisterCodec
-	RegisterPermissionTypeCodec = types.RegisterPermissionTypeCodec
-	RegisterProposalTypeCodec   = types.RegisterProposalTypeCodec
-	Uint64FromBytes             = types.Uint64FromBytes
+	NewKeeper                      = keeper.NewKeeper
+	NewQuerier                     = keeper.NewQuerier
+	RegisterInvariants             = keeper.RegisterInvariants
+	ValidCommitteesInvariant       = keeper.ValidCommitteesInvariant
+	ValidProposalsInvariant        = keeper.ValidProposalsInvariant
+	ValidQueuedProposalsInvariant  = keeper.ValidQueuedProposalsInvariant
+	ValidVotesInvariant            = keeper.ValidVotesInvariant
+	DefaultGenesisState            = types.DefaultGenesisState
+	GetKeyFromID                   = types.GetKeyFromID
+	GetParamChangeKey              = types.GetParamChangeKey
+	GetParamChangeKeyPrefix        = types.GetParamChangeKeyPrefix
+	ParseParamChangeKey            = types.ParseParamChangeKey
+	GetVoteKey                     = types.GetVoteKey
+	NewAllowedAuctionDenomParam    = types.NewAllowedAuctionDenomParam
+	NewAllowedCollateralParam      = types.NewAllowedCollateralParam
+	NewAllowedMoneyMarket          = types.NewAllowedMoneyMarket
+	NewBundleProposal              = types.NewBundleProposal
+	NewCollateralParamTemplate     = types.NewCollateralParamTemplate
+	NewCommittee                   = types.NewCommittee
+	NewCommitteeChangeProposal     = types.NewCommitteeChangeProposal
+	NewCommitteeDeleteProposal     = types.NewCommitteeDeleteProposal
+	NewCommitteeVetoProposal       = types.NewCommitteeVetoProposal
+	NewGenesisState                = types.NewGenesisState
+	NewMembersChangePermission     = types.NewMembersChangePermission
+	NewMembersChangeProposal       = types.NewMembersChangeProposal
+	NewMoneyMarketTemplate         = types.NewMoneyMarketTemplate
+	NewMsgSubmitProposal           = types.NewMsgSubmitProposal
+	NewMsgVote                     = types.NewMsgVote
+	NewParamBound                  = types.NewParamBound
+	NewParamChangeRecord           = types.NewParamChangeRecord
+	NewProposal                    = types.NewProposal
+	NewProposalTally               = types.NewProposalTally
+	NewQueryCommitteeParams        = types.NewQueryCommitteeParams
+	NewQueryProposalParams         = types.NewQueryProposalParams
+	NewQueryRawParamsParams        = types.NewQueryRawParamsParams
+	NewQueryVoteParams             = types.NewQueryVoteParams
+	NewQueuedProposal              = types.NewQueuedProposal
+	NewRewardPeriodTemplate        = types.NewRewardPeriodTemplate
+	NewTallyParams                 = types.NewTallyParams
+	NewTallySnapshot               = types.NewTallySnapshot
+	NewTallySourceFromString       = types.NewTallySourceFromString
+	NewVote                        = types.NewVote
+	NewVoteTypeFromString          = types.NewVoteTypeFromString
+	NewVotingRulesChangePermission = types.NewVotingRulesChangePermission
+	NewVotingRulesChangeProposal   = types.NewVotingRulesChangeProposal
+	RegisterCodec                  = types.RegisterCodec
+	RegisterPermissionTypeCodec    = types.RegisterPermissionTypeCodec
+	RegisterProposalTypeCodec      = types.RegisterProposalTypeCodec
+	Uint64FromBytes                = types.Uint64FromBytes
 
 	// variable aliases
 	ProposalHandler            = client.ProposalHandler
@@ -78,15 +120,20 @@ var (
 	ErrInvalidCommittee        = types.ErrInvalidCommittee
 	ErrInvalidGenesis          = types.ErrInvalidGenesis
 	ErrInvalidPubProposal      = types.ErrInvalidPubProposal
+	ErrInvalidVoteType         = types.ErrInvalidVoteType
+	ErrNoVotingWeight          = types.ErrNoVotingWeight
 	ErrNoProposalHandlerExists = types.ErrNoProposalHandlerExists
 	ErrProposalExpired         = types.ErrProposalExpired
 	ErrUnknownCommittee        = types.ErrUnknownCommittee
 	ErrUnknownProposal         = types.ErrUnknownProposal
+	ErrUnknownQueuedProposal   = types.ErrUnknownQueuedProposal
 	ErrUnknownSubspace         = types.ErrUnknownSubspace
 	ErrUnknownVote             = types.ErrUnknownVote
 	ModuleCdc                  = types.ModuleCdc
 	NextProposalIDKey          = types.NextProposalIDKey
+	ParamChangeKeyPrefix       = types.ParamChangeKeyPrefix
 	ProposalKeyPrefix          = types.ProposalKeyPrefix
+	QueuedProposalKeyPrefix    = types.QueuedProposalKeyPrefix
 	VoteKeyPrefix              = types.VoteKeyPrefix
 )
 
@@ -94,6 +141,8 @@ type (
 	Keeper                      = keeper.Keeper
 	AllowedAssetParam           = types.AllowedAssetParam
 	AllowedAssetParams          = types.AllowedAssetParams
+	AllowedAuctionDenomParam    = types.AllowedAuctionDenomParam
+	AllowedAuctionDenomParams   = types.AllowedAuctionDenomParams
 	AllowedCollateralParam      = types.AllowedCollateralParam
 	AllowedCollateralParams     = types.AllowedCollateralParams
 	AllowedDebtParam            = types.AllowedDebtParam
@@ -103,24 +152,49 @@ type (
 	AllowedMoneyMarkets         = types.AllowedMoneyMarkets
 	AllowedParam                = types.AllowedParam
 	AllowedParams               = types.AllowedParams
+	AssetListingPermission      = types.AssetListingPermission
+	BundleProposal              = types.BundleProposal
+	CollateralParamTemplate     = types.CollateralParamTemplate
 	Committee                   = types.Committee
 	CommitteeChangeProposal     = types.CommitteeChangeProposal
 	CommitteeDeleteProposal     = types.CommitteeDeleteProposal
+	CommitteeVetoProposal       = types.CommitteeVetoProposal
 	GenesisState                = types.GenesisState
 	GodPermission               = types.GodPermission
+	MembersChangePermission     = types.MembersChangePermission
+	MembersChangeProposal       = types.MembersChangeProposal
+	MoneyMarketTemplate         = types.MoneyMarketTemplate
 	MsgSubmitProposal           = types.MsgSubmitProposal
 	MsgVote                     = types.MsgVote
+	ParamBound                  = types.ParamBound
+	ParamChangeRecord           = types.ParamChangeRecord
+	ParamBounds                 = types.ParamBounds
 	ParamKeeper                 = types.ParamKeeper
+	ParamHistory                = types.ParamHistory
+	AccountKeeper               = types.AccountKeeper
+	SupplyKeeper                = types.SupplyKeeper
+	StakingKeeper               = types.StakingKeeper
+	HardKeeper                  = types.HardKeeper
 	Permission                  = types.Permission
 	Proposal                    = types.Proposal
+	ProposalTally               = types.ProposalTally
 	PubProposal                 = types.PubProposal
 	QueryCommitteeParams        = types.QueryCommitteeParams
 	QueryProposalParams         = types.QueryProposalParams
+	QueryQueuedProposalsParams  = types.QueryQueuedProposalsParams
 	QueryRawParamsParams        = types.QueryRawParamsParams
 	QueryVoteParams             = types.QueryVoteParams
+	QueuedProposal              = types.QueuedProposal
+	RewardPeriodTemplate        = types.RewardPeriodTemplate
 	SimpleParamChangePermission = types.SimpleParamChangePermission
 	SoftwareUpgradePermission   = types.SoftwareUpgradePermission
 	SubParamChangePermission    = types.SubParamChangePermission
+	TallyParams                 = types.TallyParams
+	TallySnapshot               = types.TallySnapshot
+	TallySource                 = types.TallySource
 	TextPermission              = types.TextPermission
 	Vote                        = types.Vote
+	VoteType                    = types.VoteType
+	VotingRulesChangePermission = types.VotingRulesChangePermission
+	VotingRulesChangeProposal   = types.VotingRulesChangeProposal
 )
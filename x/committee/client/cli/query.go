@@ -33,6 +33,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		// proposals
 		GetCmdQueryProposal(queryRoute, cdc),
 		GetCmdQueryProposals(queryRoute, cdc),
+		GetCmdQueryQueuedProposals(queryRoute, cdc),
 		// votes
 		GetCmdQueryVotes(queryRoute, cdc),
 		// other
@@ -179,6 +180,50 @@ func GetCmdQueryProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryQueuedProposals implements a query queued proposals command.
+func GetCmdQueryQueuedProposals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-proposals [committee-id]",
+		Short: "Query passed proposals waiting to be enacted",
+		Long:  "Query the passed proposals of a committee that are waiting for the committee's enactment delay, or of all committees if no committee-id is given.",
+		Args:  cobra.MaximumNArgs(1),
+		Example: fmt.Sprintf(`%[1]s query %[2]s queued-proposals
+%[1]s query %[2]s queued-proposals 1`, version.ClientName, types.ModuleName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			var committeeID uint64
+			if len(args) > 0 {
+				id, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("committee-id %s not a valid uint", args[0])
+				}
+				committeeID = id
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryQueuedProposalsParams(committeeID))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryQueuedProposals), bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			queuedProposals := []types.QueuedProposal{}
+			err = cdc.UnmarshalJSON(res, &queuedProposals)
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintOutput(queuedProposals)
+		},
+	}
+	return cmd
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
@@ -251,7 +296,7 @@ func GetCmdQueryTally(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			}
 
 			// Decode and print results
-			var tally bool
+			var tally types.ProposalTally
 			if err = cdc.UnmarshalJSON(res, &tally); err != nil {
 				return err
 			}
@@ -100,11 +100,11 @@ For example:
 // GetCmdVote returns the command to vote on a proposal.
 func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:     "vote [proposal-id]",
-		Args:    cobra.ExactArgs(1),
+		Use:     "vote [proposal-id] [yes/no/abstain]",
+		Args:    cobra.ExactArgs(2),
 		Short:   "Vote for an active proposal",
-		Long:    "Submit a yes vote for the proposal with id [proposal-id].",
-		Example: fmt.Sprintf("%s tx %s vote 2", version.ClientName, types.ModuleName),
+		Long:    "Submit a yes, no, or abstain vote for the proposal with id [proposal-id]. Voting again replaces the previous vote.",
+		Example: fmt.Sprintf("%s tx %s vote 2 yes", version.ClientName, types.ModuleName),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			inBuf := bufio.NewReader(cmd.InOrStdin())
 			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -119,8 +119,13 @@ func GetCmdVote(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("proposal-id %s not a valid int, please input a valid proposal-id", args[0])
 			}
 
+			voteType := types.NewVoteTypeFromString(args[1])
+			if !voteType.IsValid() {
+				return fmt.Errorf("vote %s not valid, please input one of yes, no, or abstain", args[1])
+			}
+
 			// Build vote message and run basic validation
-			msg := types.NewMsgVote(from, proposalID)
+			msg := types.NewMsgVote(from, proposalID, voteType)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
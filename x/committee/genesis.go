@@ -25,6 +25,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 	for _, v := range gs.Votes {
 		keeper.SetVote(ctx, v)
 	}
+	for _, qp := range gs.QueuedProposals {
+		keeper.SetQueuedProposal(ctx, qp)
+	}
+	for _, r := range gs.ParamChanges {
+		keeper.SetParamChangeRecord(ctx, r)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -37,11 +43,15 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 	committees := keeper.GetCommittees(ctx)
 	proposals := keeper.GetProposals(ctx)
 	votes := keeper.GetVotes(ctx)
+	queuedProposals := keeper.GetQueuedProposals(ctx)
+	paramChanges := keeper.GetParamChangeRecords(ctx)
 
 	return types.NewGenesisState(
 		nextID,
 		committees,
 		proposals,
 		votes,
+		queuedProposals,
+		paramChanges,
 	)
 }
@@ -2,6 +2,7 @@ package committee_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 
@@ -23,6 +24,7 @@ type GenesisTestSuite struct {
 }
 
 func (suite *GenesisTestSuite) TestGenesis() {
+	testTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
 	testCases := []struct {
 		name       string
 		genState   types.GenesisState
@@ -33,6 +35,22 @@ func (suite *GenesisTestSuite) TestGenesis() {
 			genState:   types.DefaultGenesisState(),
 			expectPass: true,
 		},
+		{
+			name: "param changes",
+			genState: types.NewGenesisState(
+				types.DefaultNextProposalID,
+				[]types.Committee{},
+				[]types.Proposal{},
+				[]types.Vote{},
+				[]types.QueuedProposal{},
+				[]types.ParamChangeRecord{
+					types.NewParamChangeRecord("cdp", "DebtThreshold", testTime, `"100000000000"`),
+					types.NewParamChangeRecord("cdp", "DebtThreshold", testTime.Add(time.Hour), `"200000000000"`),
+					types.NewParamChangeRecord("hard", "MoneyMarkets", testTime, `[]`),
+				},
+			),
+			expectPass: true,
+		},
 		{
 			name: "invalid",
 			genState: types.NewGenesisState(
@@ -40,6 +58,8 @@ func (suite *GenesisTestSuite) TestGenesis() {
 				[]types.Committee{},
 				[]types.Proposal{{ID: 1, CommitteeID: 57}},
 				[]types.Vote{},
+				[]types.QueuedProposal{},
+				[]types.ParamChangeRecord{},
 			),
 			expectPass: false,
 		},
@@ -66,6 +86,12 @@ func (suite *GenesisTestSuite) TestGenesis() {
 			// Check
 			if tc.expectPass {
 				suite.Equal(tc.genState, exportedGenState)
+				// imported param changes bound later changes
+				for _, r := range tc.genState.ParamChanges {
+					value, found := suite.keeper.GetParamValueAt(suite.ctx, r.Subspace, r.Key, r.Time.Add(-time.Second))
+					suite.True(found)
+					suite.NotEmpty(value)
+				}
 			}
 		})
 	}
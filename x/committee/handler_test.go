@@ -60,6 +60,8 @@ func (suite *HandlerTestSuite) SetupTest() {
 		},
 		[]types.Proposal{},
 		[]types.Vote{},
+		[]types.QueuedProposal{},
+		[]types.ParamChangeRecord{},
 	)
 	suite.communityPoolAmt = cs(c("ukava", 1000))
 	suite.app.InitializeFromGenesisStates(
@@ -18,6 +18,8 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 		ValidProposalsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "valid-votes",
 		ValidVotesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "valid-queued-proposals",
+		ValidQueuedProposalsInvariant(k))
 }
 
 // ValidCommitteesInvariant verifies that all committees in the store are independently valid
@@ -118,7 +120,8 @@ func ValidVotesInvariant(k Keeper) sdk.Invariant {
 				validationErr = fmt.Errorf("vote's proposal has no committee %d", proposal.CommitteeID)
 				return true
 			}
-			if !com.HasMember(vote.Voter) {
+			// any holder of the tally denom can vote in a token committee
+			if !com.IsTokenCommittee() && !com.HasMember(vote.Voter) {
 				validationErr = fmt.Errorf("voter is not a member of committee %+v", com)
 				return true
 			}
@@ -138,3 +141,44 @@ func ValidVotesInvariant(k Keeper) sdk.Invariant {
 		return invariantMessage, broken
 	}
 }
+
+// ValidQueuedProposalsInvariant verifies that all queued proposals in the store are valid
+func ValidQueuedProposalsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+
+		var validationErr error
+		var invalidQueuedProposal types.QueuedProposal
+		k.IterateQueuedProposals(ctx, func(qp types.QueuedProposal) bool {
+			invalidQueuedProposal = qp
+
+			if err := qp.Proposal.PubProposal.ValidateBasic(); err != nil {
+				validationErr = err
+				return true
+			}
+
+			if _, found := k.GetProposal(ctx, qp.Proposal.ID); found {
+				validationErr = fmt.Errorf("queued proposal %d is also an active proposal", qp.Proposal.ID)
+				return true
+			}
+
+			_, found := k.GetCommittee(ctx, qp.Proposal.CommitteeID)
+			if !found {
+				validationErr = fmt.Errorf("queued proposal has no committee %d", qp.Proposal.CommitteeID)
+				return true
+			}
+
+			return false
+		})
+
+		broken := validationErr != nil
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"valid queued proposals",
+			fmt.Sprintf(
+				"\tfound invalid queued proposal, reason: %s\n"+
+					"\tqueued proposal:\n\t%s\n",
+				validationErr, invalidQueuedProposal),
+		)
+		return invariantMessage, broken
+	}
+}
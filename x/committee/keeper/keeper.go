@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+	"sort"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -18,20 +20,44 @@ type Keeper struct {
 
 	ParamKeeper types.ParamKeeper // TODO ideally don't export, only sims need it exported
 
+	// Keepers used to weight the votes of token committees
+	accountKeeper types.AccountKeeper
+	supplyKeeper  types.SupplyKeeper
+	stakingKeeper types.StakingKeeper
+	hardKeeper    types.HardKeeper
+	// Module account addresses, whose balances are excluded from the total weight of balance tallies
+	moduleAccAddrs []sdk.AccAddress
+
 	// Proposal router
 	router govtypes.Router
 }
 
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router govtypes.Router, paramKeeper types.ParamKeeper) Keeper {
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router govtypes.Router, paramKeeper types.ParamKeeper,
+	ak types.AccountKeeper, sk types.SupplyKeeper, stk types.StakingKeeper, hk types.HardKeeper, moduleAccAddrs map[string]bool) Keeper {
 	// Logic in the keeper methods assume the set of gov handlers is fixed.
 	// So the gov router must be sealed so no handlers can be added or removed after the keeper is created.
 	router.Seal()
 
+	var addrs []sdk.AccAddress
+	for bech32 := range moduleAccAddrs {
+		addr, err := sdk.AccAddressFromBech32(bech32)
+		if err != nil {
+			panic(err)
+		}
+		addrs = append(addrs, addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool { return bytes.Compare(addrs[i], addrs[j]) < 0 })
+
 	return Keeper{
-		cdc:         cdc,
-		storeKey:    storeKey,
-		ParamKeeper: paramKeeper,
-		router:      router,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		ParamKeeper:    paramKeeper,
+		accountKeeper:  ak,
+		supplyKeeper:   sk,
+		stakingKeeper:  stk,
+		hardKeeper:     hk,
+		moduleAccAddrs: addrs,
+		router:         router,
 	}
 }
 
@@ -133,6 +159,13 @@ func (k Keeper) StoreNewProposal(ctx sdk.Context, pubProposal types.PubProposal,
 		deadline,
 	)
 
+	// Snapshot the total voting weight of token committees
+	com, found := k.GetCommittee(ctx, committeeID)
+	if found && com.IsTokenCommittee() {
+		snapshot := types.NewTallySnapshot(ctx.BlockHeight(), k.GetTotalVotingWeight(ctx, *com.TallyParams))
+		proposal.TallySnapshot = &snapshot
+	}
+
 	k.SetProposal(ctx, proposal)
 
 	err = k.IncrementNextProposalID(ctx)
@@ -214,6 +247,73 @@ func (k Keeper) DeleteProposalAndVotes(ctx sdk.Context, proposalID uint64) {
 	}
 }
 
+// ------------------------------------------
+//				Queued Proposals
+// ------------------------------------------
+
+// GetQueuedProposal gets a queued proposal from the store.
+func (k Keeper) GetQueuedProposal(ctx sdk.Context, proposalID uint64) (types.QueuedProposal, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	bz := store.Get(types.GetKeyFromID(proposalID))
+	if bz == nil {
+		return types.QueuedProposal{}, false
+	}
+	var queuedProposal types.QueuedProposal
+	k.cdc.MustUnmarshalBinaryBare(bz, &queuedProposal)
+	return queuedProposal, true
+}
+
+// SetQueuedProposal puts a queued proposal into the store.
+func (k Keeper) SetQueuedProposal(ctx sdk.Context, queuedProposal types.QueuedProposal) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(queuedProposal)
+	store.Set(types.GetKeyFromID(queuedProposal.Proposal.ID), bz)
+}
+
+// DeleteQueuedProposal removes a queued proposal from the store.
+func (k Keeper) DeleteQueuedProposal(ctx sdk.Context, proposalID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+	store.Delete(types.GetKeyFromID(proposalID))
+}
+
+// IterateQueuedProposals provides an iterator over all stored queued proposals.
+// For each queued proposal, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateQueuedProposals(ctx sdk.Context, cb func(queuedProposal types.QueuedProposal) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.QueuedProposalKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var queuedProposal types.QueuedProposal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &queuedProposal)
+
+		if cb(queuedProposal) {
+			break
+		}
+	}
+}
+
+// GetQueuedProposals returns all stored queued proposals.
+func (k Keeper) GetQueuedProposals(ctx sdk.Context) []types.QueuedProposal {
+	results := []types.QueuedProposal{}
+	k.IterateQueuedProposals(ctx, func(qp types.QueuedProposal) bool {
+		results = append(results, qp)
+		return false
+	})
+	return results
+}
+
+// GetQueuedProposalsByCommittee returns all queued proposals for one committee.
+func (k Keeper) GetQueuedProposalsByCommittee(ctx sdk.Context, committeeID uint64) []types.QueuedProposal {
+	results := []types.QueuedProposal{}
+	k.IterateQueuedProposals(ctx, func(qp types.QueuedProposal) bool {
+		if qp.Proposal.CommitteeID == committeeID {
+			results = append(results, qp)
+		}
+		return false
+	})
+	return results
+}
+
 // ------------------------------------------
 //				Votes
 // ------------------------------------------
@@ -283,3 +383,86 @@ func (k Keeper) GetVotesByProposal(ctx sdk.Context, proposalID uint64) []types.V
 
 	return results
 }
+
+// ------------------------------------------
+//				Param Changes
+// ------------------------------------------
+
+// RecordParamChange stores the value a param had before a committee changed it.
+// Only the first value in a block is kept, and records older than the longest param bound window are removed.
+func (k Keeper) RecordParamChange(ctx sdk.Context, subspace, key string, previousValue []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.ParamChangeKeyPrefix, types.GetParamChangeKeyPrefix(subspace, key)...))
+	timeKey := sdk.FormatTimeBytes(ctx.BlockTime())
+	if !store.Has(timeKey) {
+		store.Set(timeKey, previousValue)
+	}
+
+	// prune records that no param bound window can reach
+	var expiredKeys [][]byte
+	iterator := store.Iterator(nil, sdk.FormatTimeBytes(ctx.BlockTime().Add(-types.MaxParamBoundWindow)))
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, expiredKey := range expiredKeys {
+		store.Delete(expiredKey)
+	}
+}
+
+// SetParamChangeRecord stores a recorded param change, without pruning older records.
+func (k Keeper) SetParamChangeRecord(ctx sdk.Context, record types.ParamChangeRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ParamChangeKeyPrefix)
+	store.Set(types.GetParamChangeKey(record.Subspace, record.Key, record.Time), []byte(record.PreviousValue))
+}
+
+// IterateParamChangeRecords provides an iterator over all recorded param changes, ordered by param and then by time.
+// For each record, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateParamChangeRecords(ctx sdk.Context, cb func(record types.ParamChangeRecord) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.ParamChangeKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		subspace, key, changeTime, err := types.ParseParamChangeKey(iterator.Key()[len(types.ParamChangeKeyPrefix):])
+		if err != nil {
+			panic(err)
+		}
+		if cb(types.NewParamChangeRecord(subspace, key, changeTime, string(iterator.Value()))) {
+			break
+		}
+	}
+}
+
+// GetParamChangeRecords returns all recorded param changes.
+func (k Keeper) GetParamChangeRecords(ctx sdk.Context) []types.ParamChangeRecord {
+	results := []types.ParamChangeRecord{}
+	k.IterateParamChangeRecords(ctx, func(record types.ParamChangeRecord) bool {
+		results = append(results, record)
+		return false
+	})
+	return results
+}
+
+// GetParamValueAt returns the value a param had at a time.
+// It returns false if no committee has changed the param since then, in which case the value is the current one.
+func (k Keeper) GetParamValueAt(ctx sdk.Context, subspace, key string, t time.Time) ([]byte, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.ParamChangeKeyPrefix, types.GetParamChangeKeyPrefix(subspace, key)...))
+	iterator := store.Iterator(sdk.FormatTimeBytes(t), nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return nil, false
+	}
+	return iterator.Value(), true
+}
+
+// paramHistoryKeeper gives permissions access to recorded param changes alongside the param subspaces
+type paramHistoryKeeper struct {
+	types.ParamKeeper
+	keeper Keeper
+}
+
+var _ types.ParamHistory = paramHistoryKeeper{}
+
+// GetParamValueAt returns the value a param had at a time
+func (pk paramHistoryKeeper) GetParamValueAt(ctx sdk.Context, subspace, key string, t time.Time) ([]byte, bool) {
+	return pk.keeper.GetParamValueAt(ctx, subspace, key, t)
+}
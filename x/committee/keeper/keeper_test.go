@@ -85,11 +85,41 @@ func (suite *KeeperTestSuite) TestGetSetDeleteProposal() {
 	suite.False(found)
 }
 
+func (suite *KeeperTestSuite) TestGetSetDeleteQueuedProposal() {
+	// test setup
+	queuedProp := types.QueuedProposal{
+		Proposal: types.Proposal{
+			ID:          12,
+			CommitteeID: 0,
+			PubProposal: gov.NewTextProposal("A Title", "A description of this proposal."),
+			Deadline:    time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		EnactmentTime: time.Date(1998, time.January, 2, 0, 0, 0, 0, time.UTC),
+	}
+
+	// write and read from store
+	suite.keeper.SetQueuedProposal(suite.ctx, queuedProp)
+	readQueuedProposal, found := suite.keeper.GetQueuedProposal(suite.ctx, queuedProp.Proposal.ID)
+
+	// check before and after match
+	suite.True(found)
+	suite.Equal(queuedProp, readQueuedProposal)
+
+	// delete from store
+	suite.keeper.DeleteQueuedProposal(suite.ctx, queuedProp.Proposal.ID)
+
+	// check does not exist
+	_, found = suite.keeper.GetQueuedProposal(suite.ctx, queuedProp.Proposal.ID)
+	suite.False(found)
+}
+
 func (suite *KeeperTestSuite) TestGetSetDeleteVote() {
 	// test setup
 	vote := types.Vote{
 		ProposalID: 12,
 		Voter:      suite.addresses[0],
+		VoteType:   types.Yes,
+		Weight:     sdk.OneInt(),
 	}
 
 	// write and read from store
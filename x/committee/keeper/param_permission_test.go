@@ -15,6 +15,8 @@ import (
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/committee/types"
+	"github.com/kava-labs/kava/x/hard"
+	incentivetypes "github.com/kava-labs/kava/x/incentive/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
@@ -131,14 +133,14 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 			MarketID:   "bnb:usd",
 			BaseAsset:  "bnb",
 			QuoteAsset: "usd",
-			Oracles:    []sdk.AccAddress{},
+			Oracles:    []sdk.AccAddress{oracle},
 			Active:     true,
 		},
 		{
 			MarketID:   "btc:usd",
 			BaseAsset:  "btc",
 			QuoteAsset: "usd",
-			Oracles:    []sdk.AccAddress{},
+			Oracles:    []sdk.AccAddress{oracle},
 			Active:     true,
 		},
 	}
@@ -262,6 +264,265 @@ func (suite *PermissionTestSuite) TestSubParamChangePermission_Allows() {
 	}
 
 }
+
+func (suite *PermissionTestSuite) TestAssetListingPermission_Allows() {
+	testCPs := cdptypes.CollateralParams{
+		{
+			Denom:               "bnb",
+			Type:                "bnb-a",
+			LiquidationRatio:    d("2.0"),
+			DebtLimit:           c("usdx", 1000000000000),
+			StabilityFee:        d("1.000000001547125958"),
+			LiquidationPenalty:  d("0.05"),
+			AuctionSize:         i(100),
+			Prefix:              0x20,
+			ConversionFactor:    i(6),
+			SpotMarketID:        "bnb:usd",
+			LiquidationMarketID: "bnb:usd",
+		},
+	}
+	newCP := cdptypes.CollateralParam{
+		Denom:                  "btc",
+		Type:                   "btc-a",
+		LiquidationRatio:       d("1.5"),
+		DebtLimit:              c("usdx", 1000000000),
+		StabilityFee:           d("1.000000001547125958"),
+		LiquidationPenalty:     d("0.10"),
+		AuctionSize:            i(1000),
+		Prefix:                 0x30,
+		ConversionFactor:       i(8),
+		SpotMarketID:           "btc:usd",
+		LiquidationMarketID:    "btc:usd",
+		KeeperRewardPercentage: d("0.01"),
+	}
+	testCDPParams := cdptypes.DefaultParams()
+	testCDPParams.CollateralParams = testCPs
+	testCDPParams.GlobalDebtLimit = testCPs[0].DebtLimit.Add(testCPs[0].DebtLimit)
+
+	newMM := hard.NewMoneyMarket("btc", hard.NewBorrowLimit(true, d("1000000000"), d("0.5")), "btc:usd", i(100000000),
+		hard.NewInterestRateModel(d("0.05"), d("2"), d("0.8"), d("10")), d("0.05"), d("0.01"))
+
+	newMarket := pricefeedtypes.Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true}
+
+	rewardStart := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	newUSDXRP := incentivetypes.NewRewardPeriod(true, "btc-a", rewardStart, rewardStart.Add(365*24*time.Hour), c("ukava", 100))
+	newSupplyRP := incentivetypes.NewMultiRewardPeriod(true, "btc", rewardStart, rewardStart.Add(365*24*time.Hour), cs(c("hard", 100)))
+
+	collateralTemplate := types.NewCollateralParamTemplate(d("1.5"), i(5000000000), d("1.000000003"), d("0.15"), i(100), i(10000), d("0.05"), 0x30, 0x3f)
+	moneyMarketTemplate := types.NewMoneyMarketTemplate(d("0.6"), d("5000000000"), d("0.05"))
+	rewardPeriodTemplate := types.NewRewardPeriodTemplate(cs(c("hard", 1000), c("ukava", 1000)))
+	permission := types.AssetListingPermission{
+		CollateralTemplate:   &collateralTemplate,
+		MoneyMarketTemplate:  &moneyMarketTemplate,
+		RewardPeriodTemplate: &rewardPeriodTemplate,
+		AllowedOracles:       []sdk.AccAddress{oracle},
+	}
+
+	newRewardsProposal := func(cps cdptypes.CollateralParams, mms hard.MoneyMarkets, usdxRPs incentivetypes.RewardPeriods, supplyRPs incentivetypes.MultiRewardPeriods) types.PubProposal {
+		var changes []paramstypes.ParamChange
+		if cps != nil {
+			changes = append(changes, paramstypes.NewParamChange(cdptypes.ModuleName, string(cdptypes.KeyCollateralParams), string(suite.cdc.MustMarshalJSON(cps))))
+		}
+		if mms != nil {
+			changes = append(changes, paramstypes.NewParamChange(hard.ModuleName, string(hard.KeyMoneyMarkets), string(suite.cdc.MustMarshalJSON(mms))))
+		}
+		if usdxRPs != nil {
+			changes = append(changes, paramstypes.NewParamChange(incentivetypes.ModuleName, string(incentivetypes.KeyUSDXMintingRewardPeriods), string(suite.cdc.MustMarshalJSON(usdxRPs))))
+		}
+		if supplyRPs != nil {
+			changes = append(changes, paramstypes.NewParamChange(incentivetypes.ModuleName, string(incentivetypes.KeyHardSupplyRewardPeriods), string(suite.cdc.MustMarshalJSON(supplyRPs))))
+		}
+		return paramstypes.NewParameterChangeProposal("A Title", "A description for this proposal.", changes)
+	}
+
+	newProposal := func(cps cdptypes.CollateralParams, mms hard.MoneyMarkets, ms pricefeedtypes.Markets) types.PubProposal {
+		var changes []paramstypes.ParamChange
+		if cps != nil {
+			changes = append(changes, paramstypes.NewParamChange(cdptypes.ModuleName, string(cdptypes.KeyCollateralParams), string(suite.cdc.MustMarshalJSON(cps))))
+		}
+		if mms != nil {
+			changes = append(changes, paramstypes.NewParamChange(hard.ModuleName, string(hard.KeyMoneyMarkets), string(suite.cdc.MustMarshalJSON(mms))))
+		}
+		if ms != nil {
+			changes = append(changes, paramstypes.NewParamChange(pricefeedtypes.ModuleName, string(pricefeedtypes.KeyMarkets), string(suite.cdc.MustMarshalJSON(ms))))
+		}
+		return paramstypes.NewParameterChangeProposal("A Title", "A description for this proposal.", changes)
+	}
+	currentMs := func() pricefeedtypes.Markets {
+		return pricefeedtypes.Markets{{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true}}
+	}
+
+	testcases := []struct {
+		name          string
+		permission    types.AssetListingPermission
+		pubProposal   types.PubProposal
+		expectAllowed bool
+	}{
+		{
+			name:          "allowed new collateral, money market, and market",
+			permission:    permission,
+			pubProposal:   newProposal(append(testCPs, newCP), hard.MoneyMarkets{newMM}, append(currentMs(), newMarket)),
+			expectAllowed: true,
+		},
+		{
+			name:          "not allowed collateral below min liquidation ratio",
+			permission:    permission,
+			pubProposal:   newProposal(cdptypes.CollateralParams{testCPs[0], func() cdptypes.CollateralParam { cp := newCP; cp.LiquidationRatio = d("1.1"); return cp }()}, nil, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed collateral above max debt limit",
+			permission:    permission,
+			pubProposal:   newProposal(cdptypes.CollateralParams{testCPs[0], func() cdptypes.CollateralParam { cp := newCP; cp.DebtLimit = c("usdx", 9000000000); return cp }()}, nil, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed collateral outside prefix range",
+			permission:    permission,
+			pubProposal:   newProposal(cdptypes.CollateralParams{testCPs[0], func() cdptypes.CollateralParam { cp := newCP; cp.Prefix = 0x40; return cp }()}, nil, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed collateral above max liquidation penalty",
+			permission:    permission,
+			pubProposal:   newProposal(cdptypes.CollateralParams{testCPs[0], func() cdptypes.CollateralParam { cp := newCP; cp.LiquidationPenalty = d("0.5"); return cp }()}, nil, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed collateral below min auction size",
+			permission:    permission,
+			pubProposal:   newProposal(cdptypes.CollateralParams{testCPs[0], func() cdptypes.CollateralParam { cp := newCP; cp.AuctionSize = i(10); return cp }()}, nil, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed collateral above max auction size",
+			permission:    permission,
+			pubProposal:   newProposal(cdptypes.CollateralParams{testCPs[0], func() cdptypes.CollateralParam { cp := newCP; cp.AuctionSize = i(100000); return cp }()}, nil, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed collateral above max keeper reward",
+			permission:    permission,
+			pubProposal:   newProposal(cdptypes.CollateralParams{testCPs[0], func() cdptypes.CollateralParam { cp := newCP; cp.KeeperRewardPercentage = d("0.5"); return cp }()}, nil, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed changing existing collateral",
+			permission:    permission,
+			pubProposal:   newProposal(cdptypes.CollateralParams{func() cdptypes.CollateralParam { cp := testCPs[0]; cp.DebtLimit = c("usdx", 1000); return cp }(), newCP}, nil, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed removing existing collateral",
+			permission:    permission,
+			pubProposal:   newProposal(cdptypes.CollateralParams{newCP}, nil, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed money market above max loan to value",
+			permission:    permission,
+			pubProposal:   newProposal(nil, hard.MoneyMarkets{func() hard.MoneyMarket { mm := newMM; mm.BorrowLimit.LoanToValue = d("0.9"); return mm }()}, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed money market without borrow limit",
+			permission:    permission,
+			pubProposal:   newProposal(nil, hard.MoneyMarkets{func() hard.MoneyMarket { mm := newMM; mm.BorrowLimit.HasMaxLimit = false; return mm }()}, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed money market above max keeper reward",
+			permission:    permission,
+			pubProposal:   newProposal(nil, hard.MoneyMarkets{func() hard.MoneyMarket { mm := newMM; mm.KeeperRewardPercentage = d("0.5"); return mm }()}, nil),
+			expectAllowed: false,
+		},
+		{
+			name:       "not allowed market with an oracle not in the allowed list",
+			permission: permission,
+			pubProposal: newProposal(append(testCPs, newCP), nil, append(currentMs(), func() pricefeedtypes.Market {
+				m := newMarket
+				m.Oracles = []sdk.AccAddress{sdk.AccAddress("not an allowed oracle")}
+				return m
+			}())),
+			expectAllowed: false,
+		},
+		{
+			name:       "not allowed market without oracles",
+			permission: permission,
+			pubProposal: newProposal(append(testCPs, newCP), nil, append(currentMs(), func() pricefeedtypes.Market {
+				m := newMarket
+				m.Oracles = nil
+				return m
+			}())),
+			expectAllowed: false,
+		},
+		{
+			name:          "allowed reward periods for new collateral and money market",
+			permission:    permission,
+			pubProposal:   newRewardsProposal(append(testCPs, newCP), hard.MoneyMarkets{newMM}, incentivetypes.RewardPeriods{newUSDXRP}, incentivetypes.MultiRewardPeriods{newSupplyRP}),
+			expectAllowed: true,
+		},
+		{
+			name:          "not allowed reward period for an existing collateral",
+			permission:    permission,
+			pubProposal:   newRewardsProposal(nil, nil, incentivetypes.RewardPeriods{func() incentivetypes.RewardPeriod { rp := newUSDXRP; rp.CollateralType = "bnb-a"; return rp }()}, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed reward period above max rewards per second",
+			permission:    permission,
+			pubProposal:   newRewardsProposal(append(testCPs, newCP), nil, incentivetypes.RewardPeriods{func() incentivetypes.RewardPeriod { rp := newUSDXRP; rp.RewardsPerSecond = c("ukava", 5000); return rp }()}, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed reward period without template",
+			permission:    types.AssetListingPermission{CollateralTemplate: permission.CollateralTemplate, AllowedOracles: permission.AllowedOracles},
+			pubProposal:   newRewardsProposal(append(testCPs, newCP), nil, incentivetypes.RewardPeriods{newUSDXRP}, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed market not used by a new asset",
+			permission:    permission,
+			pubProposal:   newProposal(nil, nil, append(currentMs(), newMarket)),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed without template",
+			permission:    types.AssetListingPermission{MoneyMarketTemplate: permission.MoneyMarketTemplate},
+			pubProposal:   newProposal(append(testCPs, newCP), nil, nil),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed other param change",
+			permission:    permission,
+			pubProposal:   paramstypes.NewParameterChangeProposal("A Title", "A description for this proposal.", []paramstypes.ParamChange{paramstypes.NewParamChange(cdptypes.ModuleName, string(cdptypes.KeyDebtThreshold), string(suite.cdc.MustMarshalJSON(i(1234))))}),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (wrong pubproposal type)",
+			permission:    permission,
+			pubProposal:   govtypes.NewTextProposal("A Title", "A description for this proposal."),
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			tApp := app.NewTestApp()
+			ctx := tApp.NewContext(true, abci.Header{})
+			tApp.InitializeFromGenesisStates(
+				newPricefeedGenState([]string{"bnb"}, []sdk.Dec{d("15.01")}),
+				newCDPGenesisState(testCDPParams),
+			)
+
+			suite.Equal(
+				tc.expectAllowed,
+				tc.permission.Allows(ctx, tApp.Codec(), tApp.GetParamsKeeper(), tc.pubProposal),
+			)
+		})
+	}
+}
+
 func TestPermissionTestSuite(t *testing.T) {
 	suite.Run(t, new(PermissionTestSuite))
 }
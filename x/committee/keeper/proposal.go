@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
 
 	"github.com/kava-labs/kava/x/committee/types"
 )
@@ -20,8 +22,13 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "proposer not member of committee")
 	}
 
+	// Token committees tally against their total voting weight, which must be positive
+	if com.IsTokenCommittee() && !k.GetTotalVotingWeight(ctx, *com.TallyParams).IsPositive() {
+		return 0, sdkerrors.Wrapf(types.ErrNoVotingWeight, "committee %d has no total voting weight", com.ID)
+	}
+
 	// Check committee has permissions to enact proposal.
-	if !com.HasPermissionsFor(ctx, k.cdc, k.ParamKeeper, pubProposal) {
+	if !k.hasPermissionsFor(ctx, com, pubProposal) {
 		return 0, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
@@ -29,6 +36,9 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 	if err := k.ValidatePubProposal(ctx, pubProposal); err != nil {
 		return 0, err
 	}
+	if _, _, err := applyToCommittee(com, pubProposal); err != nil {
+		return 0, err
+	}
 
 	// Get a new ID and store the proposal
 	deadline := ctx.BlockTime().Add(com.ProposalDuration)
@@ -47,9 +57,12 @@ func (k Keeper) SubmitProposal(ctx sdk.Context, proposer sdk.AccAddress, committ
 	return proposalID, nil
 }
 
-// AddVote submits a vote on a proposal.
-func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress) error {
+// AddVote submits a vote on a proposal. Voting again before the deadline replaces the voter's previous vote.
+func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress, voteType types.VoteType) error {
 	// Validate
+	if !voteType.IsValid() {
+		return sdkerrors.Wrapf(types.ErrInvalidVoteType, "%s", voteType)
+	}
 	pr, found := k.GetProposal(ctx, proposalID)
 	if !found {
 		return sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
@@ -62,12 +75,19 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 	if !found {
 		return sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
 	}
-	if !com.HasMember(voter) {
+	// Any holder of the tally denom can vote in a token committee, with the weight they hold when voting
+	weight := sdk.OneInt()
+	if com.IsTokenCommittee() {
+		weight = k.GetVotingWeight(ctx, *com.TallyParams, voter)
+		if !weight.IsPositive() {
+			return sdkerrors.Wrapf(types.ErrNoVotingWeight, "%s", voter)
+		}
+	} else if !com.HasMember(voter) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "voter must be a member of committee")
 	}
 
 	// Store vote, overwriting any prior vote
-	k.SetVote(ctx, types.NewVote(proposalID, voter))
+	k.SetVote(ctx, types.NewVote(proposalID, voter, voteType, weight))
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -75,6 +95,7 @@ func (k Keeper) AddVote(ctx sdk.Context, proposalID uint64, voter sdk.AccAddress
 			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", com.ID)),
 			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", pr.ID)),
 			sdk.NewAttribute(types.AttributeKeyVoter, voter.String()),
+			sdk.NewAttribute(types.AttributeKeyVote, voteType.String()),
 		),
 	)
 	return nil
@@ -91,19 +112,160 @@ func (k Keeper) GetProposalResult(ctx sdk.Context, proposalID uint64) (bool, err
 		return false, sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
 	}
 
-	numVotes := k.TallyVotes(ctx, proposalID)
+	tally := k.tallyProposal(ctx, pr, com)
+	return proposalPasses(ctx, pr, com, tally), nil
+}
 
-	proposalResult := sdk.NewDec(numVotes).GTE(com.VoteThreshold.MulInt64(int64(len(com.Members))))
+// GetProposalTally counts the votes of each type on a proposal.
+func (k Keeper) GetProposalTally(ctx sdk.Context, proposalID uint64) (types.ProposalTally, error) {
+	pr, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return types.ProposalTally{}, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", proposalID)
+	}
+	com, found := k.GetCommittee(ctx, pr.CommitteeID)
+	if !found {
+		return types.ProposalTally{}, sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", pr.CommitteeID)
+	}
+	return k.tallyProposal(ctx, pr, com), nil
+}
+
+// tallyProposal counts the votes of each type on a proposal.
+// Member committees count one vote per member. Token committees weight each vote by the weight the voter held when voting,
+// reduced to the voter's current weight if it has since fallen, so tokens that vote and then move to another voter are not counted twice.
+func (k Keeper) tallyProposal(ctx sdk.Context, pr types.Proposal, com types.Committee) types.ProposalTally {
+	yes, no, abstain := sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()
+	possible := sdk.NewInt(int64(len(com.Members)))
+	if com.IsTokenCommittee() {
+		possible = sdk.ZeroInt()
+		if pr.TallySnapshot != nil {
+			possible = pr.TallySnapshot.TotalWeight
+		}
+	}
 
-	return proposalResult, nil
+	for _, vote := range k.GetVotesByProposal(ctx, pr.ID) {
+		weight := sdk.OneInt()
+		if com.IsTokenCommittee() {
+			weight = sdk.MinInt(vote.Weight, k.GetVotingWeight(ctx, *com.TallyParams, vote.Voter))
+		}
+		switch vote.VoteType {
+		case types.Yes:
+			yes = yes.Add(weight)
+		case types.No:
+			no = no.Add(weight)
+		case types.Abstain:
+			abstain = abstain.Add(weight)
+		}
+	}
+	return types.NewProposalTally(pr.ID, yes, no, abstain, possible)
 }
 
-// TallyVotes counts all the votes on a proposal
-func (k Keeper) TallyVotes(ctx sdk.Context, proposalID uint64) int64 {
+// proposalPasses returns whether a proposal currently has enough votes to pass.
+// Proposals pass early once their yes votes reach the vote threshold of possible votes. Token committee proposals can also pass
+// at their deadline, if the votes cast reach the quorum of possible votes and the yes votes are over the vote threshold of yes and no votes.
+func proposalPasses(ctx sdk.Context, pr types.Proposal, com types.Committee, tally types.ProposalTally) bool {
+	if tally.Passes(com.VoteThreshold) {
+		return true
+	}
+	if com.IsTokenCommittee() && pr.HasExpiredBy(ctx.BlockTime()) {
+		return tally.HasQuorum(com.TallyParams.Quorum) && tally.PassesVotesCast(com.VoteThreshold)
+	}
+	return false
+}
 
-	votes := k.GetVotesByProposal(ctx, proposalID)
+// proposalCanPass returns whether a proposal could still pass if all remaining voters vote yes.
+func proposalCanPass(com types.Committee, tally types.ProposalTally) bool {
+	if com.IsTokenCommittee() {
+		return tally.CanPass(com.VoteThreshold) || tally.CanPassVotesCast(com.VoteThreshold)
+	}
+	return tally.CanPass(com.VoteThreshold)
+}
 
-	return int64(len(votes))
+// GetVotingWeight returns the voting weight of an address in a token committee
+func (k Keeper) GetVotingWeight(ctx sdk.Context, tallyParams types.TallyParams, addr sdk.AccAddress) sdk.Int {
+	switch tallyParams.Source {
+	case types.BalanceTally:
+		acc := k.accountKeeper.GetAccount(ctx, addr)
+		if acc == nil {
+			return sdk.ZeroInt()
+		}
+		return acc.GetCoins().AmountOf(tallyParams.Denom)
+	case types.StakedTally:
+		if tallyParams.Denom == k.stakingKeeper.BondDenom(ctx) {
+			return k.getBondedTokens(ctx, addr)
+		}
+		deposit, found := k.hardKeeper.GetSyncedDeposit(ctx, addr)
+		if !found {
+			return sdk.ZeroInt()
+		}
+		return deposit.Amount.AmountOf(tallyParams.Denom)
+	default:
+		return sdk.ZeroInt()
+	}
+}
+
+// GetTotalVotingWeight returns the total voting weight of all holders in a token committee.
+// Coins held by module accounts are excluded as module accounts cannot vote.
+func (k Keeper) GetTotalVotingWeight(ctx sdk.Context, tallyParams types.TallyParams) sdk.Int {
+	switch tallyParams.Source {
+	case types.BalanceTally:
+		total := k.supplyKeeper.GetSupply(ctx).GetTotal().AmountOf(tallyParams.Denom)
+		for _, addr := range k.moduleAccAddrs {
+			acc := k.accountKeeper.GetAccount(ctx, addr)
+			if acc == nil {
+				continue
+			}
+			total = total.Sub(acc.GetCoins().AmountOf(tallyParams.Denom))
+		}
+		return total
+	case types.StakedTally:
+		if tallyParams.Denom == k.stakingKeeper.BondDenom(ctx) {
+			return k.stakingKeeper.TotalBondedTokens(ctx)
+		}
+		suppliedCoins, _ := k.hardKeeper.GetSuppliedCoins(ctx)
+		return suppliedCoins.AmountOf(tallyParams.Denom)
+	default:
+		return sdk.ZeroInt()
+	}
+}
+
+// getBondedTokens returns the tokens an address has delegated to bonded validators
+func (k Keeper) getBondedTokens(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int {
+	bonded := sdk.ZeroInt()
+	k.stakingKeeper.IterateDelegations(ctx, delegator, func(_ int64, delegation stakingexported.DelegationI) bool {
+		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		if !found || !validator.IsBonded() {
+			return false
+		}
+		bonded = bonded.Add(validator.TokensFromShares(delegation.GetShares()).TruncateInt())
+		return false
+	})
+	return bonded
+}
+
+// hasPermissionsFor returns whether a committee is authorized to enact a proposal.
+// Veto proposals can only be enacted by the guardian of the committee that queued the vetoed proposal.
+func (k Keeper) hasPermissionsFor(ctx sdk.Context, com types.Committee, pubProposal types.PubProposal) bool {
+	if veto, ok := pubProposal.(types.CommitteeVetoProposal); ok {
+		queuedProposal, found := k.GetQueuedProposal(ctx, veto.ProposalID)
+		if !found {
+			return false
+		}
+		vetoedCom, found := k.GetCommittee(ctx, queuedProposal.Proposal.CommitteeID)
+		return found && vetoedCom.GuardianCommitteeID != 0 && vetoedCom.GuardianCommitteeID == com.ID
+	}
+	if com.HasPermissionsFor(ctx, k.cdc, paramHistoryKeeper{k.ParamKeeper, k}, pubProposal) {
+		return true
+	}
+	// bundles not allowed as a whole are allowed if every proposal in them is allowed
+	if bundle, ok := pubProposal.(types.BundleProposal); ok {
+		for _, bundled := range bundle.Proposals {
+			if !k.hasPermissionsFor(ctx, com, bundled) {
+				return false
+			}
+		}
+		return len(bundle.Proposals) > 0
+	}
+	return false
 }
 
 // EnactProposal makes the changes proposed in a proposal.
@@ -114,7 +276,7 @@ func (k Keeper) EnactProposal(ctx sdk.Context, proposal types.Proposal) error {
 	if !found {
 		return sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", proposal.CommitteeID)
 	}
-	if !com.HasPermissionsFor(ctx, k.cdc, k.ParamKeeper, proposal.PubProposal) {
+	if !k.hasPermissionsFor(ctx, com, proposal.PubProposal) {
 		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
 	}
 
@@ -122,6 +284,34 @@ func (k Keeper) EnactProposal(ctx sdk.Context, proposal types.Proposal) error {
 		return err
 	}
 
+	// veto proposals are handled by the keeper as it stores the queued proposals
+	if veto, ok := proposal.PubProposal.(types.CommitteeVetoProposal); ok {
+		return k.VetoQueuedProposal(ctx, veto.ProposalID, com.ID)
+	}
+
+	// proposals that change the committee itself are handled by the keeper as it stores the committees
+	if updatedCom, ok, err := applyToCommittee(com, proposal.PubProposal); ok {
+		if err != nil {
+			return err
+		}
+		k.UpdateCommittee(ctx, com, updatedCom)
+		return nil
+	}
+
+	// enact bundled proposals in order, rolling back all their changes if any of them fail
+	if _, ok := proposal.PubProposal.(types.BundleProposal); ok {
+		cacheCtx, writeCache := ctx.CacheContext()
+		k.RecordParamChanges(cacheCtx, proposal.PubProposal)
+		if err := k.handlePubProposal(cacheCtx, proposal.PubProposal); err != nil {
+			return err
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		return nil
+	}
+
+	k.RecordParamChanges(ctx, proposal.PubProposal)
+
 	// enact the proposal
 	handler := k.router.GetRoute(proposal.ProposalRoute())
 	if err := handler(ctx, proposal.PubProposal); err != nil {
@@ -131,32 +321,157 @@ func (k Keeper) EnactProposal(ctx sdk.Context, proposal types.Proposal) error {
 	return nil
 }
 
-// EnactPassedProposals puts in place the changes proposed in any proposal that has enough votes
+// RecordParamChanges records the values of params before a proposal changes them, so permissions can bound changes over time.
+// It is called for proposals enacted by committees, and for proposals enacted by gov through NewParamChangeProposalHandler.
+func (k Keeper) RecordParamChanges(ctx sdk.Context, pubProposal types.PubProposal) {
+	switch p := pubProposal.(type) {
+	case types.BundleProposal:
+		for _, bundled := range p.Proposals {
+			k.RecordParamChanges(ctx, bundled)
+		}
+	case paramstypes.ParameterChangeProposal:
+		for _, change := range p.Changes {
+			subspace, found := k.ParamKeeper.GetSubspace(change.Subspace)
+			if !found {
+				continue
+			}
+			if previousValue := subspace.GetRaw(ctx, []byte(change.Key)); previousValue != nil {
+				k.RecordParamChange(ctx, change.Subspace, change.Key, previousValue)
+			}
+		}
+	}
+}
+
+// EnactPassedProposals puts in place the changes proposed in any proposal that has enough votes,
+// and closes any proposal that can no longer reach enough votes.
 func (k Keeper) EnactPassedProposals(ctx sdk.Context) {
 	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
-		passes, err := k.GetProposalResult(ctx, proposal.ID)
-		if err != nil {
-			panic(err)
+		com, found := k.GetCommittee(ctx, proposal.CommitteeID)
+		if !found {
+			panic(sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", proposal.CommitteeID))
 		}
-
-		if !passes {
+		tally := k.tallyProposal(ctx, proposal, com)
+
+		passes := proposalPasses(ctx, proposal, com, tally)
+
+		var outcome string
+		var attrs []sdk.Attribute
+		switch {
+		case passes && isDelayed(com, proposal):
+			outcome = types.AttributeValueProposalQueued
+			queuedProposal, err := k.QueueProposal(ctx, proposal)
+			if err != nil {
+				outcome = types.AttributeValueProposalFailed
+			} else {
+				attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEnactmentTime, queuedProposal.EnactmentTime.String()))
+			}
+		case passes:
+			outcome = types.AttributeValueProposalPassed
+			if err := k.EnactProposal(ctx, proposal); err != nil {
+				outcome = types.AttributeValueProposalFailed
+			}
+		case !proposalCanPass(com, tally):
+			// the remaining voters can't reach the threshold
+			outcome = types.AttributeValueProposalFailed
+		default:
 			// continue to next proposal
 			return false
 		}
 
-		err = k.EnactProposal(ctx, proposal)
-		outcome := types.AttributeValueProposalPassed
-		if err != nil {
+		k.DeleteProposalAndVotes(ctx, proposal.ID)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProposalClose,
+				append([]sdk.Attribute{
+					sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
+					sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+					sdk.NewAttribute(types.AttributeKeyProposalCloseStatus, outcome),
+				}, attrs...)...,
+			),
+		)
+		return false
+	})
+}
+
+// UpdateCommittee stores a committee changed by one of its own proposals.
+// Votes on the committee's open proposals from removed members are deleted so they are no longer counted.
+func (k Keeper) UpdateCommittee(ctx sdk.Context, com, updatedCom types.Committee) {
+	if !com.IsTokenCommittee() {
+		for _, m := range com.Members {
+			if updatedCom.HasMember(m) {
+				continue
+			}
+			for _, pr := range k.GetProposalsByCommittee(ctx, com.ID) {
+				k.DeleteVote(ctx, pr.ID, m)
+			}
+		}
+	}
+	k.SetCommittee(ctx, updatedCom)
+}
+
+// applyToCommittee returns the committee with the changes from a proposal that changes the committee itself.
+// It returns false if the proposal does not change the committee.
+func applyToCommittee(com types.Committee, pubProposal types.PubProposal) (types.Committee, bool, error) {
+	switch p := pubProposal.(type) {
+	case types.MembersChangeProposal:
+		updatedCom, err := p.Apply(com)
+		return updatedCom, true, err
+	case types.VotingRulesChangeProposal:
+		updatedCom, err := p.Apply(com)
+		return updatedCom, true, err
+	default:
+		return com, false, nil
+	}
+}
+
+// isDelayed returns whether a passed proposal must wait for its committee's enactment delay before being enacted.
+// Veto proposals are never delayed so they can take effect before the proposal they veto is enacted.
+func isDelayed(com types.Committee, proposal types.Proposal) bool {
+	if _, ok := proposal.PubProposal.(types.CommitteeVetoProposal); ok {
+		return false
+	}
+	return com.EnactmentDelay > 0
+}
+
+// QueueProposal stores a passed proposal until its committee's enactment delay has elapsed.
+// The proposal is checked to be enactable now so that proposals that can never be enacted are not queued.
+func (k Keeper) QueueProposal(ctx sdk.Context, proposal types.Proposal) (types.QueuedProposal, error) {
+	com, found := k.GetCommittee(ctx, proposal.CommitteeID)
+	if !found {
+		return types.QueuedProposal{}, sdkerrors.Wrapf(types.ErrUnknownCommittee, "%d", proposal.CommitteeID)
+	}
+	if !k.hasPermissionsFor(ctx, com, proposal.PubProposal) {
+		return types.QueuedProposal{}, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "committee does not have permissions to enact proposal")
+	}
+	if err := k.ValidatePubProposal(ctx, proposal.PubProposal); err != nil {
+		return types.QueuedProposal{}, err
+	}
+
+	queuedProposal := types.NewQueuedProposal(proposal, ctx.BlockTime().Add(com.EnactmentDelay))
+	k.SetQueuedProposal(ctx, queuedProposal)
+	return queuedProposal, nil
+}
+
+// EnactQueuedProposals puts in place the changes proposed in any queued proposal whose enactment delay has elapsed.
+func (k Keeper) EnactQueuedProposals(ctx sdk.Context) {
+	k.IterateQueuedProposals(ctx, func(queuedProposal types.QueuedProposal) bool {
+		if !queuedProposal.IsEnactableBy(ctx.BlockTime()) {
+			return false
+		}
+
+		outcome := types.AttributeValueProposalEnacted
+		if err := k.EnactProposal(ctx, queuedProposal.Proposal); err != nil {
 			outcome = types.AttributeValueProposalFailed
 		}
 
-		k.DeleteProposalAndVotes(ctx, proposal.ID)
+		k.DeleteQueuedProposal(ctx, queuedProposal.Proposal.ID)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
-				types.EventTypeProposalClose,
-				sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", proposal.CommitteeID)),
-				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposal.ID)),
+				types.EventTypeProposalEnact,
+				sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", queuedProposal.Proposal.CommitteeID)),
+				sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", queuedProposal.Proposal.ID)),
 				sdk.NewAttribute(types.AttributeKeyProposalCloseStatus, outcome),
 			),
 		)
@@ -164,6 +479,26 @@ func (k Keeper) EnactPassedProposals(ctx sdk.Context) {
 	})
 }
 
+// VetoQueuedProposal removes a queued proposal so that it is never enacted.
+func (k Keeper) VetoQueuedProposal(ctx sdk.Context, proposalID uint64, guardianCommitteeID uint64) error {
+	queuedProposal, found := k.GetQueuedProposal(ctx, proposalID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrUnknownQueuedProposal, "%d", proposalID)
+	}
+
+	k.DeleteQueuedProposal(ctx, proposalID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProposalVeto,
+			sdk.NewAttribute(types.AttributeKeyCommitteeID, fmt.Sprintf("%d", queuedProposal.Proposal.CommitteeID)),
+			sdk.NewAttribute(types.AttributeKeyProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeKeyGuardianCommitteeID, fmt.Sprintf("%d", guardianCommitteeID)),
+		),
+	)
+	return nil
+}
+
 // CloseExpiredProposals removes proposals (and associated votes) that have past their deadline.
 func (k Keeper) CloseExpiredProposals(ctx sdk.Context) {
 	k.IterateProposals(ctx, func(proposal types.Proposal) bool {
@@ -186,7 +521,7 @@ func (k Keeper) CloseExpiredProposals(ctx sdk.Context) {
 }
 
 // ValidatePubProposal checks if a pubproposal is valid.
-func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubProposal) (returnErr error) {
+func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubProposal) error {
 	if pubProposal == nil {
 		return sdkerrors.Wrap(types.ErrInvalidPubProposal, "pub proposal cannot be nil")
 	}
@@ -194,12 +529,40 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 		return err
 	}
 
-	if !k.router.HasRoute(pubProposal.ProposalRoute()) {
-		return sdkerrors.Wrapf(types.ErrNoProposalHandlerExists, "%T", pubProposal)
+	// veto proposals have no handler, they are valid as long as the proposal they veto is still queued
+	if veto, ok := pubProposal.(types.CommitteeVetoProposal); ok {
+		if _, found := k.GetQueuedProposal(ctx, veto.ProposalID); !found {
+			return sdkerrors.Wrapf(types.ErrUnknownQueuedProposal, "%d", veto.ProposalID)
+		}
+		return nil
+	}
+
+	// proposals that change the committee itself have no handler, they are validated against the committee when submitted and enacted
+	switch pubProposal.(type) {
+	case types.MembersChangeProposal, types.VotingRulesChangeProposal:
+		return nil
 	}
 
 	// Run the proposal's changes through the associated handler using a cached version of state to ensure changes are not permanent.
 	cacheCtx, _ := ctx.CacheContext()
+	return k.handlePubProposal(cacheCtx, pubProposal)
+}
+
+// handlePubProposal runs a proposal's changes through the associated handler.
+// Bundled proposals are handled in order, so each one sees the changes made by the ones before it.
+func (k Keeper) handlePubProposal(ctx sdk.Context, pubProposal types.PubProposal) (returnErr error) {
+	if bundle, ok := pubProposal.(types.BundleProposal); ok {
+		for i, bundled := range bundle.Proposals {
+			if err := k.handlePubProposal(ctx, bundled); err != nil {
+				return sdkerrors.Wrapf(err, "bundled proposal %d", i)
+			}
+		}
+		return nil
+	}
+
+	if !k.router.HasRoute(pubProposal.ProposalRoute()) {
+		return sdkerrors.Wrapf(types.ErrNoProposalHandlerExists, "%T", pubProposal)
+	}
 	handler := k.router.GetRoute(pubProposal.ProposalRoute())
 
 	// Handle an edge case where a param change proposal causes the proposal handler to panic.
@@ -212,7 +575,7 @@ func (k Keeper) ValidatePubProposal(ctx sdk.Context, pubProposal types.PubPropos
 		}
 	}()
 
-	if err := handler(cacheCtx, pubProposal); err != nil {
+	if err := handler(ctx, pubProposal); err != nil {
 		return err
 	}
 	return nil
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"reflect"
 	"time"
 
@@ -10,15 +11,20 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 
 	"github.com/kava-labs/kava/app"
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/committee"
 	"github.com/kava-labs/kava/x/committee/types"
+	"github.com/kava-labs/kava/x/kavadist"
 	"github.com/kava-labs/kava/x/pricefeed"
 )
 
+// oracle is the address that posts prices in the pricefeed genesis states used by tests
+var oracle = sdk.AccAddress(crypto.AddressHash([]byte("oracle")))
+
 func newCDPGenesisState(params cdptypes.Params) app.GenesisState {
 	genesis := cdptypes.DefaultGenesisState()
 	genesis.Params = params
@@ -41,13 +47,13 @@ func newPricefeedGenState(assets []string, prices []sdk.Dec) app.GenesisState {
 		pfGenesis.Params.Markets = append(
 			pfGenesis.Params.Markets,
 			pricefeed.Market{
-				MarketID: assets[i] + ":usd", BaseAsset: assets[i], QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true,
+				MarketID: assets[i] + ":usd", BaseAsset: assets[i], QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true,
 			})
 		pfGenesis.PostedPrices = append(
 			pfGenesis.PostedPrices,
 			pricefeed.PostedPrice{
 				MarketID:      assets[i] + ":usd",
-				OracleAddress: sdk.AccAddress{},
+				OracleAddress: oracle,
 				Price:         prices[i],
 				Expiry:        time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC),
 			})
@@ -241,6 +247,224 @@ func (suite *KeeperTestSuite) TestSubmitProposal() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestSubmitProposal_ParamBoundWindow() {
+	testCP := cdptypes.CollateralParams{{
+		Denom:               "bnb",
+		Type:                "bnb-a",
+		LiquidationRatio:    d("1.5"),
+		DebtLimit:           c("usdx", 1000000000000),
+		StabilityFee:        d("1.000000001547125958"), // %5 apr
+		LiquidationPenalty:  d("0.05"),
+		AuctionSize:         i(100),
+		Prefix:              0x20,
+		ConversionFactor:    i(6),
+		LiquidationMarketID: "bnb:usd",
+		SpotMarketID:        "bnb:usd",
+	}}
+	testCDPParams := cdptypes.DefaultParams()
+	testCDPParams.CollateralParams = testCP
+	testCDPParams.GlobalDebtLimit = testCP[0].DebtLimit
+
+	com := types.Committee{
+		ID:          12,
+		Description: "This committee is for testing.",
+		Members:     suite.addresses[:2],
+		Permissions: []types.Permission{
+			types.SubParamChangePermission{
+				AllowedParams: types.AllowedParams{
+					{Subspace: cdptypes.ModuleName, Key: string(cdptypes.KeyCollateralParams)},
+				},
+				AllowedCollateralParams: types.AllowedCollateralParams{{
+					Type:      "bnb-a",
+					DebtLimit: true,
+					Bounds:    types.ParamBounds{types.NewParamBound("debt_limit", nil, nil, dp("0.1"), 24*time.Hour)},
+				}},
+			},
+		},
+		VoteThreshold:    d("0.667"),
+		ProposalDuration: time.Hour * 24 * 7,
+	}
+
+	newDebtLimitProposal := func(amount int64) types.PubProposal {
+		cps := make(cdptypes.CollateralParams, len(testCP))
+		copy(cps, testCP)
+		cps[0].DebtLimit = c("usdx", amount)
+		return params.NewParameterChangeProposal(
+			"A Title",
+			"A description of this proposal.",
+			[]params.ParamChange{{
+				Subspace: cdptypes.ModuleName,
+				Key:      string(cdptypes.KeyCollateralParams),
+				Value:    string(suite.app.Codec().MustMarshalJSON(cps)),
+			}},
+		)
+	}
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	startTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Time: startTime})
+	tApp.InitializeFromGenesisStates(
+		newPricefeedGenState([]string{"bnb"}, []sdk.Dec{d("15.01")}),
+		newCDPGenesisState(testCDPParams),
+	)
+	keeper.SetCommittee(ctx, com)
+
+	// a change within the window limit can be enacted
+	id, err := keeper.SubmitProposal(ctx, com.Members[0], com.ID, newDebtLimitProposal(920000000000))
+	suite.Require().NoError(err)
+	pr, found := keeper.GetProposal(ctx, id)
+	suite.Require().True(found)
+	suite.Require().NoError(keeper.EnactProposal(ctx, pr))
+
+	_, found = keeper.GetParamValueAt(ctx, cdptypes.ModuleName, string(cdptypes.KeyCollateralParams), startTime)
+	suite.True(found)
+
+	// a further change is within the limit for one proposal, but not within the window
+	ctx = ctx.WithBlockTime(startTime.Add(12 * time.Hour))
+	_, err = keeper.SubmitProposal(ctx, com.Members[0], com.ID, newDebtLimitProposal(850000000000))
+	suite.Error(err)
+
+	// once the window has passed the change is measured from the new value
+	ctx = ctx.WithBlockTime(startTime.Add(25 * time.Hour))
+	_, err = keeper.SubmitProposal(ctx, com.Members[0], com.ID, newDebtLimitProposal(850000000000))
+	suite.NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestEnactProposal_CommitteeSelfChange() {
+	com := types.Committee{
+		ID:          12,
+		Description: "This committee is for testing.",
+		Members:     suite.addresses[:3],
+		Permissions: []types.Permission{
+			types.TextPermission{},
+			types.NewMembersChangePermission(2, 3),
+			types.NewVotingRulesChangePermission(d("0.5"), d("0.8"), time.Hour, time.Hour*24*7),
+		},
+		VoteThreshold:    d("0.667"),
+		ProposalDuration: time.Hour * 24 * 7,
+	}
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, abci.Header{})
+	tApp.InitializeFromGenesisStates()
+	keeper.SetCommittee(ctx, com)
+
+	// an open proposal with a vote from the member that will be removed
+	textID, err := keeper.SubmitProposal(ctx, com.Members[0], com.ID, gov.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.AddVote(ctx, textID, com.Members[2], types.Yes))
+
+	// proposals that would leave the committee invalid are rejected when submitted
+	_, err = keeper.SubmitProposal(ctx, com.Members[0], com.ID, types.NewMembersChangeProposal("A Title", "A description of this proposal.", nil, suite.addresses[3:]))
+	suite.Error(err)
+	_, err = keeper.SubmitProposal(ctx, com.Members[0], com.ID, types.NewVotingRulesChangeProposal("A Title", "A description of this proposal.", d("0.9"), time.Hour))
+	suite.Error(err)
+	// proposals that would take the member count outside the permission's bounds are rejected when submitted
+	_, err = keeper.SubmitProposal(ctx, com.Members[0], com.ID, types.NewMembersChangeProposal("A Title", "A description of this proposal.", suite.addresses[3:4], nil))
+	suite.Error(err)
+	_, err = keeper.SubmitProposal(ctx, com.Members[0], com.ID, types.NewMembersChangeProposal("A Title", "A description of this proposal.", nil, suite.addresses[1:3]))
+	suite.Error(err)
+
+	// change members
+	membersID, err := keeper.SubmitProposal(ctx, com.Members[0], com.ID, types.NewMembersChangeProposal("A Title", "A description of this proposal.", suite.addresses[3:4], suite.addresses[2:3]))
+	suite.Require().NoError(err)
+	pr, found := keeper.GetProposal(ctx, membersID)
+	suite.Require().True(found)
+	suite.Require().NoError(keeper.EnactProposal(ctx, pr))
+
+	updatedCom, found := keeper.GetCommittee(ctx, com.ID)
+	suite.Require().True(found)
+	suite.Equal([]sdk.AccAddress{suite.addresses[0], suite.addresses[1], suite.addresses[3]}, updatedCom.Members)
+	_, found = keeper.GetVote(ctx, textID, com.Members[2])
+	suite.False(found)
+
+	// change voting rules
+	rulesID, err := keeper.SubmitProposal(ctx, com.Members[0], com.ID, types.NewVotingRulesChangeProposal("A Title", "A description of this proposal.", d("0.75"), time.Hour*24))
+	suite.Require().NoError(err)
+	pr, found = keeper.GetProposal(ctx, rulesID)
+	suite.Require().True(found)
+	suite.Require().NoError(keeper.EnactProposal(ctx, pr))
+
+	updatedCom, found = keeper.GetCommittee(ctx, com.ID)
+	suite.Require().True(found)
+	suite.Equal(d("0.75"), updatedCom.VoteThreshold)
+	suite.Equal(time.Hour*24, updatedCom.ProposalDuration)
+	suite.Equal(com.Permissions, updatedCom.Permissions)
+}
+
+func (suite *KeeperTestSuite) TestEnactProposal_Bundle() {
+	com := types.Committee{
+		ID:          12,
+		Description: "This committee is for testing.",
+		Members:     suite.addresses[:2],
+		Permissions: []types.Permission{
+			types.TextPermission{},
+			types.SimpleParamChangePermission{
+				AllowedParams: types.AllowedParams{
+					{Subspace: cdptypes.ModuleName, Key: string(cdptypes.KeyCircuitBreaker)},
+					{Subspace: cdptypes.ModuleName, Key: string(cdptypes.KeyDebtThreshold)},
+				},
+			},
+		},
+		VoteThreshold:    d("0.667"),
+		ProposalDuration: time.Hour * 24 * 7,
+	}
+	newParamChangeProposal := func(key []byte, value string) types.PubProposal {
+		return params.NewParameterChangeProposal(
+			"A Title",
+			"A description of this proposal.",
+			[]params.ParamChange{{Subspace: cdptypes.ModuleName, Key: string(key), Value: value}},
+		)
+	}
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	cdpKeeper := tApp.GetCDPKeeper()
+	ctx := tApp.NewContext(true, abci.Header{})
+	tApp.InitializeFromGenesisStates()
+	keeper.SetCommittee(ctx, com)
+
+	// bundles are rejected if any of their proposals are not allowed
+	_, err := keeper.SubmitProposal(ctx, com.Members[0], com.ID, types.NewBundleProposal("A Title", "A description of this proposal.", []types.PubProposal{
+		gov.NewTextProposal("A Title", "A description of this proposal."),
+		newParamChangeProposal(cdptypes.KeySurplusThreshold, `"1000"`),
+	}))
+	suite.Error(err)
+
+	// bundles are rejected if any of their proposals are invalid
+	invalidBundle := types.NewBundleProposal("A Title", "A description of this proposal.", []types.PubProposal{
+		newParamChangeProposal(cdptypes.KeyCircuitBreaker, "true"),
+		newParamChangeProposal(cdptypes.KeyDebtThreshold, `"not an int"`),
+	})
+	_, err = keeper.SubmitProposal(ctx, com.Members[0], com.ID, invalidBundle)
+	suite.Error(err)
+
+	// none of the changes in a bundle are kept if any of them fail
+	id, err := keeper.StoreNewProposal(ctx, invalidBundle, com.ID, ctx.BlockTime().Add(com.ProposalDuration))
+	suite.Require().NoError(err)
+	pr, found := keeper.GetProposal(ctx, id)
+	suite.Require().True(found)
+	suite.Error(keeper.EnactProposal(ctx, pr))
+	suite.False(cdpKeeper.GetParams(ctx).CircuitBreaker)
+
+	// bundles are allowed if each of their proposals is allowed by a permission
+	id, err = keeper.SubmitProposal(ctx, com.Members[0], com.ID, types.NewBundleProposal("A Title", "A description of this proposal.", []types.PubProposal{
+		gov.NewTextProposal("A Title", "A description of this proposal."),
+		newParamChangeProposal(cdptypes.KeyCircuitBreaker, "true"),
+		newParamChangeProposal(cdptypes.KeyDebtThreshold, `"1000"`),
+	}))
+	suite.Require().NoError(err)
+	pr, found = keeper.GetProposal(ctx, id)
+	suite.Require().True(found)
+	suite.Require().NoError(keeper.EnactProposal(ctx, pr))
+
+	cdpParams := cdpKeeper.GetParams(ctx)
+	suite.True(cdpParams.CircuitBreaker)
+	suite.Equal(i(1000), cdpParams.DebtAuctionThreshold)
+}
+
 func (suite *KeeperTestSuite) TestAddVote() {
 	normalCom := types.Committee{
 		ID:          12,
@@ -253,6 +477,7 @@ func (suite *KeeperTestSuite) TestAddVote() {
 		name       string
 		proposalID uint64
 		voter      sdk.AccAddress
+		voteType   types.VoteType
 		voteTime   time.Time
 		expectErr  bool
 	}{
@@ -260,24 +485,49 @@ func (suite *KeeperTestSuite) TestAddVote() {
 			name:       "normal",
 			proposalID: types.DefaultNextProposalID,
 			voter:      normalCom.Members[0],
+			voteType:   types.Yes,
 			expectErr:  false,
 		},
+		{
+			name:       "no vote",
+			proposalID: types.DefaultNextProposalID,
+			voter:      normalCom.Members[0],
+			voteType:   types.No,
+			expectErr:  false,
+		},
+		{
+			name:       "abstain vote",
+			proposalID: types.DefaultNextProposalID,
+			voter:      normalCom.Members[0],
+			voteType:   types.Abstain,
+			expectErr:  false,
+		},
+		{
+			name:       "invalid vote type",
+			proposalID: types.DefaultNextProposalID,
+			voter:      normalCom.Members[0],
+			voteType:   types.NullVoteType,
+			expectErr:  true,
+		},
 		{
 			name:       "nonexistent proposal",
 			proposalID: 9999999,
 			voter:      normalCom.Members[0],
+			voteType:   types.Yes,
 			expectErr:  true,
 		},
 		{
 			name:       "voter not committee member",
 			proposalID: types.DefaultNextProposalID,
 			voter:      suite.addresses[4],
+			voteType:   types.Yes,
 			expectErr:  true,
 		},
 		{
 			name:       "proposal expired",
 			proposalID: types.DefaultNextProposalID,
 			voter:      normalCom.Members[0],
+			voteType:   types.Yes,
 			voteTime:   firstBlockTime.Add(normalCom.ProposalDuration),
 			expectErr:  true,
 		},
@@ -297,14 +547,15 @@ func (suite *KeeperTestSuite) TestAddVote() {
 			suite.NoError(err)
 
 			ctx = ctx.WithBlockTime(tc.voteTime)
-			err = keeper.AddVote(ctx, tc.proposalID, tc.voter)
+			err = keeper.AddVote(ctx, tc.proposalID, tc.voter, tc.voteType)
 
 			if tc.expectErr {
 				suite.NotNil(err)
 			} else {
 				suite.NoError(err)
-				_, found := keeper.GetVote(ctx, tc.proposalID, tc.voter)
+				vote, found := keeper.GetVote(ctx, tc.proposalID, tc.voter)
 				suite.True(found)
+				suite.Equal(tc.voteType, vote.VoteType)
 			}
 		})
 	}
@@ -333,10 +584,10 @@ func (suite *KeeperTestSuite) TestGetProposalResult() {
 			name:      "enough votes",
 			committee: normalCom,
 			votes: []types.Vote{
-				{ProposalID: defaultID, Voter: suite.addresses[0]},
-				{ProposalID: defaultID, Voter: suite.addresses[1]},
-				{ProposalID: defaultID, Voter: suite.addresses[2]},
-				{ProposalID: defaultID, Voter: suite.addresses[3]},
+				{ProposalID: defaultID, Voter: suite.addresses[0], VoteType: types.Yes, Weight: sdk.OneInt()},
+				{ProposalID: defaultID, Voter: suite.addresses[1], VoteType: types.Yes, Weight: sdk.OneInt()},
+				{ProposalID: defaultID, Voter: suite.addresses[2], VoteType: types.Yes, Weight: sdk.OneInt()},
+				{ProposalID: defaultID, Voter: suite.addresses[3], VoteType: types.Yes, Weight: sdk.OneInt()},
 			},
 			proposalPasses: true,
 			expectErr:      false,
@@ -345,7 +596,19 @@ func (suite *KeeperTestSuite) TestGetProposalResult() {
 			name:      "not enough votes",
 			committee: normalCom,
 			votes: []types.Vote{
-				{ProposalID: defaultID, Voter: suite.addresses[0]},
+				{ProposalID: defaultID, Voter: suite.addresses[0], VoteType: types.Yes, Weight: sdk.OneInt()},
+			},
+			proposalPasses: false,
+			expectErr:      false,
+		},
+		{
+			name:      "no and abstain votes not counted",
+			committee: normalCom,
+			votes: []types.Vote{
+				{ProposalID: defaultID, Voter: suite.addresses[0], VoteType: types.Yes, Weight: sdk.OneInt()},
+				{ProposalID: defaultID, Voter: suite.addresses[1], VoteType: types.Yes, Weight: sdk.OneInt()},
+				{ProposalID: defaultID, Voter: suite.addresses[2], VoteType: types.No, Weight: sdk.OneInt()},
+				{ProposalID: defaultID, Voter: suite.addresses[3], VoteType: types.Abstain, Weight: sdk.OneInt()},
 			},
 			proposalPasses: false,
 			expectErr:      false,
@@ -385,12 +648,171 @@ func (suite *KeeperTestSuite) TestGetProposalResult() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGetTokenCommitteeProposalResult() {
+	tallyParams := types.NewTallyParams(types.BalanceTally, "hard", d("0.2"))
+	tokenCom := types.Committee{
+		ID:               12,
+		Description:      "This committee is for testing.",
+		Members:          suite.addresses[:1],
+		Permissions:      []types.Permission{types.GodPermission{}},
+		VoteThreshold:    d("0.5"),
+		ProposalDuration: time.Hour * 24 * 7,
+		TallyParams:      &tallyParams,
+	}
+	firstBlockTime := time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)
+
+	type vote struct {
+		voter    sdk.AccAddress
+		voteType types.VoteType
+	}
+	testcases := []struct {
+		name           string
+		votes          []vote
+		blockTime      time.Time
+		proposalPasses bool
+	}{
+		{
+			name:           "enough weight",
+			votes:          []vote{{suite.addresses[0], types.Yes}, {suite.addresses[1], types.Yes}},
+			blockTime:      firstBlockTime,
+			proposalPasses: true,
+		},
+		{
+			name:           "not enough weight",
+			votes:          []vote{{suite.addresses[2], types.Yes}, {suite.addresses[3], types.Yes}},
+			blockTime:      firstBlockTime,
+			proposalPasses: false,
+		},
+		{
+			name:           "quorum reached at deadline",
+			votes:          []vote{{suite.addresses[2], types.Yes}, {suite.addresses[3], types.Yes}},
+			blockTime:      firstBlockTime.Add(tokenCom.ProposalDuration),
+			proposalPasses: true,
+		},
+		{
+			name:           "quorum not reached at deadline",
+			votes:          []vote{{suite.addresses[3], types.Yes}},
+			blockTime:      firstBlockTime.Add(tokenCom.ProposalDuration),
+			proposalPasses: false,
+		},
+		{
+			name:           "abstain votes count towards quorum",
+			votes:          []vote{{suite.addresses[3], types.Yes}, {suite.addresses[2], types.Abstain}},
+			blockTime:      firstBlockTime.Add(tokenCom.ProposalDuration),
+			proposalPasses: true,
+		},
+		{
+			name:           "no votes win at deadline",
+			votes:          []vote{{suite.addresses[2], types.Yes}, {suite.addresses[3], types.Yes}, {suite.addresses[0], types.No}, {suite.addresses[1], types.No}},
+			blockTime:      firstBlockTime.Add(tokenCom.ProposalDuration),
+			proposalPasses: false,
+		},
+		{
+			name:           "tied yes and no votes at deadline",
+			votes:          []vote{{suite.addresses[1], types.Yes}, {suite.addresses[2], types.No}, {suite.addresses[3], types.No}},
+			blockTime:      firstBlockTime.Add(tokenCom.ProposalDuration),
+			proposalPasses: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			// Create local testApp because suite doesn't run the SetupTest function for subtests
+			tApp := app.NewTestApp()
+			keeper := tApp.GetCommitteeKeeper()
+			ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: firstBlockTime})
+
+			// voting weight is split 40%, 30%, 20%, 10% between the first four addresses
+			tApp.InitializeFromGenesisStates(
+				app.NewAuthGenState(suite.addresses[:4], []sdk.Coins{
+					cs(c("hard", 4000)), cs(c("hard", 3000)), cs(c("hard", 2000)), cs(c("hard", 1000)),
+				}),
+			)
+			keeper.SetCommittee(ctx, tokenCom)
+
+			proposalID, err := keeper.SubmitProposal(ctx, tokenCom.Members[0], tokenCom.ID, gov.NewTextProposal("A Title", "A description of this proposal."))
+			suite.Require().NoError(err)
+			proposal, found := keeper.GetProposal(ctx, proposalID)
+			suite.Require().True(found)
+			suite.Equal(i(10000), proposal.TallySnapshot.TotalWeight)
+
+			// addresses without voting weight cannot vote
+			err = keeper.AddVote(ctx, proposalID, suite.addresses[4], types.Yes)
+			suite.Require().True(errors.Is(err, types.ErrNoVotingWeight))
+
+			for _, v := range tc.votes {
+				suite.Require().NoError(keeper.AddVote(ctx, proposalID, v.voter, v.voteType))
+			}
+
+			proposalPasses, err := keeper.GetProposalResult(ctx.WithBlockTime(tc.blockTime), proposalID)
+			suite.Require().NoError(err)
+			suite.Equal(tc.proposalPasses, proposalPasses)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTokenCommitteeVoteWeights() {
+	tallyParams := types.NewTallyParams(types.BalanceTally, "hard", d("0.2"))
+	tokenCom := types.Committee{
+		ID:               12,
+		Description:      "This committee is for testing.",
+		Members:          suite.addresses[:1],
+		Permissions:      []types.Permission{types.GodPermission{}},
+		VoteThreshold:    d("0.5"),
+		ProposalDuration: time.Hour * 24 * 7,
+		TallyParams:      &tallyParams,
+	}
+
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Date(1998, time.January, 1, 1, 0, 0, 0, time.UTC)})
+	tApp.InitializeFromGenesisStates(
+		app.NewAuthGenState(suite.addresses[:4], []sdk.Coins{
+			cs(c("hard", 4000)), cs(c("hard", 3000)), cs(c("hard", 2000)), cs(c("hard", 1000)),
+		}),
+	)
+	keeper.SetCommittee(ctx, tokenCom)
+
+	// coins held by module accounts are not counted in the total weight
+	suite.Require().NoError(tApp.GetSupplyKeeper().MintCoins(ctx, kavadist.ModuleName, cs(c("hard", 5000))))
+
+	proposalID, err := keeper.SubmitProposal(ctx, tokenCom.Members[0], tokenCom.ID, gov.NewTextProposal("A Title", "A description of this proposal."))
+	suite.Require().NoError(err)
+	proposal, found := keeper.GetProposal(ctx, proposalID)
+	suite.Require().True(found)
+	suite.Equal(i(10000), proposal.TallySnapshot.TotalWeight)
+
+	// votes record the voter's weight when they are cast
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.addresses[0], types.Yes))
+	vote, found := keeper.GetVote(ctx, proposalID, suite.addresses[0])
+	suite.Require().True(found)
+	suite.Equal(i(4000), vote.Weight)
+
+	// tokens that voted and then moved to another voter are only counted once
+	bankKeeper := tApp.GetBankKeeper()
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, suite.addresses[0], suite.addresses[1], cs(c("hard", 4000))))
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.addresses[1], types.Yes))
+	tally, err := keeper.GetProposalTally(ctx, proposalID)
+	suite.Require().NoError(err)
+	suite.Equal(i(7000), tally.YesVotes)
+
+	// tokens received after voting do not add to the vote
+	suite.Require().NoError(keeper.AddVote(ctx, proposalID, suite.addresses[2], types.No))
+	suite.Require().NoError(bankKeeper.SendCoins(ctx, suite.addresses[3], suite.addresses[2], cs(c("hard", 1000))))
+	tally, err = keeper.GetProposalTally(ctx, proposalID)
+	suite.Require().NoError(err)
+	suite.Equal(i(7000), tally.YesVotes)
+	suite.Equal(i(2000), tally.NoVotes)
+}
+
 func committeeGenState(cdc *codec.Codec, committees []types.Committee, proposals []types.Proposal, votes []types.Vote) app.GenesisState {
 	gs := types.NewGenesisState(
 		uint64(len(proposals)+1),
 		committees,
 		proposals,
 		votes,
+		[]types.QueuedProposal{},
+		[]types.ParamChangeRecord{},
 	)
 	return app.GenesisState{committee.ModuleName: cdc.MustMarshalJSON(gs)}
 }
@@ -535,10 +957,12 @@ func (suite *KeeperTestSuite) TestCloseExpiredProposals() {
 			},
 		},
 		[]types.Vote{
-			{ProposalID: 1, Voter: suite.addresses[0]},
-			{ProposalID: 1, Voter: suite.addresses[1]},
-			{ProposalID: 2, Voter: suite.addresses[2]},
+			{ProposalID: 1, Voter: suite.addresses[0], VoteType: types.Yes, Weight: sdk.OneInt()},
+			{ProposalID: 1, Voter: suite.addresses[1], VoteType: types.Yes, Weight: sdk.OneInt()},
+			{ProposalID: 2, Voter: suite.addresses[2], VoteType: types.Yes, Weight: sdk.OneInt()},
 		},
+		[]types.QueuedProposal{},
+		[]types.ParamChangeRecord{},
 	)
 	suite.app.InitializeFromGenesisStates(
 		NewCommitteeGenesisState(suite.app.Codec(), testGenesis),
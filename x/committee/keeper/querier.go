@@ -32,6 +32,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryNextProposalID(ctx, req, keeper)
 		case types.QueryRawParams:
 			return queryRawParams(ctx, path[1:], req, keeper)
+		case types.QueryQueuedProposals:
+			return queryQueuedProposals(ctx, path[1:], req, keeper)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
@@ -112,6 +114,25 @@ func queryProposal(ctx sdk.Context, path []string, req abci.RequestQuery, keeper
 	return bz, nil
 }
 
+func queryQueuedProposals(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryQueuedProposalsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	queuedProposals := keeper.GetQueuedProposals(ctx)
+	if params.CommitteeID != 0 {
+		queuedProposals = keeper.GetQueuedProposalsByCommittee(ctx, params.CommitteeID)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, queuedProposals)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 func queryNextProposalID(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
 	nextProposalID, _ := keeper.GetNextProposalID(ctx)
 
@@ -173,13 +194,12 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
 	}
 
-	_, found := keeper.GetProposal(ctx, params.ProposalID)
-	if !found {
-		return nil, sdkerrors.Wrapf(types.ErrUnknownProposal, "%d", params.ProposalID)
+	tally, err := keeper.GetProposalTally(ctx, params.ProposalID)
+	if err != nil {
+		return nil, err
 	}
-	numVotes := keeper.TallyVotes(ctx, params.ProposalID)
 
-	bz, err := codec.MarshalJSONIndent(keeper.cdc, numVotes)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, tally)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
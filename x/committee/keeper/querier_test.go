@@ -49,7 +49,7 @@ func (suite *QuerierTestSuite) SetupTest() {
 
 	_, suite.addresses = app.GeneratePrivKeyAddressPairs(5)
 	suite.testGenesis = types.NewGenesisState(
-		3,
+		5,
 		[]types.Committee{
 			{
 				ID:               1,
@@ -72,10 +72,21 @@ func (suite *QuerierTestSuite) SetupTest() {
 			{ID: 2, CommitteeID: 1, PubProposal: gov.NewTextProposal("Another Title", "A description of this other proposal."), Deadline: testTime.Add(21 * 24 * time.Hour)},
 		},
 		[]types.Vote{
-			{ProposalID: 1, Voter: suite.addresses[0]},
-			{ProposalID: 1, Voter: suite.addresses[1]},
-			{ProposalID: 2, Voter: suite.addresses[2]},
+			{ProposalID: 1, Voter: suite.addresses[0], VoteType: types.Yes, Weight: sdk.OneInt()},
+			{ProposalID: 1, Voter: suite.addresses[1], VoteType: types.No, Weight: sdk.OneInt()},
+			{ProposalID: 2, Voter: suite.addresses[2], VoteType: types.Yes, Weight: sdk.OneInt()},
 		},
+		[]types.QueuedProposal{
+			{
+				Proposal:      types.Proposal{ID: 3, CommitteeID: 1, PubProposal: gov.NewTextProposal("A Queued Title", "A description of this queued proposal."), Deadline: testTime.Add(7 * 24 * time.Hour)},
+				EnactmentTime: testTime.Add(8 * 24 * time.Hour),
+			},
+			{
+				Proposal:      types.Proposal{ID: 4, CommitteeID: 2, PubProposal: gov.NewTextProposal("Another Queued Title", "A description of this other queued proposal."), Deadline: testTime.Add(7 * 24 * time.Hour)},
+				EnactmentTime: testTime.Add(8 * 24 * time.Hour),
+			},
+		},
+		[]types.ParamChangeRecord{},
 	)
 	suite.app.InitializeFromGenesisStates(
 		NewCommitteeGenesisState(suite.cdc, suite.testGenesis),
@@ -153,6 +164,54 @@ func (suite *QuerierTestSuite) TestQueryProposals() {
 	suite.Equal(expectedProposals, proposals)
 }
 
+func (suite *QuerierTestSuite) TestQueryQueuedProposals() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+
+	testCases := []struct {
+		name        string
+		committeeID uint64
+		expected    []types.QueuedProposal
+	}{
+		{
+			name:        "all committees",
+			committeeID: 0,
+			expected:    suite.testGenesis.QueuedProposals,
+		},
+		{
+			name:        "one committee",
+			committeeID: 2,
+			expected:    suite.testGenesis.QueuedProposals[1:],
+		},
+		{
+			name:        "committee without queued proposals",
+			committeeID: 57,
+			expected:    []types.QueuedProposal{},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// Set up request query
+			query := abci.RequestQuery{
+				Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryQueuedProposals}, "/"),
+				Data: suite.cdc.MustMarshalJSON(types.NewQueryQueuedProposalsParams(tc.committeeID)),
+			}
+
+			// Execute query and check the []byte result
+			bz, err := suite.querier(ctx, []string{types.QueryQueuedProposals}, query)
+			suite.NoError(err)
+			suite.NotNil(bz)
+
+			// Unmarshal the bytes
+			queuedProposals := []types.QueuedProposal{}
+			suite.NoError(suite.cdc.UnmarshalJSON(bz, &queuedProposals))
+
+			// Check
+			suite.ElementsMatch(tc.expected, queuedProposals)
+		})
+	}
+}
+
 func (suite *QuerierTestSuite) TestQueryProposal() {
 	ctx := suite.ctx.WithIsCheckTx(false) // ?
 	// Set up request query
@@ -245,11 +304,12 @@ func (suite *QuerierTestSuite) TestQueryTally() {
 	suite.NotNil(bz)
 
 	// Unmarshal the bytes
-	var tally int64
+	var tally types.ProposalTally
 	suite.NoError(suite.cdc.UnmarshalJSON(bz, &tally))
 
 	// Check
-	suite.Equal(int64(len(suite.votes[propID])), tally)
+	expectedTally := types.NewProposalTally(propID, i(1), i(1), i(0), i(3))
+	suite.Equal(expectedTally, tally)
 }
 
 type TestSubParam struct {
@@ -279,7 +339,7 @@ func (suite *QuerierTestSuite) TestQueryRawParams() {
 	paramValue := TestSubParam{
 		Some:   "test",
 		Test:   d("1000000000000.000000000000000001"),
-		Params: []types.Vote{{1, suite.addresses[0]}, {12, suite.addresses[1]}},
+		Params: []types.Vote{types.NewVote(1, suite.addresses[0], types.Yes, sdk.OneInt()), types.NewVote(12, suite.addresses[1], types.No, sdk.OneInt())},
 	}
 	subspace.Set(ctx, []byte(paramKey), paramValue)
 
@@ -20,6 +20,15 @@ func NewProposalHandler(k Keeper) govtypes.Handler {
 	}
 }
 
+// NewParamChangeProposalHandler wraps a param change proposal handler to record the values params had before they are changed,
+// so that committee param bounds with a time window also count changes enacted by gov.
+func NewParamChangeProposalHandler(k Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		k.RecordParamChanges(ctx, content)
+		return handler(ctx, content)
+	}
+}
+
 func handleCommitteeChangeProposal(ctx sdk.Context, k Keeper, committeeProposal CommitteeChangeProposal) error {
 	if err := committeeProposal.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(ErrInvalidPubProposal, err.Error())
@@ -46,6 +55,10 @@ func handleCommitteeDeleteProposal(ctx sdk.Context, k Keeper, committeeProposal
 	for _, p := range proposals {
 		k.DeleteProposalAndVotes(ctx, p.ID)
 	}
+	// Remove all committee's queued proposals
+	for _, qp := range k.GetQueuedProposalsByCommittee(ctx, committeeProposal.CommitteeID) {
+		k.DeleteQueuedProposal(ctx, qp.Proposal.ID)
+	}
 
 	k.DeleteCommittee(ctx, committeeProposal.CommitteeID)
 	return nil
@@ -9,10 +9,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/kava-labs/kava/app"
+	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/committee"
 	"github.com/kava-labs/kava/x/committee/types"
 )
@@ -59,8 +61,10 @@ func (suite *ProposalHandlerTestSuite) SetupTest() {
 			{ID: 1, CommitteeID: 1, PubProposal: gov.NewTextProposal("A Title", "A description of this proposal."), Deadline: testTime.Add(7 * 24 * time.Hour)},
 		},
 		[]committee.Vote{
-			{ProposalID: 1, Voter: suite.addresses[0]},
+			{ProposalID: 1, Voter: suite.addresses[0], VoteType: committee.Yes, Weight: sdk.OneInt()},
 		},
+		[]committee.QueuedProposal{},
+		[]committee.ParamChangeRecord{},
 	)
 }
 
@@ -222,6 +226,29 @@ func (suite *ProposalHandlerTestSuite) TestProposalHandler_DeleteCommittee() {
 	}
 }
 
+func (suite *ProposalHandlerTestSuite) TestParamChangeProposalHandler() {
+	tApp := app.NewTestApp()
+	keeper := tApp.GetCommitteeKeeper()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: testTime})
+	tApp.InitializeFromGenesisStates()
+
+	subspace, found := tApp.GetParamsKeeper().GetSubspace(cdptypes.ModuleName)
+	suite.Require().True(found)
+	previousValue := subspace.GetRaw(ctx, cdptypes.KeyDebtThreshold)
+
+	// param changes enacted by gov are recorded so committee param bounds can limit changes over a time window
+	handler := committee.NewParamChangeProposalHandler(keeper, params.NewParamChangeProposalHandler(tApp.GetParamsKeeper()))
+	err := handler(ctx, params.NewParameterChangeProposal("A Title", "A description of this proposal.", []params.ParamChange{
+		params.NewParamChange(cdptypes.ModuleName, string(cdptypes.KeyDebtThreshold), `"200000000000"`),
+	}))
+	suite.Require().NoError(err)
+	suite.NotEqual(previousValue, subspace.GetRaw(ctx, cdptypes.KeyDebtThreshold))
+
+	recordedValue, found := keeper.GetParamValueAt(ctx, cdptypes.ModuleName, string(cdptypes.KeyDebtThreshold), testTime.Add(-time.Hour))
+	suite.Require().True(found)
+	suite.Equal(previousValue, recordedValue)
+}
+
 func TestProposalHandlerTestSuite(t *testing.T) {
 	suite.Run(t, new(ProposalHandlerTestSuite))
 }
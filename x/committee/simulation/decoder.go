@@ -37,6 +37,15 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 		proposalIDB := types.Uint64FromBytes(kvB.Value)
 		return fmt.Sprintf("%d\n%d", proposalIDA, proposalIDB)
 
+	case bytes.Equal(kvA.Key[:1], types.QueuedProposalKeyPrefix):
+		var queuedProposalA, queuedProposalB types.QueuedProposal
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &queuedProposalA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &queuedProposalB)
+		return fmt.Sprintf("%v\n%v", queuedProposalA, queuedProposalB)
+
+	case bytes.Equal(kvA.Key[:1], types.ParamChangeKeyPrefix):
+		return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
 	default:
 		panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 	}
@@ -43,6 +43,8 @@ func TestDecodeStore(t *testing.T) {
 	vote := types.Vote{
 		ProposalID: 9,
 		Voter:      nil,
+		VoteType:   types.Yes,
+		Weight:     sdk.OneInt(),
 	}
 
 	kvPairs := kv.Pairs{
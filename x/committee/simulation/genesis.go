@@ -58,6 +58,8 @@ func RandomizedGenState(simState *module.SimulationState) {
 		committees,
 		[]types.Proposal{},
 		[]types.Vote{},
+		[]types.QueuedProposal{},
+		[]types.ParamChangeRecord{},
 	)
 	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, codec.MustMarshalJSONIndent(simState.Cdc, genesisState))
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesisState)
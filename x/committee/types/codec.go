@@ -33,6 +33,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*PubProposal)(nil), nil)
 	cdc.RegisterConcrete(CommitteeChangeProposal{}, "kava/CommitteeChangeProposal", nil)
 	cdc.RegisterConcrete(CommitteeDeleteProposal{}, "kava/CommitteeDeleteProposal", nil)
+	cdc.RegisterConcrete(CommitteeVetoProposal{}, "kava/CommitteeVetoProposal", nil)
+	cdc.RegisterConcrete(MembersChangeProposal{}, "kava/MembersChangeProposal", nil)
+	cdc.RegisterConcrete(VotingRulesChangeProposal{}, "kava/VotingRulesChangeProposal", nil)
+	cdc.RegisterConcrete(BundleProposal{}, "kava/BundleProposal", nil)
 
 	// Permissions
 	cdc.RegisterInterface((*Permission)(nil), nil)
@@ -41,6 +45,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(TextPermission{}, "kava/TextPermission", nil)
 	cdc.RegisterConcrete(SoftwareUpgradePermission{}, "kava/SoftwareUpgradePermission", nil)
 	cdc.RegisterConcrete(SubParamChangePermission{}, "kava/SubParamChangePermission", nil)
+	cdc.RegisterConcrete(AssetListingPermission{}, "kava/AssetListingPermission", nil)
+	cdc.RegisterConcrete(MembersChangePermission{}, "kava/MembersChangePermission", nil)
+	cdc.RegisterConcrete(VotingRulesChangePermission{}, "kava/VotingRulesChangePermission", nil)
 
 	// Msgs
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "kava/MsgSubmitProposal", nil)
@@ -1,7 +1,9 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	yaml "gopkg.in/yaml.v2"
@@ -19,12 +21,15 @@ const MaxCommitteeDescriptionLength int = 512
 
 // A Committee is a collection of addresses that are allowed to vote and enact any governance proposal that passes their permissions.
 type Committee struct {
-	ID               uint64           `json:"id" yaml:"id"`
-	Description      string           `json:"description" yaml:"description"`
-	Members          []sdk.AccAddress `json:"members" yaml:"members"`
-	Permissions      []Permission     `json:"permissions" yaml:"permissions"`
-	VoteThreshold    sdk.Dec          `json:"vote_threshold" yaml:"vote_threshold"`       // Smallest percentage of members that must vote for a proposal to pass.
-	ProposalDuration time.Duration    `json:"proposal_duration" yaml:"proposal_duration"` // The length of time a proposal remains active for. Proposals will close earlier if they get enough votes.
+	ID                  uint64           `json:"id" yaml:"id"`
+	Description         string           `json:"description" yaml:"description"`
+	Members             []sdk.AccAddress `json:"members" yaml:"members"`
+	Permissions         []Permission     `json:"permissions" yaml:"permissions"`
+	VoteThreshold       sdk.Dec          `json:"vote_threshold" yaml:"vote_threshold"`                                   // Smallest percentage of members that must vote for a proposal to pass.
+	ProposalDuration    time.Duration    `json:"proposal_duration" yaml:"proposal_duration"`                             // The length of time a proposal remains active for. Proposals will close earlier if they get enough votes.
+	TallyParams         *TallyParams     `json:"tally_params,omitempty" yaml:"tally_params,omitempty"`                   // Weights votes by token holdings. Nil for committees where each member has one vote.
+	EnactmentDelay      time.Duration    `json:"enactment_delay,omitempty" yaml:"enactment_delay,omitempty"`             // The length of time passed proposals are queued for before they are enacted.
+	GuardianCommitteeID uint64           `json:"guardian_committee_id,omitempty" yaml:"guardian_committee_id,omitempty"` // The committee that can veto queued proposals. Zero for no guardian.
 }
 
 func NewCommittee(id uint64, description string, members []sdk.AccAddress, permissions []Permission, threshold sdk.Dec, duration time.Duration) Committee {
@@ -38,6 +43,11 @@ func NewCommittee(id uint64, description string, members []sdk.AccAddress, permi
 	}
 }
 
+// IsTokenCommittee returns whether the committee's votes are weighted by token holdings rather than counted per member.
+func (c Committee) IsTokenCommittee() bool {
+	return c.TallyParams != nil
+}
+
 func (c Committee) HasMember(addr sdk.AccAddress) bool {
 	for _, m := range c.Members {
 		if m.Equals(addr) {
@@ -58,6 +68,16 @@ func (c Committee) HasPermissionsFor(ctx sdk.Context, appCdc *codec.Codec, pk Pa
 	return false
 }
 
+// allowsMemberCount returns whether one of the committee's members change permissions allows it to have a number of members.
+func (c Committee) allowsMemberCount(count int) bool {
+	for _, p := range c.Permissions {
+		if perm, ok := p.(MembersChangePermission); ok && perm.AllowsMemberCount(count) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c Committee) Validate() error {
 
 	addressMap := make(map[string]bool, len(c.Members))
@@ -85,6 +105,24 @@ func (c Committee) Validate() error {
 		if p == nil {
 			return fmt.Errorf("committee cannot have a nil permission")
 		}
+		switch perm := p.(type) {
+		case SubParamChangePermission:
+			if err := perm.Validate(); err != nil {
+				return err
+			}
+		case AssetListingPermission:
+			if err := perm.Validate(); err != nil {
+				return err
+			}
+		case VotingRulesChangePermission:
+			if err := perm.Validate(); err != nil {
+				return err
+			}
+		case MembersChangePermission:
+			if err := perm.Validate(); err != nil {
+				return err
+			}
+		}
 	}
 
 	// threshold must be in the range (0,1]
@@ -96,6 +134,111 @@ func (c Committee) Validate() error {
 		return fmt.Errorf("invalid proposal duration: %s", c.ProposalDuration)
 	}
 
+	if c.EnactmentDelay < 0 {
+		return fmt.Errorf("invalid enactment delay: %s", c.EnactmentDelay)
+	}
+
+	if c.GuardianCommitteeID != 0 && c.GuardianCommitteeID == c.ID {
+		return fmt.Errorf("committee cannot be its own guardian: %d", c.ID)
+	}
+
+	if c.TallyParams != nil {
+		if err := c.TallyParams.Validate(); err != nil {
+			return err
+		}
+		// quorum must be in the range (0,threshold]
+		if c.TallyParams.Quorum.GT(c.VoteThreshold) {
+			return fmt.Errorf("quorum %s cannot be greater than threshold %s", c.TallyParams.Quorum, c.VoteThreshold)
+		}
+	}
+
+	return nil
+}
+
+// TallySource is the type of token holding used to weight votes in a token committee
+type TallySource byte
+
+const (
+	BalanceTally TallySource = 0x01 // votes are weighted by the voter's account balance of the tally denom
+	StakedTally  TallySource = 0x02 // votes are weighted by the voter's staked tally denom
+)
+
+// NewTallySourceFromString returns a TallySource from a string
+func NewTallySourceFromString(str string) TallySource {
+	switch str {
+	case "balance", "Balance":
+		return BalanceTally
+	case "staked", "Staked":
+		return StakedTally
+	default:
+		return 0x00
+	}
+}
+
+// IsValid returns true if the tally source is valid
+func (ts TallySource) IsValid() bool {
+	return ts == BalanceTally || ts == StakedTally
+}
+
+// String returns the string representation of a TallySource
+func (ts TallySource) String() string {
+	switch ts {
+	case BalanceTally:
+		return "balance"
+	case StakedTally:
+		return "staked"
+	default:
+		return "invalid"
+	}
+}
+
+// MarshalJSON marshals the tally source
+func (ts TallySource) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ts.String())
+}
+
+// UnmarshalJSON unmarshals the tally source
+func (ts *TallySource) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	*ts = NewTallySourceFromString(s)
+	return nil
+}
+
+// MarshalYAML marshals the tally source
+func (ts TallySource) MarshalYAML() (interface{}, error) {
+	return ts.String(), nil
+}
+
+// TallyParams weight a committee's votes by the token holdings of each voter.
+// Staked holdings are ukava delegated to bonded validators when the denom is the staking bond denom, otherwise the denom deposited in the hard protocol.
+type TallyParams struct {
+	Source TallySource `json:"source" yaml:"source"`
+	Denom  string      `json:"denom" yaml:"denom"`
+	Quorum sdk.Dec     `json:"quorum" yaml:"quorum"` // Smallest fraction of the total weight that must vote, including abstain votes, for a proposal to pass at its deadline.
+}
+
+func NewTallyParams(source TallySource, denom string, quorum sdk.Dec) TallyParams {
+	return TallyParams{
+		Source: source,
+		Denom:  denom,
+		Quorum: quorum,
+	}
+}
+
+func (tp TallyParams) Validate() error {
+	if !tp.Source.IsValid() {
+		return fmt.Errorf("invalid tally source: %s", tp.Source)
+	}
+	if err := sdk.ValidateDenom(tp.Denom); err != nil {
+		return fmt.Errorf("invalid tally denom: %w", err)
+	}
+	if tp.Quorum.IsNil() || tp.Quorum.LTE(sdk.ZeroDec()) || tp.Quorum.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid quorum: %s", tp.Quorum)
+	}
 	return nil
 }
 
@@ -110,10 +253,11 @@ type PubProposal govtypes.Content
 
 // Proposal is an internal record of a governance proposal submitted to a committee.
 type Proposal struct {
-	PubProposal `json:"pub_proposal" yaml:"pub_proposal"`
-	ID          uint64    `json:"id" yaml:"id"`
-	CommitteeID uint64    `json:"committee_id" yaml:"committee_id"`
-	Deadline    time.Time `json:"deadline" yaml:"deadline"`
+	PubProposal   `json:"pub_proposal" yaml:"pub_proposal"`
+	ID            uint64         `json:"id" yaml:"id"`
+	CommitteeID   uint64         `json:"committee_id" yaml:"committee_id"`
+	Deadline      time.Time      `json:"deadline" yaml:"deadline"`
+	TallySnapshot *TallySnapshot `json:"tally_snapshot,omitempty" yaml:"tally_snapshot,omitempty"` // Total voting weight of a token committee when the proposal was submitted. Nil for other committees.
 }
 
 func NewProposal(pubProposal PubProposal, id uint64, committeeID uint64, deadline time.Time) Proposal {
@@ -125,6 +269,43 @@ func NewProposal(pubProposal PubProposal, id uint64, committeeID uint64, deadlin
 	}
 }
 
+// QueuedProposal is a passed proposal waiting for its committee's enactment delay to elapse before it is enacted.
+type QueuedProposal struct {
+	Proposal      Proposal  `json:"proposal" yaml:"proposal"`
+	EnactmentTime time.Time `json:"enactment_time" yaml:"enactment_time"`
+}
+
+func NewQueuedProposal(proposal Proposal, enactmentTime time.Time) QueuedProposal {
+	return QueuedProposal{
+		Proposal:      proposal,
+		EnactmentTime: enactmentTime,
+	}
+}
+
+// IsEnactableBy calculates if the queued proposal's enactment delay will have elapsed by a certain time.
+func (qp QueuedProposal) IsEnactableBy(time time.Time) bool {
+	return !time.Before(qp.EnactmentTime)
+}
+
+// String implements the fmt.Stringer interface.
+func (qp QueuedProposal) String() string {
+	bz, _ := yaml.Marshal(qp)
+	return string(bz)
+}
+
+// TallySnapshot records the total voting weight of a token committee at a block height
+type TallySnapshot struct {
+	Height      int64   `json:"height" yaml:"height"`
+	TotalWeight sdk.Int `json:"total_weight" yaml:"total_weight"`
+}
+
+func NewTallySnapshot(height int64, totalWeight sdk.Int) TallySnapshot {
+	return TallySnapshot{
+		Height:      height,
+		TotalWeight: totalWeight,
+	}
+}
+
 // HasExpiredBy calculates if the proposal will have expired by a certain time.
 // All votes must be cast before deadline, those cast at time == deadline are not valid
 func (p Proposal) HasExpiredBy(time time.Time) bool {
@@ -144,12 +325,16 @@ func (p Proposal) String() string {
 type Vote struct {
 	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
 	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	VoteType   VoteType       `json:"vote_type" yaml:"vote_type"`
+	Weight     sdk.Int        `json:"weight" yaml:"weight"` // voting weight of the voter when the vote was cast
 }
 
-func NewVote(proposalID uint64, voter sdk.AccAddress) Vote {
+func NewVote(proposalID uint64, voter sdk.AccAddress, voteType VoteType, weight sdk.Int) Vote {
 	return Vote{
 		ProposalID: proposalID,
 		Voter:      voter,
+		VoteType:   voteType,
+		Weight:     weight,
 	}
 }
 
@@ -157,5 +342,179 @@ func (v Vote) Validate() error {
 	if v.Voter.Empty() {
 		return fmt.Errorf("voter address cannot be empty")
 	}
+	if !v.VoteType.IsValid() {
+		return fmt.Errorf("invalid vote type: %s", v.VoteType)
+	}
+	if v.Weight.IsNil() || v.Weight.IsNegative() {
+		return fmt.Errorf("vote weight cannot be nil or negative: %s", v.Weight)
+	}
+	return nil
+}
+
+// VoteType is the option chosen by a vote
+type VoteType byte
+
+const (
+	NullVoteType VoteType = 0x00
+	Yes          VoteType = 0x01
+	No           VoteType = 0x02
+	Abstain      VoteType = 0x03
+)
+
+// NewVoteTypeFromString returns a VoteType from a string
+func NewVoteTypeFromString(str string) VoteType {
+	switch strings.ToLower(str) {
+	case "yes", "y":
+		return Yes
+	case "no", "n":
+		return No
+	case "abstain", "a":
+		return Abstain
+	default:
+		return NullVoteType
+	}
+}
+
+// IsValid returns true if the vote type is valid
+func (vt VoteType) IsValid() bool {
+	return vt == Yes || vt == No || vt == Abstain
+}
+
+// String returns the string representation of a VoteType
+func (vt VoteType) String() string {
+	switch vt {
+	case Yes:
+		return "yes"
+	case No:
+		return "no"
+	case Abstain:
+		return "abstain"
+	default:
+		return "invalid"
+	}
+}
+
+// MarshalJSON marshals the vote type
+func (vt VoteType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(vt.String())
+}
+
+// UnmarshalJSON unmarshals the vote type
+func (vt *VoteType) UnmarshalJSON(data []byte) error {
+	var s string
+	err := json.Unmarshal(data, &s)
+	if err != nil {
+		return err
+	}
+	*vt = NewVoteTypeFromString(s)
+	return nil
+}
+
+// MarshalYAML marshals the vote type
+func (vt VoteType) MarshalYAML() (interface{}, error) {
+	return vt.String(), nil
+}
+
+// ProposalTally is the weight of each vote option cast on a proposal.
+// Weights are numbers of members for member committees, and token holdings for token committees.
+type ProposalTally struct {
+	ProposalID    uint64  `json:"proposal_id" yaml:"proposal_id"`
+	YesVotes      sdk.Int `json:"yes_votes" yaml:"yes_votes"`
+	NoVotes       sdk.Int `json:"no_votes" yaml:"no_votes"`
+	AbstainVotes  sdk.Int `json:"abstain_votes" yaml:"abstain_votes"`
+	PossibleVotes sdk.Int `json:"possible_votes" yaml:"possible_votes"` // Number of members, or total weight snapshotted when the proposal was submitted.
+}
+
+func NewProposalTally(proposalID uint64, yesVotes, noVotes, abstainVotes, possibleVotes sdk.Int) ProposalTally {
+	return ProposalTally{
+		ProposalID:    proposalID,
+		YesVotes:      yesVotes,
+		NoVotes:       noVotes,
+		AbstainVotes:  abstainVotes,
+		PossibleVotes: possibleVotes,
+	}
+}
+
+// Passes returns whether the yes votes have reached the threshold fraction of possible votes.
+func (t ProposalTally) Passes(threshold sdk.Dec) bool {
+	if !t.PossibleVotes.IsPositive() {
+		return false
+	}
+	return t.YesVotes.ToDec().GTE(threshold.MulInt(t.PossibleVotes))
+}
+
+// CanPass returns whether the yes votes could still reach the threshold fraction of possible votes,
+// assuming all votes that are not no or abstain votes become yes votes.
+func (t ProposalTally) CanPass(threshold sdk.Dec) bool {
+	if !t.PossibleVotes.IsPositive() {
+		return false
+	}
+	remaining := t.PossibleVotes.Sub(t.NoVotes).Sub(t.AbstainVotes)
+	return remaining.ToDec().GTE(threshold.MulInt(t.PossibleVotes))
+}
+
+// HasQuorum returns whether the votes cast, including abstain votes, have reached the quorum fraction of possible votes.
+func (t ProposalTally) HasQuorum(quorum sdk.Dec) bool {
+	if !t.PossibleVotes.IsPositive() {
+		return false
+	}
+	cast := t.YesVotes.Add(t.NoVotes).Add(t.AbstainVotes)
+	return cast.ToDec().GTE(quorum.MulInt(t.PossibleVotes))
+}
+
+// PassesVotesCast returns whether the yes votes are over the threshold fraction of the yes and no votes cast.
+func (t ProposalTally) PassesVotesCast(threshold sdk.Dec) bool {
+	cast := t.YesVotes.Add(t.NoVotes)
+	if !cast.IsPositive() {
+		return false
+	}
+	return t.YesVotes.ToDec().GT(threshold.MulInt(cast))
+}
+
+// CanPassVotesCast returns whether the yes votes could still be over the threshold fraction of the yes and no votes cast,
+// assuming all votes that are not no or abstain votes become yes votes.
+func (t ProposalTally) CanPassVotesCast(threshold sdk.Dec) bool {
+	remaining := t.PossibleVotes.Sub(t.NoVotes).Sub(t.AbstainVotes)
+	if !remaining.IsPositive() {
+		return false
+	}
+	return remaining.ToDec().GT(threshold.MulInt(remaining.Add(t.NoVotes)))
+}
+
+// ------------------------------------------
+//				Param Changes
+// ------------------------------------------
+
+// ParamChangeRecord is the value a param had before a proposal changed it, used to bound param changes over a time window.
+type ParamChangeRecord struct {
+	Subspace      string    `json:"subspace" yaml:"subspace"`
+	Key           string    `json:"key" yaml:"key"`
+	Time          time.Time `json:"time" yaml:"time"`                     // Block time the param was changed.
+	PreviousValue string    `json:"previous_value" yaml:"previous_value"` // JSON encoded value of the param before the change.
+}
+
+func NewParamChangeRecord(subspace, key string, changeTime time.Time, previousValue string) ParamChangeRecord {
+	return ParamChangeRecord{
+		Subspace:      subspace,
+		Key:           key,
+		Time:          changeTime,
+		PreviousValue: previousValue,
+	}
+}
+
+// Validate performs basic validation of a param change record
+func (r ParamChangeRecord) Validate() error {
+	if strings.TrimSpace(r.Subspace) == "" || len(r.Subspace) > MaxParamChangeKeyLength {
+		return fmt.Errorf("invalid param change subspace: %q", r.Subspace)
+	}
+	if strings.TrimSpace(r.Key) == "" || len(r.Key) > MaxParamChangeKeyLength {
+		return fmt.Errorf("invalid param change key: %q", r.Key)
+	}
+	if r.Time.IsZero() {
+		return fmt.Errorf("param change %s/%s has no time", r.Subspace, r.Key)
+	}
+	if !json.Valid([]byte(r.PreviousValue)) {
+		return fmt.Errorf("param change %s/%s previous value is not valid JSON", r.Subspace, r.Key)
+	}
 	return nil
 }
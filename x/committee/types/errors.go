@@ -14,4 +14,7 @@ var (
 	ErrInvalidGenesis          = sdkerrors.Register(ModuleName, 8, "invalid genesis")
 	ErrNoProposalHandlerExists = sdkerrors.Register(ModuleName, 9, "pubproposal has no corresponding handler")
 	ErrUnknownSubspace         = sdkerrors.Register(ModuleName, 10, "subspace not found")
+	ErrNoVotingWeight          = sdkerrors.Register(ModuleName, 11, "voter has no voting weight")
+	ErrInvalidVoteType         = sdkerrors.Register(ModuleName, 12, "invalid vote type")
+	ErrUnknownQueuedProposal   = sdkerrors.Register(ModuleName, 13, "queued proposal not found")
 )
@@ -5,13 +5,20 @@ const (
 	EventTypeProposalSubmit = "proposal_submit"
 	EventTypeProposalClose  = "proposal_close"
 	EventTypeProposalVote   = "proposal_vote"
+	EventTypeProposalEnact  = "proposal_enact"
+	EventTypeProposalVeto   = "proposal_veto"
 
 	AttributeValueCategory          = "committee"
 	AttributeKeyCommitteeID         = "committee_id"
 	AttributeKeyProposalID          = "proposal_id"
 	AttributeKeyProposalCloseStatus = "status"
 	AttributeKeyVoter               = "voter"
+	AttributeKeyVote                = "vote"
+	AttributeKeyEnactmentTime       = "enactment_time"
+	AttributeKeyGuardianCommitteeID = "guardian_committee_id"
 	AttributeValueProposalPassed    = "proposal_passed"
 	AttributeValueProposalTimeout   = "proposal_timeout"
 	AttributeValueProposalFailed    = "proposal_failed"
+	AttributeValueProposalQueued    = "proposal_queued"
+	AttributeValueProposalEnacted   = "proposal_enacted"
 )
@@ -1,9 +1,47 @@
 package types
 
 import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 	"github.com/cosmos/cosmos-sdk/x/params"
+	stakingexported "github.com/cosmos/cosmos-sdk/x/staking/exported"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+
+	hardtypes "github.com/kava-labs/kava/x/hard/types"
 )
 
 type ParamKeeper interface {
 	GetSubspace(string) (params.Subspace, bool)
 }
+
+// ParamHistory defines the expected interface for looking up past param values, used to bound param changes over a time window
+type ParamHistory interface {
+	GetParamValueAt(ctx sdk.Context, subspace, key string, t time.Time) ([]byte, bool)
+}
+
+// AccountKeeper defines the expected account keeper
+type AccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
+}
+
+// SupplyKeeper defines the expected supply keeper
+type SupplyKeeper interface {
+	GetSupply(ctx sdk.Context) (supply supplyexported.SupplyI)
+}
+
+// StakingKeeper defines the expected staking keeper
+type StakingKeeper interface {
+	BondDenom(ctx sdk.Context) string
+	IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn func(index int64, delegation stakingexported.DelegationI) (stop bool))
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	TotalBondedTokens(ctx sdk.Context) sdk.Int
+}
+
+// HardKeeper defines the expected hard keeper
+type HardKeeper interface {
+	GetSyncedDeposit(ctx sdk.Context, depositor sdk.AccAddress) (hardtypes.Deposit, bool)
+	GetSuppliedCoins(ctx sdk.Context) (sdk.Coins, bool)
+}
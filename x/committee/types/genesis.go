@@ -10,19 +10,24 @@ const DefaultNextProposalID uint64 = 1
 
 // GenesisState is state that must be provided at chain genesis.
 type GenesisState struct {
-	NextProposalID uint64      `json:"next_proposal_id" yaml:"next_proposal_id"`
-	Committees     []Committee `json:"committees" yaml:"committees"`
-	Proposals      []Proposal  `json:"proposals" yaml:"proposals"`
-	Votes          []Vote      `json:"votes" yaml:"votes"`
+	NextProposalID  uint64              `json:"next_proposal_id" yaml:"next_proposal_id"`
+	Committees      []Committee         `json:"committees" yaml:"committees"`
+	Proposals       []Proposal          `json:"proposals" yaml:"proposals"`
+	Votes           []Vote              `json:"votes" yaml:"votes"`
+	QueuedProposals []QueuedProposal    `json:"queued_proposals" yaml:"queued_proposals"`
+	ParamChanges    []ParamChangeRecord `json:"param_changes" yaml:"param_changes"`
 }
 
 // NewGenesisState returns a new genesis state object for the module.
-func NewGenesisState(nextProposalID uint64, committees []Committee, proposals []Proposal, votes []Vote) GenesisState {
+func NewGenesisState(nextProposalID uint64, committees []Committee, proposals []Proposal, votes []Vote, queuedProposals []QueuedProposal,
+	paramChanges []ParamChangeRecord) GenesisState {
 	return GenesisState{
-		NextProposalID: nextProposalID,
-		Committees:     committees,
-		Proposals:      proposals,
-		Votes:          votes,
+		NextProposalID:  nextProposalID,
+		Committees:      committees,
+		Proposals:       proposals,
+		Votes:           votes,
+		QueuedProposals: queuedProposals,
+		ParamChanges:    paramChanges,
 	}
 }
 
@@ -33,6 +38,8 @@ func DefaultGenesisState() GenesisState {
 		[]Committee{},
 		[]Proposal{},
 		[]Vote{},
+		[]QueuedProposal{},
+		[]ParamChangeRecord{},
 	)
 }
 
@@ -65,6 +72,13 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// check guardian committees exist
+	for _, com := range gs.Committees {
+		if com.GuardianCommitteeID != 0 && !committeeMap[com.GuardianCommitteeID] {
+			return fmt.Errorf("committee refers to non existent guardian committee; id: %d, guardian id: %d", com.ID, com.GuardianCommitteeID)
+		}
+	}
+
 	// validate proposals
 	proposalMap := make(map[uint64]bool, len(gs.Proposals))
 	for _, p := range gs.Proposals {
@@ -102,5 +116,47 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("vote refers to non existent proposal; vote: %+v", v)
 		}
 	}
+
+	// validate queued proposals
+	for _, qp := range gs.QueuedProposals {
+		p := qp.Proposal
+		// check there are no duplicate IDs, including with active proposals
+		if _, ok := proposalMap[p.ID]; ok {
+			return fmt.Errorf("duplicate proposal ID found in genesis state; id: %d", p.ID)
+		}
+		proposalMap[p.ID] = true
+
+		// validate next proposal ID
+		if p.ID >= gs.NextProposalID {
+			return fmt.Errorf("NextProposalID is not greater than all proposal IDs; id: %d", p.ID)
+		}
+
+		// check committee exists
+		if !committeeMap[p.CommitteeID] {
+			return fmt.Errorf("queued proposal refers to non existent committee; proposal: %+v", p)
+		}
+
+		// validate pubProposal
+		if p.PubProposal == nil {
+			return fmt.Errorf("queued proposal %d has nil pubproposal", p.ID)
+		}
+		if err := p.PubProposal.ValidateBasic(); err != nil {
+			return fmt.Errorf("queued proposal %d invalid: %w", p.ID, err)
+		}
+	}
+
+	// validate param changes
+	paramChangeMap := make(map[string]bool, len(gs.ParamChanges))
+	for _, r := range gs.ParamChanges {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		// check there is only one record per param and time
+		id := string(GetParamChangeKey(r.Subspace, r.Key, r.Time))
+		if paramChangeMap[id] {
+			return fmt.Errorf("duplicate param change found in genesis state; %s/%s at %s", r.Subspace, r.Key, r.Time)
+		}
+		paramChangeMap[id] = true
+	}
 	return nil
 }
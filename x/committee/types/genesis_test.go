@@ -45,8 +45,8 @@ func TestGenesisState_Validate(t *testing.T) {
 			{ID: 1, CommitteeID: 1, PubProposal: govtypes.NewTextProposal("A Title", "A description of this proposal."), Deadline: testTime.Add(7 * 24 * time.Hour)},
 		},
 		Votes: []Vote{
-			{ProposalID: 1, Voter: addresses[0]},
-			{ProposalID: 1, Voter: addresses[1]},
+			{ProposalID: 1, Voter: addresses[0], VoteType: Yes, Weight: sdk.OneInt()},
+			{ProposalID: 1, Voter: addresses[1], VoteType: Yes, Weight: sdk.OneInt()},
 		},
 	}
 
@@ -85,6 +85,102 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "invalid committee tally params",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees: append(testGenesis.Committees, func() Committee {
+					com := testGenesis.Committees[0]
+					com.ID = 3
+					com.TallyParams = &TallyParams{Source: BalanceTally, Denom: "hard", Quorum: d("0.8")} // greater than vote threshold
+					return com
+				}()),
+				Proposals: testGenesis.Proposals,
+				Votes:     testGenesis.Votes,
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid committee enactment delay",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees: append(testGenesis.Committees, func() Committee {
+					com := testGenesis.Committees[0]
+					com.ID = 3
+					com.EnactmentDelay = -time.Hour
+					return com
+				}()),
+				Proposals: testGenesis.Proposals,
+				Votes:     testGenesis.Votes,
+			},
+			expectPass: false,
+		},
+		{
+			name: "invalid committee param bounds",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees: append(testGenesis.Committees, func() Committee {
+					com := testGenesis.Committees[0]
+					com.ID = 3
+					com.Permissions = []Permission{SubParamChangePermission{
+						AllowedCollateralParams: AllowedCollateralParams{{
+							Type:         "bnb-a",
+							StabilityFee: true,
+							Bounds:       ParamBounds{NewParamBound("not_a_field", dp("1.0"), dp("1.1"), dp("0.1"), 0)},
+						}},
+					}}
+					return com
+				}()),
+				Proposals: testGenesis.Proposals,
+				Votes:     testGenesis.Votes,
+			},
+			expectPass: false,
+		},
+		{
+			name: "committee is own guardian",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees: append(testGenesis.Committees, func() Committee {
+					com := testGenesis.Committees[0]
+					com.ID = 3
+					com.GuardianCommitteeID = 3
+					return com
+				}()),
+				Proposals: testGenesis.Proposals,
+				Votes:     testGenesis.Votes,
+			},
+			expectPass: false,
+		},
+		{
+			name: "committee with guardian",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees: append(testGenesis.Committees, func() Committee {
+					com := testGenesis.Committees[0]
+					com.ID = 3
+					com.GuardianCommitteeID = 2
+					return com
+				}()),
+				Proposals: testGenesis.Proposals,
+				Votes:     testGenesis.Votes,
+			},
+			expectPass: true,
+		},
+		{
+			name: "guardian committee does not exist",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees: append(testGenesis.Committees, func() Committee {
+					com := testGenesis.Committees[0]
+					com.ID = 3
+					com.GuardianCommitteeID = 4
+					return com
+				}()),
+				Proposals: testGenesis.Proposals,
+				Votes:     testGenesis.Votes,
+			},
+			expectPass: false,
+		},
 		{
 			name: "duplicate proposal IDs",
 			genState: GenesisState{
@@ -131,6 +227,48 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "queued proposal",
+			genState: GenesisState{
+				NextProposalID: 3,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+				QueuedProposals: []QueuedProposal{{
+					Proposal:      Proposal{ID: 2, CommitteeID: 1, PubProposal: govtypes.NewTextProposal("A Title", "A description of this proposal."), Deadline: testTime},
+					EnactmentTime: testTime.Add(24 * time.Hour),
+				}},
+			},
+			expectPass: true,
+		},
+		{
+			name: "queued proposal with duplicate ID",
+			genState: GenesisState{
+				NextProposalID: 3,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+				QueuedProposals: []QueuedProposal{{
+					Proposal:      Proposal{ID: 1, CommitteeID: 1, PubProposal: govtypes.NewTextProposal("A Title", "A description of this proposal."), Deadline: testTime},
+					EnactmentTime: testTime.Add(24 * time.Hour),
+				}},
+			},
+			expectPass: false,
+		},
+		{
+			name: "queued proposal without committee",
+			genState: GenesisState{
+				NextProposalID: 3,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+				QueuedProposals: []QueuedProposal{{
+					Proposal:      Proposal{ID: 2, CommitteeID: 57, PubProposal: govtypes.NewTextProposal("A Title", "A description of this proposal."), Deadline: testTime},
+					EnactmentTime: testTime.Add(24 * time.Hour),
+				}},
+			},
+			expectPass: false,
+		},
 		{
 			name: "vote without proposal",
 			genState: GenesisState{
@@ -151,6 +289,47 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "valid param changes",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+				ParamChanges: []ParamChangeRecord{
+					NewParamChangeRecord("cdp", "DebtThreshold", testTime, `"100000000000"`),
+					NewParamChangeRecord("cdp", "DebtThreshold", testTime.Add(time.Hour), `"200000000000"`),
+				},
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid param change",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+				ParamChanges: []ParamChangeRecord{
+					NewParamChangeRecord("cdp", "DebtThreshold", testTime, `not json`),
+				},
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate param change",
+			genState: GenesisState{
+				NextProposalID: testGenesis.NextProposalID,
+				Committees:     testGenesis.Committees,
+				Proposals:      testGenesis.Proposals,
+				Votes:          testGenesis.Votes,
+				ParamChanges: []ParamChangeRecord{
+					NewParamChangeRecord("cdp", "DebtThreshold", testTime, `"100000000000"`),
+					NewParamChangeRecord("cdp", "DebtThreshold", testTime, `"200000000000"`),
+				},
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -2,6 +2,8 @@ package types
 
 import (
 	"encoding/binary"
+	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -30,6 +32,9 @@ var (
 	VoteKeyPrefix      = []byte{0x02} // prefix for keys that store votes
 
 	NextProposalIDKey = []byte{0x03} // key for the next proposal id
+
+	QueuedProposalKeyPrefix = []byte{0x04} // prefix for keys that store passed proposals waiting to be enacted
+	ParamChangeKeyPrefix    = []byte{0x05} // prefix for keys that store param values from before committees changed them
 )
 
 // GetKeyFromID returns the bytes to use as a key for a uint64 id
@@ -41,6 +46,39 @@ func GetVoteKey(proposalID uint64, voter sdk.AccAddress) []byte {
 	return append(GetKeyFromID(proposalID), voter.Bytes()...)
 }
 
+// MaxParamChangeKeyLength is the longest subspace or key of a recorded param change, as their lengths are stored in a single byte
+const MaxParamChangeKeyLength = 255
+
+// GetParamChangeKeyPrefix returns the key prefix for the recorded changes to one param
+func GetParamChangeKeyPrefix(subspace, key string) []byte {
+	bz := append([]byte{byte(len(subspace))}, subspace...)
+	bz = append(bz, byte(len(key)))
+	return append(bz, key...)
+}
+
+// GetParamChangeKey returns the key for a recorded change to a param at a time
+func GetParamChangeKey(subspace, key string, changeTime time.Time) []byte {
+	return append(GetParamChangeKeyPrefix(subspace, key), sdk.FormatTimeBytes(changeTime)...)
+}
+
+// ParseParamChangeKey returns the subspace, key and time of a recorded param change key
+func ParseParamChangeKey(bz []byte) (string, string, time.Time, error) {
+	var parts [2]string
+	for i := range parts {
+		if len(bz) < 1 || len(bz) < 1+int(bz[0]) {
+			return "", "", time.Time{}, fmt.Errorf("invalid param change key: %x", bz)
+		}
+		length := int(bz[0])
+		parts[i] = string(bz[1 : 1+length])
+		bz = bz[1+length:]
+	}
+	changeTime, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		return "", "", time.Time{}, err
+	}
+	return parts[0], parts[1], changeTime, nil
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
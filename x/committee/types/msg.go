@@ -61,11 +61,12 @@ func (msg MsgSubmitProposal) GetSigners() []sdk.AccAddress {
 type MsgVote struct {
 	ProposalID uint64         `json:"proposal_id" yaml:"proposal_id"`
 	Voter      sdk.AccAddress `json:"voter" yaml:"voter"`
+	VoteType   VoteType       `json:"vote_type" yaml:"vote_type"`
 }
 
 // NewMsgVote creates a message to cast a vote on an active proposal
-func NewMsgVote(voter sdk.AccAddress, proposalID uint64) MsgVote {
-	return MsgVote{proposalID, voter}
+func NewMsgVote(voter sdk.AccAddress, proposalID uint64, voteType VoteType) MsgVote {
+	return MsgVote{proposalID, voter, voteType}
 }
 
 // Route return the message type used for routing the message.
@@ -79,6 +80,9 @@ func (msg MsgVote) ValidateBasic() error {
 	if msg.Voter.Empty() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "voter address cannot be empty")
 	}
+	if !msg.VoteType.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidVoteType, "%s", msg.VoteType)
+	}
 	return nil
 }
 
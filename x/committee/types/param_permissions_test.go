@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
@@ -13,6 +14,7 @@ import (
 // Avoid cluttering test cases with long function names
 func i(in int64) sdk.Int                    { return sdk.NewInt(in) }
 func d(str string) sdk.Dec                  { return sdk.MustNewDecFromStr(str) }
+func dp(str string) *sdk.Dec                { dec := d(str); return &dec }
 func c(denom string, amount int64) sdk.Coin { return sdk.NewInt64Coin(denom, amount) }
 func cs(coins ...sdk.Coin) sdk.Coins        { return sdk.NewCoins(coins...) }
 
@@ -396,6 +398,100 @@ func (suite *PermissionsTestSuite) TestAllowedMarkets_Allows() {
 	}
 }
 
+func (suite *PermissionsTestSuite) TestAllowedAuctionDenomParams_Allows() {
+	testDPs := auctiontypes.DenomParams{
+		auctiontypes.NewDenomParam("bnb", 24*time.Hour, 1*time.Hour, d("0.05"), d("0.05"), d("0.05")),
+		auctiontypes.NewDenomParam("busd", 48*time.Hour, 4*time.Hour, d("0.01"), d("0.01"), d("0.01")),
+	}
+	updatedTestDPs := make(auctiontypes.DenomParams, len(testDPs))
+	copy(updatedTestDPs, testDPs)
+	updatedTestDPs[0].BidDuration = 30 * time.Minute  // bnb
+	updatedTestDPs[1].IncrementCollateral = d("0.02") // busd
+	newDP := auctiontypes.NewDenomParam("btcb", 24*time.Hour, 1*time.Hour, d("0.05"), d("0.05"), d("0.05"))
+
+	testcases := []struct {
+		name          string
+		allowed       AllowedAuctionDenomParams
+		current       auctiontypes.DenomParams
+		incoming      auctiontypes.DenomParams
+		expectAllowed bool
+	}{
+		{
+			name: "allowed change",
+			allowed: AllowedAuctionDenomParams{
+				NewAllowedAuctionDenomParam("bnb", false, true, false, false, false),
+				NewAllowedAuctionDenomParam("busd", false, false, false, false, true),
+			},
+			current:       testDPs,
+			incoming:      updatedTestDPs,
+			expectAllowed: true,
+		},
+		{
+			name: "disallowed field change",
+			allowed: AllowedAuctionDenomParams{
+				NewAllowedAuctionDenomParam("bnb", false, true, false, false, false),
+				NewAllowedAuctionDenomParam("busd", true, true, true, true, false),
+			},
+			current:       testDPs,
+			incoming:      updatedTestDPs,
+			expectAllowed: false,
+		},
+		{
+			name: "disallowed denom change",
+			allowed: AllowedAuctionDenomParams{
+				NewAllowedAuctionDenomParam("bnb", true, true, true, true, true),
+			},
+			current:       testDPs,
+			incoming:      updatedTestDPs,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed add",
+			allowed: AllowedAuctionDenomParams{
+				NewAllowedAuctionDenomParam("btcb", true, true, true, true, true),
+			},
+			current:       testDPs,
+			incoming:      append(auctiontypes.DenomParams{newDP}, testDPs...),
+			expectAllowed: true,
+		},
+		{
+			name: "disallowed add",
+			allowed: AllowedAuctionDenomParams{
+				NewAllowedAuctionDenomParam("btcb", false, true, true, true, true),
+			},
+			current:       testDPs,
+			incoming:      append(auctiontypes.DenomParams{newDP}, testDPs...),
+			expectAllowed: false,
+		},
+		{
+			name: "allowed remove",
+			allowed: AllowedAuctionDenomParams{
+				NewAllowedAuctionDenomParam("busd", true, true, true, true, true),
+			},
+			current:       testDPs,
+			incoming:      testDPs[:1],
+			expectAllowed: true,
+		},
+		{
+			name: "disallowed remove",
+			allowed: AllowedAuctionDenomParams{
+				NewAllowedAuctionDenomParam("bnb", true, true, true, true, true),
+			},
+			current:       testDPs,
+			incoming:      testDPs[:1],
+			expectAllowed: false,
+		},
+	}
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(
+				tc.expectAllowed,
+				tc.allowed.Allows(tc.current, tc.incoming),
+			)
+		})
+	}
+}
+
 func (suite *PermissionsTestSuite) TestAllowedCollateralParam_Allows() {
 	testCP := cdptypes.NewCollateralParam(
 		"bnb",
@@ -484,6 +580,83 @@ func (suite *PermissionsTestSuite) TestAllowedCollateralParam_Allows() {
 			incoming:      newMarketIDAndDebtLimitCP,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed change within bounds",
+			allowed: AllowedCollateralParam{
+				Type:      "bnb-a",
+				DebtLimit: true,
+				Bounds:    ParamBounds{NewParamBound("debt_limit", dp("100"), dp("2000000000000"), dp("1.0"), 0)},
+			},
+			current:       testCP,
+			incoming:      newDebtLimitCP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed change below min",
+			allowed: AllowedCollateralParam{
+				Type:      "bnb-a",
+				DebtLimit: true,
+				Bounds:    ParamBounds{NewParamBound("debt_limit", dp("5000"), nil, nil, 0)},
+			},
+			current:       testCP,
+			incoming:      newDebtLimitCP,
+			expectAllowed: false,
+		},
+		{
+			name: "un-allowed change above max",
+			allowed: AllowedCollateralParam{
+				Type:      "bnb-a",
+				DebtLimit: true,
+				Bounds:    ParamBounds{NewParamBound("debt_limit", nil, dp("500"), nil, 0)},
+			},
+			current:       testCP,
+			incoming:      newDebtLimitCP,
+			expectAllowed: false,
+		},
+		{
+			name: "un-allowed change over max relative change",
+			allowed: AllowedCollateralParam{
+				Type:      "bnb-a",
+				DebtLimit: true,
+				Bounds:    ParamBounds{NewParamBound("debt_limit", nil, nil, dp("0.1"), 0)},
+			},
+			current:       testCP,
+			incoming:      newDebtLimitCP,
+			expectAllowed: false,
+		},
+		{
+			name: "un-allowed change with zero max relative change",
+			allowed: AllowedCollateralParam{
+				Type:      "bnb-a",
+				DebtLimit: true,
+				Bounds:    ParamBounds{NewParamBound("debt_limit", nil, nil, dp("0"), 0)},
+			},
+			current:       testCP,
+			incoming:      newDebtLimitCP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed change with zero min",
+			allowed: AllowedCollateralParam{
+				Type:      "bnb-a",
+				DebtLimit: true,
+				Bounds:    ParamBounds{NewParamBound("debt_limit", dp("0"), dp("2000000000000"), nil, 0)},
+			},
+			current:       testCP,
+			incoming:      newDebtLimitCP,
+			expectAllowed: true,
+		},
+		{
+			name: "allowed change with bound on unchanged field",
+			allowed: AllowedCollateralParam{
+				Type:      "bnb-a",
+				DebtLimit: true,
+				Bounds:    ParamBounds{NewParamBound("stability_fee", nil, dp("1.0000001"), dp("0.00001"), 0)},
+			},
+			current:       testCP,
+			incoming:      newDebtLimitCP,
+			expectAllowed: true,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
@@ -616,6 +789,20 @@ func (suite *PermissionsTestSuite) TestAllowedAssetParam_Allows() {
 	newCoinidAndLimitAP.CoinID = 0
 	newCoinidAndLimitAP.SupplyLimit.Limit = i(1000)
 
+	newDeputyRotationAP := testAP
+	newDeputyRotationAP.DeputyRotation = bep3types.NewDeputyRotation(
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTestUser2"))), 1000, 100,
+	)
+
+	newSwapQuotaAP := testAP
+	newSwapQuotaAP.SwapQuota = bep3types.NewSwapQuota(time.Hour, bep3types.NewQuotaLimit(true, i(1000)), bep3types.NewQuotaLimit(true, i(1000)), bep3types.NewQuotaLimit(true, i(10000)))
+
+	newTimeExpiryAP := testAP
+	newTimeExpiryAP.TimeExpiry = bep3types.NewTimeExpiry(true, time.Minute, time.Hour)
+
+	newChainIDsAP := testAP
+	newChainIDsAP.ChainIDs = []string{"Binance-Chain-Tigris"}
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedAssetParam
@@ -663,6 +850,87 @@ func (suite *PermissionsTestSuite) TestAllowedAssetParam_Allows() {
 			incoming:      newCoinidAndLimitAP,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed deputy rotation",
+			allowed: AllowedAssetParam{
+				Denom:          "usdx",
+				DeputyRotation: true,
+			},
+			current:       testAP,
+			incoming:      newDeputyRotationAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed deputy rotation",
+			allowed: AllowedAssetParam{
+				Denom: "usdx",
+				Limit: true,
+			},
+			current:       testAP,
+			incoming:      newDeputyRotationAP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed swap quota",
+			allowed: AllowedAssetParam{
+				Denom:     "usdx",
+				SwapQuota: true,
+			},
+			current:       testAP,
+			incoming:      newSwapQuotaAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed swap quota",
+			allowed: AllowedAssetParam{
+				Denom:          "usdx",
+				DeputyRotation: true,
+			},
+			current:       testAP,
+			incoming:      newSwapQuotaAP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed time expiry",
+			allowed: AllowedAssetParam{
+				Denom:      "usdx",
+				TimeExpiry: true,
+			},
+			current:       testAP,
+			incoming:      newTimeExpiryAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed time expiry",
+			allowed: AllowedAssetParam{
+				Denom:     "usdx",
+				SwapQuota: true,
+			},
+			current:       testAP,
+			incoming:      newTimeExpiryAP,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed chain ids",
+			allowed: AllowedAssetParam{
+				Denom:    "usdx",
+				ChainIDs: true,
+			},
+			current:       testAP,
+			incoming:      newChainIDsAP,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed chain ids",
+			allowed: AllowedAssetParam{
+				Denom:  "usdx",
+				Limit:  true,
+				Active: true,
+			},
+			current:       testAP,
+			incoming:      newChainIDsAP,
+			expectAllowed: false,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
@@ -703,6 +971,16 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 	newOraclesAndActiveM.Oracles = nil
 	newOraclesAndActiveM.Active = false
 
+	newTWAPWindowsM := testM
+	newTWAPWindowsM.TWAPWindows = []time.Duration{30 * time.Minute}
+
+	newGuardsM := testM
+	newGuardsM.MaxPriceDeviation = sdk.MustNewDecFromStr("0.1")
+
+	newDerivationM := testM
+	newDerivationM.Oracles = nil
+	newDerivationM.Derivation = pricefeedtypes.NewDerivation(pricefeedtypes.DerivationInverse, "usd:bnb")
+
 	testcases := []struct {
 		name          string
 		allowed       AllowedMarket
@@ -750,6 +1028,67 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 			incoming:      newOraclesAndActiveM,
 			expectAllowed: false,
 		},
+		{
+			name: "allowed twap windows change",
+			allowed: AllowedMarket{
+				MarketID:    "bnb:usd",
+				TWAPWindows: true,
+			},
+			current:       testM,
+			incoming:      newTWAPWindowsM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed twap windows change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Active:   true,
+			},
+			current:       testM,
+			incoming:      newTWAPWindowsM,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed guards change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Guards:   true,
+			},
+			current:       testM,
+			incoming:      newGuardsM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed guards change",
+			allowed: AllowedMarket{
+				MarketID:    "bnb:usd",
+				TWAPWindows: true,
+			},
+			current:       testM,
+			incoming:      newGuardsM,
+			expectAllowed: false,
+		},
+		{
+			name: "allowed derivation change",
+			allowed: AllowedMarket{
+				MarketID:   "bnb:usd",
+				Oracles:    true,
+				Derivation: true,
+			},
+			current:       testM,
+			incoming:      newDerivationM,
+			expectAllowed: true,
+		},
+		{
+			name: "un-allowed derivation change",
+			allowed: AllowedMarket{
+				MarketID: "bnb:usd",
+				Oracles:  true,
+			},
+			current:       testM,
+			incoming:      newDerivationM,
+			expectAllowed: false,
+		},
 		// TODO {
 		// 	name: "nil Int values",
 		// 	allowed: AllowedCollateralParam{
@@ -771,3 +1110,119 @@ func (suite *PermissionsTestSuite) TestAllowedMarket_Allows() {
 		})
 	}
 }
+
+func (suite *PermissionsTestSuite) TestParamBounds_Validate() {
+	testcases := []struct {
+		name       string
+		bounds     ParamBounds
+		expectPass bool
+	}{
+		{
+			name:       "valid",
+			bounds:     ParamBounds{NewParamBound("stability_fee", dp("1.0"), dp("1.000000003"), dp("0.1"), 24*time.Hour)},
+			expectPass: true,
+		},
+		{
+			name:       "valid unset limits",
+			bounds:     ParamBounds{{Field: "stability_fee"}},
+			expectPass: true,
+		},
+		{
+			name:       "blank field",
+			bounds:     ParamBounds{NewParamBound("", dp("1.0"), dp("2.0"), nil, 0)},
+			expectPass: false,
+		},
+		{
+			name:       "negative min",
+			bounds:     ParamBounds{NewParamBound("stability_fee", dp("-1.0"), dp("2.0"), nil, 0)},
+			expectPass: false,
+		},
+		{
+			name:       "min greater than max",
+			bounds:     ParamBounds{NewParamBound("stability_fee", dp("3.0"), dp("2.0"), nil, 0)},
+			expectPass: false,
+		},
+		{
+			name:       "negative max relative change",
+			bounds:     ParamBounds{NewParamBound("stability_fee", nil, nil, dp("-0.1"), 0)},
+			expectPass: false,
+		},
+		{
+			name:       "window too long",
+			bounds:     ParamBounds{NewParamBound("stability_fee", nil, nil, dp("0.1"), MaxParamBoundWindow+time.Hour)},
+			expectPass: false,
+		},
+		{
+			name:       "window without max relative change",
+			bounds:     ParamBounds{NewParamBound("stability_fee", dp("1.0"), dp("2.0"), nil, time.Hour)},
+			expectPass: false,
+		},
+		{
+			name: "duplicate fields",
+			bounds: ParamBounds{
+				NewParamBound("stability_fee", dp("1.0"), dp("2.0"), nil, 0),
+				NewParamBound("stability_fee", nil, nil, dp("0.1"), 0),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			err := tc.bounds.Validate()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestParamBounds_AllowsWindowed() {
+	bounds := ParamBounds{NewParamBound("reserve_factor", nil, nil, dp("0.1"), 24*time.Hour)}
+	reference := map[string]sdk.Dec{"reserve_factor": d("0.5")}
+	getReference := func(time.Duration) (map[string]sdk.Dec, bool) { return reference, true }
+
+	testcases := []struct {
+		name          string
+		current       sdk.Dec
+		incoming      sdk.Dec
+		expectAllowed bool
+	}{
+		{
+			name:          "allowed change within window limit",
+			current:       d("0.5"),
+			incoming:      d("0.54"),
+			expectAllowed: true,
+		},
+		{
+			name:          "allowed change back towards reference",
+			current:       d("0.55"),
+			incoming:      d("0.52"),
+			expectAllowed: true,
+		},
+		{
+			name:          "un-allowed change within proposal limit but over window limit",
+			current:       d("0.54"),
+			incoming:      d("0.58"),
+			expectAllowed: false,
+		},
+		{
+			name:          "allowed no change",
+			current:       d("0.6"),
+			incoming:      d("0.6"),
+			expectAllowed: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			current := map[string]sdk.Dec{"reserve_factor": tc.current}
+			incoming := map[string]sdk.Dec{"reserve_factor": tc.incoming}
+			// windowed bounds are not applied per proposal
+			suite.True(bounds.Allows(current, incoming))
+			suite.Equal(tc.expectAllowed, bounds.allowsWindowed(current, incoming, getReference))
+		})
+	}
+}
@@ -1,15 +1,21 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
 
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	bep3types "github.com/kava-labs/kava/x/bep3/types"
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	"github.com/kava-labs/kava/x/hard"
+	incentivetypes "github.com/kava-labs/kava/x/incentive/types"
 	"github.com/kava-labs/kava/x/pricefeed"
 	pricefeedtypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
@@ -23,6 +29,9 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(TextPermission{}, "kava/TextPermission")
 	govtypes.RegisterProposalTypeCodec(SoftwareUpgradePermission{}, "kava/SoftwareUpgradePermission")
 	govtypes.RegisterProposalTypeCodec(SubParamChangePermission{}, "kava/SubParamChangePermission")
+	govtypes.RegisterProposalTypeCodec(AssetListingPermission{}, "kava/AssetListingPermission")
+	govtypes.RegisterProposalTypeCodec(MembersChangePermission{}, "kava/MembersChangePermission")
+	govtypes.RegisterProposalTypeCodec(VotingRulesChangePermission{}, "kava/VotingRulesChangePermission")
 }
 
 // Permission is anything with a method that validates whether a proposal is allowed by it or not.
@@ -158,18 +167,141 @@ func (SoftwareUpgradePermission) MarshalYAML() (interface{}, error) {
 	return valueToMarshal, nil
 }
 
+// ------------------------------------------
+//				MembersChangePermission
+// ------------------------------------------
+
+// MembersChangePermission permission type for proposals that add or remove members of the committee itself.
+// The number of members after the change must be within the bounds of the permission.
+type MembersChangePermission struct {
+	MinMembers uint64 `json:"min_members" yaml:"min_members"`
+	MaxMembers uint64 `json:"max_members" yaml:"max_members"`
+}
+
+var _ Permission = MembersChangePermission{}
+
+// NewMembersChangePermission returns a new MembersChangePermission
+func NewMembersChangePermission(minMembers, maxMembers uint64) MembersChangePermission {
+	return MembersChangePermission{
+		MinMembers: minMembers,
+		MaxMembers: maxMembers,
+	}
+}
+
+// Validate checks the bounds are well formed
+func (perm MembersChangePermission) Validate() error {
+	if perm.MinMembers == 0 || perm.MinMembers > perm.MaxMembers {
+		return fmt.Errorf("invalid member count bounds: [%d, %d]", perm.MinMembers, perm.MaxMembers)
+	}
+	return nil
+}
+
+// Allows implement permission interface.
+// The member count bounds depend on the committee, so they are checked when the proposal is applied to it.
+func (MembersChangePermission) Allows(_ sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	_, ok := p.(MembersChangeProposal)
+	return ok
+}
+
+// AllowsMemberCount returns true if a committee can have a number of members after a members change
+func (perm MembersChangePermission) AllowsMemberCount(count int) bool {
+	return uint64(count) >= perm.MinMembers && uint64(count) <= perm.MaxMembers
+}
+
+// MarshalYAML implement yaml marshalling
+func (perm MembersChangePermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type       string `yaml:"type"`
+		MinMembers uint64 `yaml:"min_members"`
+		MaxMembers uint64 `yaml:"max_members"`
+	}{
+		Type:       "members_change_permission",
+		MinMembers: perm.MinMembers,
+		MaxMembers: perm.MaxMembers,
+	}
+	return valueToMarshal, nil
+}
+
+// ------------------------------------------
+//				VotingRulesChangePermission
+// ------------------------------------------
+
+// VotingRulesChangePermission permission type for proposals that change the vote threshold and proposal duration of the committee itself.
+// The new values must be within the bounds of the permission.
+type VotingRulesChangePermission struct {
+	MinVoteThreshold    sdk.Dec       `json:"min_vote_threshold" yaml:"min_vote_threshold"`
+	MaxVoteThreshold    sdk.Dec       `json:"max_vote_threshold" yaml:"max_vote_threshold"`
+	MinProposalDuration time.Duration `json:"min_proposal_duration" yaml:"min_proposal_duration"`
+	MaxProposalDuration time.Duration `json:"max_proposal_duration" yaml:"max_proposal_duration"`
+}
+
+var _ Permission = VotingRulesChangePermission{}
+
+// NewVotingRulesChangePermission returns a new VotingRulesChangePermission
+func NewVotingRulesChangePermission(minVoteThreshold, maxVoteThreshold sdk.Dec, minProposalDuration, maxProposalDuration time.Duration) VotingRulesChangePermission {
+	return VotingRulesChangePermission{
+		MinVoteThreshold:    minVoteThreshold,
+		MaxVoteThreshold:    maxVoteThreshold,
+		MinProposalDuration: minProposalDuration,
+		MaxProposalDuration: maxProposalDuration,
+	}
+}
+
+// Validate checks the bounds are well formed
+func (perm VotingRulesChangePermission) Validate() error {
+	if perm.MinVoteThreshold.IsNil() || perm.MaxVoteThreshold.IsNil() {
+		return fmt.Errorf("vote threshold bounds must be set")
+	}
+	if !perm.MinVoteThreshold.IsPositive() || perm.MinVoteThreshold.GT(perm.MaxVoteThreshold) || perm.MaxVoteThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("invalid vote threshold bounds: [%s, %s]", perm.MinVoteThreshold, perm.MaxVoteThreshold)
+	}
+	if perm.MinProposalDuration < 0 || perm.MinProposalDuration > perm.MaxProposalDuration {
+		return fmt.Errorf("invalid proposal duration bounds: [%s, %s]", perm.MinProposalDuration, perm.MaxProposalDuration)
+	}
+	return nil
+}
+
+// Allows implement permission interface
+func (perm VotingRulesChangePermission) Allows(_ sdk.Context, _ *codec.Codec, _ ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(VotingRulesChangeProposal)
+	if !ok || proposal.VoteThreshold.IsNil() || perm.MinVoteThreshold.IsNil() || perm.MaxVoteThreshold.IsNil() {
+		return false
+	}
+	return proposal.VoteThreshold.GTE(perm.MinVoteThreshold) && proposal.VoteThreshold.LTE(perm.MaxVoteThreshold) &&
+		proposal.ProposalDuration >= perm.MinProposalDuration && proposal.ProposalDuration <= perm.MaxProposalDuration
+}
+
+// MarshalYAML implement yaml marshalling
+func (perm VotingRulesChangePermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type                string        `yaml:"type"`
+		MinVoteThreshold    sdk.Dec       `yaml:"min_vote_threshold"`
+		MaxVoteThreshold    sdk.Dec       `yaml:"max_vote_threshold"`
+		MinProposalDuration time.Duration `yaml:"min_proposal_duration"`
+		MaxProposalDuration time.Duration `yaml:"max_proposal_duration"`
+	}{
+		Type:                "voting_rules_change_permission",
+		MinVoteThreshold:    perm.MinVoteThreshold,
+		MaxVoteThreshold:    perm.MaxVoteThreshold,
+		MinProposalDuration: perm.MinProposalDuration,
+		MaxProposalDuration: perm.MaxProposalDuration,
+	}
+	return valueToMarshal, nil
+}
+
 // ------------------------------------------
 //				SubParamChangePermission
 // ------------------------------------------
 
 // SubParamChangePermission permission type for allowing changes to specific sub-keys within module parameter keys
 type SubParamChangePermission struct {
-	AllowedParams           AllowedParams           `json:"allowed_params" yaml:"allowed_params"`
-	AllowedCollateralParams AllowedCollateralParams `json:"allowed_collateral_params" yaml:"allowed_collateral_params"`
-	AllowedDebtParam        AllowedDebtParam        `json:"allowed_debt_param" yaml:"allowed_debt_param"`
-	AllowedAssetParams      AllowedAssetParams      `json:"allowed_asset_params" yaml:"allowed_asset_params"`
-	AllowedMarkets          AllowedMarkets          `json:"allowed_markets" yaml:"allowed_markets"`
-	AllowedMoneyMarkets     AllowedMoneyMarkets     `json:"allowed_money_markets" yaml:"allowed_money_markets"`
+	AllowedParams             AllowedParams             `json:"allowed_params" yaml:"allowed_params"`
+	AllowedCollateralParams   AllowedCollateralParams   `json:"allowed_collateral_params" yaml:"allowed_collateral_params"`
+	AllowedDebtParam          AllowedDebtParam          `json:"allowed_debt_param" yaml:"allowed_debt_param"`
+	AllowedAssetParams        AllowedAssetParams        `json:"allowed_asset_params" yaml:"allowed_asset_params"`
+	AllowedMarkets            AllowedMarkets            `json:"allowed_markets" yaml:"allowed_markets"`
+	AllowedMoneyMarkets       AllowedMoneyMarkets       `json:"allowed_money_markets" yaml:"allowed_money_markets"`
+	AllowedAuctionDenomParams AllowedAuctionDenomParams `json:"allowed_auction_denom_params" yaml:"allowed_auction_denom_params"`
 }
 
 var _ Permission = SubParamChangePermission{}
@@ -177,25 +309,50 @@ var _ Permission = SubParamChangePermission{}
 // MarshalYAML implement yaml marshalling
 func (perm SubParamChangePermission) MarshalYAML() (interface{}, error) {
 	valueToMarshal := struct {
-		Type                    string                  `yaml:"type" json:"type"`
-		AllowedParams           AllowedParams           `yaml:"allowed_params" json:"allowed_params"`
-		AllowedCollateralParams AllowedCollateralParams `yaml:"allowed_collateral_params" json:"allowed_collateral_params"`
-		AllowedDebtParam        AllowedDebtParam        `yaml:"allowed_debt_param" json:"allowed_debt_param"`
-		AllowedAssetParams      AllowedAssetParams      `yaml:"allowed_asset_params" json:"allowed_asset_params"`
-		AllowedMarkets          AllowedMarkets          `yaml:"allowed_markets" json:"allowed_markets"`
-		AllowedMoneyMarkets     AllowedMoneyMarkets     `json:"allowed_money_markets" yaml:"allowed_money_markets"`
+		Type                      string                    `yaml:"type" json:"type"`
+		AllowedParams             AllowedParams             `yaml:"allowed_params" json:"allowed_params"`
+		AllowedCollateralParams   AllowedCollateralParams   `yaml:"allowed_collateral_params" json:"allowed_collateral_params"`
+		AllowedDebtParam          AllowedDebtParam          `yaml:"allowed_debt_param" json:"allowed_debt_param"`
+		AllowedAssetParams        AllowedAssetParams        `yaml:"allowed_asset_params" json:"allowed_asset_params"`
+		AllowedMarkets            AllowedMarkets            `yaml:"allowed_markets" json:"allowed_markets"`
+		AllowedMoneyMarkets       AllowedMoneyMarkets       `json:"allowed_money_markets" yaml:"allowed_money_markets"`
+		AllowedAuctionDenomParams AllowedAuctionDenomParams `json:"allowed_auction_denom_params" yaml:"allowed_auction_denom_params"`
 	}{
-		Type:                    "param_change_permission",
-		AllowedParams:           perm.AllowedParams,
-		AllowedCollateralParams: perm.AllowedCollateralParams,
-		AllowedDebtParam:        perm.AllowedDebtParam,
-		AllowedAssetParams:      perm.AllowedAssetParams,
-		AllowedMarkets:          perm.AllowedMarkets,
-		AllowedMoneyMarkets:     perm.AllowedMoneyMarkets,
+		Type:                      "param_change_permission",
+		AllowedParams:             perm.AllowedParams,
+		AllowedCollateralParams:   perm.AllowedCollateralParams,
+		AllowedDebtParam:          perm.AllowedDebtParam,
+		AllowedAssetParams:        perm.AllowedAssetParams,
+		AllowedMarkets:            perm.AllowedMarkets,
+		AllowedMoneyMarkets:       perm.AllowedMoneyMarkets,
+		AllowedAuctionDenomParams: perm.AllowedAuctionDenomParams,
 	}
 	return valueToMarshal, nil
 }
 
+// Validate checks any bounds on the allowed params are well formed
+func (perm SubParamChangePermission) Validate() error {
+	for _, acp := range perm.AllowedCollateralParams {
+		if err := validateBounds(acp.Bounds, collateralParamValues(cdptypes.CollateralParam{})); err != nil {
+			return fmt.Errorf("invalid bounds for collateral type %s: %w", acp.Type, err)
+		}
+	}
+	if err := validateBounds(perm.AllowedDebtParam.Bounds, debtParamValues(cdptypes.DebtParam{})); err != nil {
+		return fmt.Errorf("invalid bounds for debt param: %w", err)
+	}
+	for _, amm := range perm.AllowedMoneyMarkets {
+		if err := validateBounds(amm.Bounds, moneyMarketValues(hard.MoneyMarket{})); err != nil {
+			return fmt.Errorf("invalid bounds for money market %s: %w", amm.Denom, err)
+		}
+	}
+	for _, aadp := range perm.AllowedAuctionDenomParams {
+		if err := validateBounds(aadp.Bounds, auctionDenomParamValues(auctiontypes.DenomParam{})); err != nil {
+			return fmt.Errorf("invalid bounds for auction denom %s: %w", aadp.Denom, err)
+		}
+	}
+	return nil
+}
+
 // Allows implement permission interface
 func (perm SubParamChangePermission) Allows(ctx sdk.Context, appCdc *codec.Codec, pk ParamKeeper, p PubProposal) bool {
 	// Check pubproposal has correct type
@@ -239,6 +396,13 @@ func (perm SubParamChangePermission) Allows(ctx sdk.Context, appCdc *codec.Codec
 		if !collateralParamChangesAllowed {
 			return false
 		}
+		// Check the changes are within any bounds that apply over a time window
+		getReference := func(window time.Duration, ptr interface{}) bool {
+			return getParamAtWindowStart(ctx, appCdc, pk, cdptypes.ModuleName, cdptypes.KeyCollateralParams, window, ptr)
+		}
+		if !perm.AllowedCollateralParams.allowsWindowed(currentCP, incomingCP, getReference) {
+			return false
+		}
 	}
 
 	// Check any DebtParam changes are allowed
@@ -271,6 +435,13 @@ func (perm SubParamChangePermission) Allows(ctx sdk.Context, appCdc *codec.Codec
 		if !debtParamChangeAllowed {
 			return false
 		}
+		// Check the changes are within any bounds that apply over a time window
+		getReference := func(window time.Duration, ptr interface{}) bool {
+			return getParamAtWindowStart(ctx, appCdc, pk, cdptypes.ModuleName, cdptypes.KeyDebtParam, window, ptr)
+		}
+		if !perm.AllowedDebtParam.allowsWindowed(currentDP, incomingDP, getReference) {
+			return false
+		}
 	}
 
 	// Check any AssetParams changes are allowed
@@ -362,6 +533,47 @@ func (perm SubParamChangePermission) Allows(ctx sdk.Context, appCdc *codec.Codec
 		if !mmChangesAllowed {
 			return false
 		}
+		// Check the changes are within any bounds that apply over a time window
+		getReference := func(window time.Duration, ptr interface{}) bool {
+			return getParamAtWindowStart(ctx, appCdc, pk, hard.ModuleName, hard.KeyMoneyMarkets, window, ptr)
+		}
+		if !perm.AllowedMoneyMarkets.allowsWindowed(currentMMs, incomingMMs, getReference) {
+			return false
+		}
+	}
+
+	// Check any auction DenomParams changes are allowed
+
+	var foundIncomingADPs bool
+	var incomingADPs auctiontypes.DenomParams
+	for _, change := range proposal.Changes {
+		if !(change.Subspace == auctiontypes.ModuleName && change.Key == string(auctiontypes.KeyDenomParams)) {
+			continue
+		}
+		foundIncomingADPs = true
+		if err := appCdc.UnmarshalJSON([]byte(change.Value), &incomingADPs); err != nil {
+			return false
+		}
+	}
+
+	if foundIncomingADPs {
+		subspace, found := pk.GetSubspace(auctiontypes.ModuleName)
+		if !found {
+			return false
+		}
+		var currentADPs auctiontypes.DenomParams
+		subspace.Get(ctx, auctiontypes.KeyDenomParams, &currentADPs)
+		adpChangesAllowed := perm.AllowedAuctionDenomParams.Allows(currentADPs, incomingADPs)
+		if !adpChangesAllowed {
+			return false
+		}
+		// Check the changes are within any bounds that apply over a time window
+		getReference := func(window time.Duration, ptr interface{}) bool {
+			return getParamAtWindowStart(ctx, appCdc, pk, auctiontypes.ModuleName, auctiontypes.KeyDenomParams, window, ptr)
+		}
+		if !perm.AllowedAuctionDenomParams.allowsWindowed(currentADPs, incomingADPs, getReference) {
+			return false
+		}
 	}
 
 	return true
@@ -421,19 +633,20 @@ func (acps AllowedCollateralParams) Allows(current, incoming cdptypes.Collateral
 
 // AllowedCollateralParam permission struct for changes to collateral parameter keys (cdp module)
 type AllowedCollateralParam struct {
-	Type                             string `json:"type" yaml:"type"`
-	Denom                            bool   `json:"denom" yaml:"denom"`
-	LiquidationRatio                 bool   `json:"liquidation_ratio" yaml:"liquidation_ratio"`
-	DebtLimit                        bool   `json:"debt_limit" yaml:"debt_limit"`
-	StabilityFee                     bool   `json:"stability_fee" yaml:"stability_fee"`
-	AuctionSize                      bool   `json:"auction_size" yaml:"auction_size"`
-	LiquidationPenalty               bool   `json:"liquidation_penalty" yaml:"liquidation_penalty"`
-	Prefix                           bool   `json:"prefix" yaml:"prefix"`
-	SpotMarketID                     bool   `json:"spot_market_id" yaml:"spot_market_id"`
-	LiquidationMarketID              bool   `json:"liquidation_market_id" yaml:"liquidation_market_id"`
-	ConversionFactor                 bool   `json:"conversion_factor" yaml:"conversion_factor"`
-	KeeperRewardPercentage           bool   `json:"keeper_reward_percentage" yaml:"keeper_reward_percentage"`
-	CheckCollateralizationIndexCount bool   `json:"check_collateralization_index_count" yaml:"check_collateralization_index_count"`
+	Type                             string      `json:"type" yaml:"type"`
+	Denom                            bool        `json:"denom" yaml:"denom"`
+	LiquidationRatio                 bool        `json:"liquidation_ratio" yaml:"liquidation_ratio"`
+	DebtLimit                        bool        `json:"debt_limit" yaml:"debt_limit"`
+	StabilityFee                     bool        `json:"stability_fee" yaml:"stability_fee"`
+	AuctionSize                      bool        `json:"auction_size" yaml:"auction_size"`
+	LiquidationPenalty               bool        `json:"liquidation_penalty" yaml:"liquidation_penalty"`
+	Prefix                           bool        `json:"prefix" yaml:"prefix"`
+	SpotMarketID                     bool        `json:"spot_market_id" yaml:"spot_market_id"`
+	LiquidationMarketID              bool        `json:"liquidation_market_id" yaml:"liquidation_market_id"`
+	ConversionFactor                 bool        `json:"conversion_factor" yaml:"conversion_factor"`
+	KeeperRewardPercentage           bool        `json:"keeper_reward_percentage" yaml:"keeper_reward_percentage"`
+	CheckCollateralizationIndexCount bool        `json:"check_collateralization_index_count" yaml:"check_collateralization_index_count"`
+	Bounds                           ParamBounds `json:"bounds,omitempty" yaml:"bounds,omitempty"`
 }
 
 // NewAllowedCollateralParam return a new AllowedCollateralParam
@@ -472,16 +685,73 @@ func (acp AllowedCollateralParam) Allows(current, incoming cdptypes.CollateralPa
 		((current.LiquidationMarketID == incoming.LiquidationMarketID) || acp.LiquidationMarketID) &&
 		((current.KeeperRewardPercentage.Equal(incoming.KeeperRewardPercentage)) || acp.KeeperRewardPercentage) &&
 		((current.CheckCollateralizationIndexCount.Equal(incoming.CheckCollateralizationIndexCount)) || acp.CheckCollateralizationIndexCount) &&
-		(current.ConversionFactor.Equal(incoming.ConversionFactor) || acp.ConversionFactor)
+		(current.ConversionFactor.Equal(incoming.ConversionFactor) || acp.ConversionFactor) &&
+		acp.Bounds.Allows(collateralParamValues(current), collateralParamValues(incoming))
 	return allowed
 }
 
+// collateralParamValues returns the numeric fields of a collateral param that can be bounded
+func collateralParamValues(cp cdptypes.CollateralParam) map[string]sdk.Dec {
+	return map[string]sdk.Dec{
+		"liquidation_ratio":                   cp.LiquidationRatio,
+		"debt_limit":                          intToDec(cp.DebtLimit.Amount),
+		"stability_fee":                       cp.StabilityFee,
+		"auction_size":                        intToDec(cp.AuctionSize),
+		"liquidation_penalty":                 cp.LiquidationPenalty,
+		"keeper_reward_percentage":            cp.KeeperRewardPercentage,
+		"check_collateralization_index_count": intToDec(cp.CheckCollateralizationIndexCount),
+		"conversion_factor":                   intToDec(cp.ConversionFactor),
+	}
+}
+
+// findCollateralParam returns the collateral param with a collateral type
+func findCollateralParam(cps cdptypes.CollateralParams, ctype string) (cdptypes.CollateralParam, bool) {
+	for _, cp := range cps {
+		if cp.Type == ctype {
+			return cp, true
+		}
+	}
+	return cdptypes.CollateralParam{}, false
+}
+
+// allowsWindowed determines if collateral param changes are within the bounds that apply over a time window.
+// getReference fills in the collateral params from the start of a window.
+func (acps AllowedCollateralParams) allowsWindowed(current, incoming cdptypes.CollateralParams, getReference func(time.Duration, interface{}) bool) bool {
+	for _, incomingCP := range incoming {
+		for _, acp := range acps {
+			if acp.Type != incomingCP.Type {
+				continue
+			}
+			currentCP, found := findCollateralParam(current, incomingCP.Type)
+			if !found {
+				return false
+			}
+			allowed := acp.Bounds.allowsWindowed(collateralParamValues(currentCP), collateralParamValues(incomingCP), func(window time.Duration) (map[string]sdk.Dec, bool) {
+				var reference cdptypes.CollateralParams
+				if !getReference(window, &reference) {
+					return nil, false
+				}
+				referenceCP, found := findCollateralParam(reference, incomingCP.Type)
+				if !found {
+					return nil, false
+				}
+				return collateralParamValues(referenceCP), true
+			})
+			if !allowed {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // AllowedDebtParam permission struct for changes to debt parameter keys (cdp module)
 type AllowedDebtParam struct {
-	Denom            bool `json:"denom" yaml:"denom"`
-	ReferenceAsset   bool `json:"reference_asset" yaml:"reference_asset"`
-	ConversionFactor bool `json:"conversion_factor" yaml:"conversion_factor"`
-	DebtFloor        bool `json:"debt_floor" yaml:"debt_floor"`
+	Denom            bool        `json:"denom" yaml:"denom"`
+	ReferenceAsset   bool        `json:"reference_asset" yaml:"reference_asset"`
+	ConversionFactor bool        `json:"conversion_factor" yaml:"conversion_factor"`
+	DebtFloor        bool        `json:"debt_floor" yaml:"debt_floor"`
+	Bounds           ParamBounds `json:"bounds,omitempty" yaml:"bounds,omitempty"`
 }
 
 // Allows determines if debt params changes are permitted
@@ -489,10 +759,31 @@ func (adp AllowedDebtParam) Allows(current, incoming cdptypes.DebtParam) bool {
 	allowed := ((current.Denom == incoming.Denom) || adp.Denom) &&
 		((current.ReferenceAsset == incoming.ReferenceAsset) || adp.ReferenceAsset) &&
 		(current.ConversionFactor.Equal(incoming.ConversionFactor) || adp.ConversionFactor) &&
-		(current.DebtFloor.Equal(incoming.DebtFloor) || adp.DebtFloor)
+		(current.DebtFloor.Equal(incoming.DebtFloor) || adp.DebtFloor) &&
+		adp.Bounds.Allows(debtParamValues(current), debtParamValues(incoming))
 	return allowed
 }
 
+// debtParamValues returns the numeric fields of a debt param that can be bounded
+func debtParamValues(dp cdptypes.DebtParam) map[string]sdk.Dec {
+	return map[string]sdk.Dec{
+		"conversion_factor": intToDec(dp.ConversionFactor),
+		"debt_floor":        intToDec(dp.DebtFloor),
+	}
+}
+
+// allowsWindowed determines if debt param changes are within the bounds that apply over a time window.
+// getReference fills in the debt param from the start of a window.
+func (adp AllowedDebtParam) allowsWindowed(current, incoming cdptypes.DebtParam, getReference func(time.Duration, interface{}) bool) bool {
+	return adp.Bounds.allowsWindowed(debtParamValues(current), debtParamValues(incoming), func(window time.Duration) (map[string]sdk.Dec, bool) {
+		var reference cdptypes.DebtParam
+		if !getReference(window, &reference) {
+			return nil, false
+		}
+		return debtParamValues(reference), true
+	})
+}
+
 // AllowedAssetParams slice of AllowedAssetParam
 type AllowedAssetParams []AllowedAssetParam
 
@@ -547,12 +838,16 @@ func (aaps AllowedAssetParams) Allows(current, incoming bep3types.AssetParams) b
 
 // AllowedAssetParam bep3 asset parameters that can be changed by committee
 type AllowedAssetParam struct {
-	Denom         string `json:"denom" yaml:"denom"`
-	CoinID        bool   `json:"coin_id" yaml:"coin_id"`
-	Limit         bool   `json:"limit" yaml:"limit"`
-	Active        bool   `json:"active" yaml:"active"`
-	MaxSwapAmount bool   `json:"max_swap_amount" yaml:"max_swap_amount"`
-	MinBlockLock  bool   `json:"min_block_lock" yaml:"min_block_lock"`
+	Denom          string `json:"denom" yaml:"denom"`
+	CoinID         bool   `json:"coin_id" yaml:"coin_id"`
+	Limit          bool   `json:"limit" yaml:"limit"`
+	Active         bool   `json:"active" yaml:"active"`
+	MaxSwapAmount  bool   `json:"max_swap_amount" yaml:"max_swap_amount"`
+	MinBlockLock   bool   `json:"min_block_lock" yaml:"min_block_lock"`
+	DeputyRotation bool   `json:"deputy_rotation" yaml:"deputy_rotation"`
+	SwapQuota      bool   `json:"swap_quota" yaml:"swap_quota"`
+	TimeExpiry     bool   `json:"time_expiry" yaml:"time_expiry"`
+	ChainIDs       bool   `json:"chain_ids" yaml:"chain_ids"`
 }
 
 // Allows bep3 AssetParam parameters than can be changed by committee
@@ -563,7 +858,11 @@ func (aap AllowedAssetParam) Allows(current, incoming bep3types.AssetParam) bool
 		(current.SupplyLimit.Equals(incoming.SupplyLimit) || aap.Limit) &&
 		((current.Active == incoming.Active) || aap.Active) &&
 		((current.MaxSwapAmount.Equal(incoming.MaxSwapAmount)) || aap.MaxSwapAmount) &&
-		((current.MinBlockLock == incoming.MinBlockLock) || aap.MinBlockLock)
+		((current.MinBlockLock == incoming.MinBlockLock) || aap.MinBlockLock) &&
+		(current.DeputyRotation.Equal(incoming.DeputyRotation) || aap.DeputyRotation) &&
+		(current.SwapQuota.Equal(incoming.SwapQuota) || aap.SwapQuota) &&
+		((current.TimeExpiry == incoming.TimeExpiry) || aap.TimeExpiry) &&
+		(stringsEqual(current.ChainIDs, incoming.ChainIDs) || aap.ChainIDs)
 	return allowed
 }
 
@@ -621,11 +920,14 @@ func (ams AllowedMarkets) Allows(current, incoming pricefeedtypes.Markets) bool
 
 // AllowedMarket permission struct for market parameters (pricefeed module)
 type AllowedMarket struct {
-	MarketID   string `json:"market_id" yaml:"market_id"`
-	BaseAsset  bool   `json:"base_asset" yaml:"base_asset"`
-	QuoteAsset bool   `json:"quote_asset" yaml:"quote_asset"`
-	Oracles    bool   `json:"oracles" yaml:"oracles"`
-	Active     bool   `json:"active" yaml:"active"`
+	MarketID    string `json:"market_id" yaml:"market_id"`
+	BaseAsset   bool   `json:"base_asset" yaml:"base_asset"`
+	QuoteAsset  bool   `json:"quote_asset" yaml:"quote_asset"`
+	Oracles     bool   `json:"oracles" yaml:"oracles"`
+	Active      bool   `json:"active" yaml:"active"`
+	TWAPWindows bool   `json:"twap_windows,omitempty" yaml:"twap_windows,omitempty"`
+	Guards      bool   `json:"guards,omitempty" yaml:"guards,omitempty"` // max price deviation, deviation period, resume updates, and min oracles
+	Derivation  bool   `json:"derivation,omitempty" yaml:"derivation,omitempty"`
 }
 
 // Allows determines if market param changes are permitted
@@ -634,7 +936,10 @@ func (am AllowedMarket) Allows(current, incoming pricefeedtypes.Market) bool {
 		((current.BaseAsset == incoming.BaseAsset) || am.BaseAsset) &&
 		((current.QuoteAsset == incoming.QuoteAsset) || am.QuoteAsset) &&
 		(addressesEqual(current.Oracles, incoming.Oracles) || am.Oracles) &&
-		((current.Active == incoming.Active) || am.Active)
+		((current.Active == incoming.Active) || am.Active) &&
+		(durationsEqual(current.TWAPWindows, incoming.TWAPWindows) || am.TWAPWindows) &&
+		(guardsEqual(current, incoming) || am.Guards) &&
+		(derivationsEqual(current.Derivation, incoming.Derivation) || am.Derivation)
 	return allowed
 }
 
@@ -650,15 +955,73 @@ func addressesEqual(addrs1, addrs2 []sdk.AccAddress) bool {
 	return areEqual
 }
 
+// stringsEqual check if slices of strings are equal, the order matters
+func stringsEqual(strs1, strs2 []string) bool {
+	if len(strs1) != len(strs2) {
+		return false
+	}
+	for i := range strs1 {
+		if strs1[i] != strs2[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// guardsEqual check if the price guards of two markets are equal
+func guardsEqual(m1, m2 pricefeedtypes.Market) bool {
+	deviation1, deviation2 := m1.MaxPriceDeviation, m2.MaxPriceDeviation
+	if deviation1.IsNil() {
+		deviation1 = sdk.ZeroDec()
+	}
+	if deviation2.IsNil() {
+		deviation2 = sdk.ZeroDec()
+	}
+	return deviation1.Equal(deviation2) &&
+		m1.DeviationPeriod == m2.DeviationPeriod &&
+		m1.ResumeUpdates == m2.ResumeUpdates &&
+		m1.MinOracles == m2.MinOracles
+}
+
+// derivationsEqual check if two market derivations are equal, the order of inputs matters
+func derivationsEqual(d1, d2 *pricefeedtypes.Derivation) bool {
+	if d1 == nil || d2 == nil {
+		return d1 == d2
+	}
+	if d1.Operation != d2.Operation || len(d1.Inputs) != len(d2.Inputs) {
+		return false
+	}
+	for i := range d1.Inputs {
+		if d1.Inputs[i] != d2.Inputs[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// durationsEqual check if slices of durations are equal, the order matters
+func durationsEqual(durations1, durations2 []time.Duration) bool {
+	if len(durations1) != len(durations2) {
+		return false
+	}
+	for i := range durations1 {
+		if durations1[i] != durations2[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // AllowedMoneyMarket permission struct for money market parameters (hard module)
 type AllowedMoneyMarket struct {
-	Denom                  string `json:"denom" yaml:"denom"`
-	BorrowLimit            bool   `json:"borrow_limit" yaml:"borrow_limit"`
-	SpotMarketID           bool   `json:"spot_market_id" yaml:"spot_market_id"`
-	ConversionFactor       bool   `json:"conversion_factor" yaml:"conversion_factor"`
-	InterestRateModel      bool   `json:"interest_rate_model" yaml:"interest_rate_model"`
-	ReserveFactor          bool   `json:"reserve_factor" yaml:"reserve_factor"`
-	KeeperRewardPercentage bool   `json:"keeper_reward_percentage" yaml:"keeper_reward_percentage"`
+	Denom                  string      `json:"denom" yaml:"denom"`
+	BorrowLimit            bool        `json:"borrow_limit" yaml:"borrow_limit"`
+	SpotMarketID           bool        `json:"spot_market_id" yaml:"spot_market_id"`
+	ConversionFactor       bool        `json:"conversion_factor" yaml:"conversion_factor"`
+	InterestRateModel      bool        `json:"interest_rate_model" yaml:"interest_rate_model"`
+	ReserveFactor          bool        `json:"reserve_factor" yaml:"reserve_factor"`
+	KeeperRewardPercentage bool        `json:"keeper_reward_percentage" yaml:"keeper_reward_percentage"`
+	Bounds                 ParamBounds `json:"bounds,omitempty" yaml:"bounds,omitempty"`
 }
 
 // NewAllowedMoneyMarket returns a new AllowedMoneyMarket
@@ -682,10 +1045,36 @@ func (amm AllowedMoneyMarket) Allows(current, incoming hard.MoneyMarket) bool {
 		((current.ConversionFactor.Equal(incoming.ConversionFactor)) || amm.ConversionFactor) &&
 		((current.InterestRateModel.Equal(incoming.InterestRateModel)) || amm.InterestRateModel) &&
 		((current.ReserveFactor.Equal(incoming.ReserveFactor)) || amm.ReserveFactor) &&
-		((current.KeeperRewardPercentage.Equal(incoming.KeeperRewardPercentage)) || amm.KeeperRewardPercentage)
+		((current.KeeperRewardPercentage.Equal(incoming.KeeperRewardPercentage)) || amm.KeeperRewardPercentage) &&
+		amm.Bounds.Allows(moneyMarketValues(current), moneyMarketValues(incoming))
 	return allowed
 }
 
+// moneyMarketValues returns the numeric fields of a money market that can be bounded
+func moneyMarketValues(mm hard.MoneyMarket) map[string]sdk.Dec {
+	return map[string]sdk.Dec{
+		"borrow_limit.maximum_limit":          mm.BorrowLimit.MaximumLimit,
+		"borrow_limit.loan_to_value":          mm.BorrowLimit.LoanToValue,
+		"conversion_factor":                   intToDec(mm.ConversionFactor),
+		"interest_rate_model.base_rate_apy":   mm.InterestRateModel.BaseRateAPY,
+		"interest_rate_model.base_multiplier": mm.InterestRateModel.BaseMultiplier,
+		"interest_rate_model.kink":            mm.InterestRateModel.Kink,
+		"interest_rate_model.jump_multiplier": mm.InterestRateModel.JumpMultiplier,
+		"reserve_factor":                      mm.ReserveFactor,
+		"keeper_reward_percentage":            mm.KeeperRewardPercentage,
+	}
+}
+
+// findMoneyMarket returns the money market with a denom
+func findMoneyMarket(mms hard.MoneyMarkets, denom string) (hard.MoneyMarket, bool) {
+	for _, mm := range mms {
+		if mm.Denom == denom {
+			return mm, true
+		}
+	}
+	return hard.MoneyMarket{}, false
+}
+
 // AllowedMoneyMarkets slice of AllowedMoneyMarket
 type AllowedMoneyMarkets []AllowedMoneyMarket
 
@@ -731,3 +1120,872 @@ func (amms AllowedMoneyMarkets) Allows(current, incoming hard.MoneyMarkets) bool
 
 	return allAllowed
 }
+
+// allowsWindowed determines if money market changes are within the bounds that apply over a time window.
+// getReference fills in the money markets from the start of a window.
+func (amms AllowedMoneyMarkets) allowsWindowed(current, incoming hard.MoneyMarkets, getReference func(time.Duration, interface{}) bool) bool {
+	for _, incomingMM := range incoming {
+		for _, amm := range amms {
+			if amm.Denom != incomingMM.Denom {
+				continue
+			}
+			currentMM, found := findMoneyMarket(current, incomingMM.Denom)
+			if !found {
+				return false
+			}
+			allowed := amm.Bounds.allowsWindowed(moneyMarketValues(currentMM), moneyMarketValues(incomingMM), func(window time.Duration) (map[string]sdk.Dec, bool) {
+				var reference hard.MoneyMarkets
+				if !getReference(window, &reference) {
+					return nil, false
+				}
+				referenceMM, found := findMoneyMarket(reference, incomingMM.Denom)
+				if !found {
+					return nil, false
+				}
+				return moneyMarketValues(referenceMM), true
+			})
+			if !allowed {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// AllowedAuctionDenomParam permission struct for per denom auction parameters (auction module)
+type AllowedAuctionDenomParam struct {
+	Denom               string      `json:"denom" yaml:"denom"`
+	MaxAuctionDuration  bool        `json:"max_auction_duration" yaml:"max_auction_duration"`
+	BidDuration         bool        `json:"bid_duration" yaml:"bid_duration"`
+	IncrementSurplus    bool        `json:"increment_surplus" yaml:"increment_surplus"`
+	IncrementDebt       bool        `json:"increment_debt" yaml:"increment_debt"`
+	IncrementCollateral bool        `json:"increment_collateral" yaml:"increment_collateral"`
+	Bounds              ParamBounds `json:"bounds,omitempty" yaml:"bounds,omitempty"`
+}
+
+// NewAllowedAuctionDenomParam returns a new AllowedAuctionDenomParam
+func NewAllowedAuctionDenomParam(denom string, mad, bd, is, id, ic bool) AllowedAuctionDenomParam {
+	return AllowedAuctionDenomParam{
+		Denom:               denom,
+		MaxAuctionDuration:  mad,
+		BidDuration:         bd,
+		IncrementSurplus:    is,
+		IncrementDebt:       id,
+		IncrementCollateral: ic,
+	}
+}
+
+// Allows determines if auction denom param changes are permitted
+func (aadp AllowedAuctionDenomParam) Allows(current, incoming auctiontypes.DenomParam) bool {
+	allowed := ((aadp.Denom == current.Denom) && (aadp.Denom == incoming.Denom)) &&
+		((current.MaxAuctionDuration == incoming.MaxAuctionDuration) || aadp.MaxAuctionDuration) &&
+		((current.BidDuration == incoming.BidDuration) || aadp.BidDuration) &&
+		((current.IncrementSurplus.Equal(incoming.IncrementSurplus)) || aadp.IncrementSurplus) &&
+		((current.IncrementDebt.Equal(incoming.IncrementDebt)) || aadp.IncrementDebt) &&
+		((current.IncrementCollateral.Equal(incoming.IncrementCollateral)) || aadp.IncrementCollateral) &&
+		aadp.Bounds.Allows(auctionDenomParamValues(current), auctionDenomParamValues(incoming))
+	return allowed
+}
+
+// auctionDenomParamValues returns the numeric fields of an auction denom param that can be bounded
+func auctionDenomParamValues(dp auctiontypes.DenomParam) map[string]sdk.Dec {
+	return map[string]sdk.Dec{
+		"increment_surplus":    dp.IncrementSurplus,
+		"increment_debt":       dp.IncrementDebt,
+		"increment_collateral": dp.IncrementCollateral,
+	}
+}
+
+// findAuctionDenomParam returns the auction denom param with a denom
+func findAuctionDenomParam(dps auctiontypes.DenomParams, denom string) (auctiontypes.DenomParam, bool) {
+	for _, dp := range dps {
+		if dp.Denom == denom {
+			return dp, true
+		}
+	}
+	return auctiontypes.DenomParam{}, false
+}
+
+// allowsAll returns true if every field of the denom param may be changed
+func (aadp AllowedAuctionDenomParam) allowsAll() bool {
+	return aadp.MaxAuctionDuration && aadp.BidDuration && aadp.IncrementSurplus && aadp.IncrementDebt && aadp.IncrementCollateral
+}
+
+// AllowedAuctionDenomParams slice of AllowedAuctionDenomParam
+type AllowedAuctionDenomParams []AllowedAuctionDenomParam
+
+// Allows determines if auction denom params changes are permitted.
+// Unlike other list params, overrides can be added or removed, but only for denoms where every field is allowed to change.
+// Overrides for denoms not in the allowed list must be left unchanged.
+func (aadps AllowedAuctionDenomParams) Allows(current, incoming auctiontypes.DenomParams) bool {
+	allAllowed := true
+
+	// check every incoming override is allowed
+	for _, incomingDP := range incoming {
+		var foundCurrentDP bool
+		var currentDP auctiontypes.DenomParam
+		for _, p := range current {
+			if p.Denom != incomingDP.Denom {
+				continue
+			}
+			foundCurrentDP = true
+			currentDP = p
+		}
+
+		var foundAllowedDP bool
+		var allowedDP AllowedAuctionDenomParam
+		for _, p := range aadps {
+			if p.Denom != incomingDP.Denom {
+				continue
+			}
+			foundAllowedDP = true
+			allowedDP = p
+		}
+		if !foundAllowedDP {
+			// denoms that are not allowed must not change
+			allAllowed = allAllowed && foundCurrentDP && currentDP.Equal(incomingDP)
+			continue
+		}
+
+		if !foundCurrentDP {
+			// adding an override sets every field
+			allAllowed = allAllowed && allowedDP.allowsAll() && allowedDP.Bounds.allowsValues(auctionDenomParamValues(incomingDP))
+			continue
+		}
+		allowed := allowedDP.Allows(currentDP, incomingDP)
+		allAllowed = allAllowed && allowed
+	}
+
+	// check any removed overrides are allowed
+	for _, currentDP := range current {
+		var foundIncomingDP bool
+		for _, p := range incoming {
+			if p.Denom == currentDP.Denom {
+				foundIncomingDP = true
+			}
+		}
+		if foundIncomingDP {
+			continue
+		}
+		var foundAllowedDP bool
+		for _, p := range aadps {
+			if p.Denom == currentDP.Denom && p.allowsAll() {
+				foundAllowedDP = true
+			}
+		}
+		allAllowed = allAllowed && foundAllowedDP
+	}
+
+	return allAllowed
+}
+
+// allowsWindowed determines if auction denom param changes are within the bounds that apply over a time window.
+// Added overrides have no previous value to measure a change from so are only checked against the min and max bounds.
+// getReference fills in the denom params from the start of a window.
+func (aadps AllowedAuctionDenomParams) allowsWindowed(current, incoming auctiontypes.DenomParams, getReference func(time.Duration, interface{}) bool) bool {
+	for _, incomingDP := range incoming {
+		for _, aadp := range aadps {
+			if aadp.Denom != incomingDP.Denom {
+				continue
+			}
+			currentDP, found := findAuctionDenomParam(current, incomingDP.Denom)
+			if !found {
+				continue
+			}
+			allowed := aadp.Bounds.allowsWindowed(auctionDenomParamValues(currentDP), auctionDenomParamValues(incomingDP), func(window time.Duration) (map[string]sdk.Dec, bool) {
+				var reference auctiontypes.DenomParams
+				if !getReference(window, &reference) {
+					return nil, false
+				}
+				referenceDP, found := findAuctionDenomParam(reference, incomingDP.Denom)
+				if !found {
+					return nil, false
+				}
+				return auctionDenomParamValues(referenceDP), true
+			})
+			if !allowed {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// getParamAtWindowStart unmarshals the value a param had at the start of a time window into ptr.
+// It returns false if the param keeper does not record the history of param changes.
+func getParamAtWindowStart(ctx sdk.Context, appCdc *codec.Codec, pk ParamKeeper, subspace string, key []byte, window time.Duration, ptr interface{}) bool {
+	history, ok := pk.(ParamHistory)
+	if !ok {
+		return false
+	}
+	value, found := history.GetParamValueAt(ctx, subspace, string(key), ctx.BlockTime().Add(-window))
+	if !found {
+		// the param has not been changed since the window started
+		ss, found := pk.GetSubspace(subspace)
+		if !found {
+			return false
+		}
+		value = ss.GetRaw(ctx, key)
+	}
+	return appCdc.UnmarshalJSON(value, ptr) == nil
+}
+
+// ------------------------------------------
+//				AssetListingPermission
+// ------------------------------------------
+
+// AssetListingPermission allows new collateral types and money markets to be added, along with pricefeed markets and incentive reward periods for them.
+// New entries must fit a template set by governance, and existing entries cannot be changed.
+type AssetListingPermission struct {
+	CollateralTemplate   *CollateralParamTemplate `json:"collateral_template,omitempty" yaml:"collateral_template,omitempty"`       // nil to disallow adding collateral types
+	MoneyMarketTemplate  *MoneyMarketTemplate     `json:"money_market_template,omitempty" yaml:"money_market_template,omitempty"`   // nil to disallow adding money markets
+	RewardPeriodTemplate *RewardPeriodTemplate    `json:"reward_period_template,omitempty" yaml:"reward_period_template,omitempty"` // nil to disallow adding incentive reward periods
+	AllowedOracles       []sdk.AccAddress         `json:"allowed_oracles" yaml:"allowed_oracles"`                                   // oracles that new pricefeed markets can use
+}
+
+var _ Permission = AssetListingPermission{}
+
+// MarshalYAML implement yaml marshalling
+func (perm AssetListingPermission) MarshalYAML() (interface{}, error) {
+	valueToMarshal := struct {
+		Type                 string                   `yaml:"type"`
+		CollateralTemplate   *CollateralParamTemplate `yaml:"collateral_template,omitempty"`
+		MoneyMarketTemplate  *MoneyMarketTemplate     `yaml:"money_market_template,omitempty"`
+		RewardPeriodTemplate *RewardPeriodTemplate    `yaml:"reward_period_template,omitempty"`
+		AllowedOracles       []sdk.AccAddress         `yaml:"allowed_oracles"`
+	}{
+		Type:                 "asset_listing_permission",
+		CollateralTemplate:   perm.CollateralTemplate,
+		MoneyMarketTemplate:  perm.MoneyMarketTemplate,
+		RewardPeriodTemplate: perm.RewardPeriodTemplate,
+		AllowedOracles:       perm.AllowedOracles,
+	}
+	return valueToMarshal, nil
+}
+
+// Validate checks the templates are well formed
+func (perm AssetListingPermission) Validate() error {
+	if perm.CollateralTemplate == nil && perm.MoneyMarketTemplate == nil {
+		return fmt.Errorf("asset listing permission must have a collateral or money market template")
+	}
+	if perm.CollateralTemplate != nil {
+		if err := perm.CollateralTemplate.Validate(); err != nil {
+			return fmt.Errorf("invalid collateral template: %w", err)
+		}
+	}
+	if perm.MoneyMarketTemplate != nil {
+		if err := perm.MoneyMarketTemplate.Validate(); err != nil {
+			return fmt.Errorf("invalid money market template: %w", err)
+		}
+	}
+	if perm.RewardPeriodTemplate != nil {
+		if err := perm.RewardPeriodTemplate.Validate(); err != nil {
+			return fmt.Errorf("invalid reward period template: %w", err)
+		}
+	}
+	oracles := make(map[string]bool, len(perm.AllowedOracles))
+	for _, oracle := range perm.AllowedOracles {
+		if oracle.Empty() {
+			return fmt.Errorf("allowed oracle cannot be empty")
+		}
+		if oracles[oracle.String()] {
+			return fmt.Errorf("duplicate allowed oracle %s", oracle)
+		}
+		oracles[oracle.String()] = true
+	}
+	return nil
+}
+
+// Allows implement permission interface
+func (perm AssetListingPermission) Allows(ctx sdk.Context, appCdc *codec.Codec, pk ParamKeeper, p PubProposal) bool {
+	proposal, ok := p.(paramstypes.ParameterChangeProposal)
+	if !ok {
+		return false
+	}
+	// Check the param changes are only to the lists assets are added to
+	for _, change := range proposal.Changes {
+		switch {
+		case change.Subspace == cdptypes.ModuleName && change.Key == string(cdptypes.KeyCollateralParams) && perm.CollateralTemplate != nil:
+		case change.Subspace == hard.ModuleName && change.Key == string(hard.KeyMoneyMarkets) && perm.MoneyMarketTemplate != nil:
+		case change.Subspace == pricefeedtypes.ModuleName && change.Key == string(pricefeedtypes.KeyMarkets):
+		case change.Subspace == incentivetypes.ModuleName && perm.RewardPeriodTemplate != nil &&
+			(change.Key == string(incentivetypes.KeyUSDXMintingRewardPeriods) ||
+				change.Key == string(incentivetypes.KeyHardSupplyRewardPeriods) ||
+				change.Key == string(incentivetypes.KeyHardBorrowRewardPeriods)):
+		default:
+			return false
+		}
+	}
+
+	// market ids used by the new collateral types and money markets, which can be added to the pricefeed
+	var newMarketIDs []string
+	// new collateral types and money market denoms, which can be given incentive reward periods
+	var newCollateralTypes, newMoneyMarketDenoms []string
+
+	// Check any new CollateralParams fit the template
+	var incomingCPs cdptypes.CollateralParams
+	found, err := getIncomingParam(appCdc, proposal.Changes, cdptypes.ModuleName, cdptypes.KeyCollateralParams, &incomingCPs)
+	if err != nil {
+		return false // invalid json value, so just disallow
+	}
+	if found {
+		subspace, found := pk.GetSubspace(cdptypes.ModuleName)
+		if !found {
+			return false // not using a panic to help avoid begin blocker panics
+		}
+		var currentCPs cdptypes.CollateralParams
+		subspace.Get(ctx, cdptypes.KeyCollateralParams, &currentCPs) // panics if something goes wrong
+
+		// existing collateral params must be unchanged
+		for _, currentCP := range currentCPs {
+			incomingCP, found := findCollateralParam(incomingCPs, currentCP.Type)
+			if !found || !(AllowedCollateralParam{Type: currentCP.Type}).Allows(currentCP, incomingCP) {
+				return false
+			}
+		}
+		for _, incomingCP := range incomingCPs {
+			if _, found := findCollateralParam(currentCPs, incomingCP.Type); found {
+				continue
+			}
+			if !perm.CollateralTemplate.Allows(incomingCP) {
+				return false
+			}
+			newMarketIDs = append(newMarketIDs, incomingCP.SpotMarketID, incomingCP.LiquidationMarketID)
+			newCollateralTypes = append(newCollateralTypes, incomingCP.Type)
+		}
+	}
+
+	// Check any new MoneyMarkets fit the template
+	var incomingMMs hard.MoneyMarkets
+	found, err = getIncomingParam(appCdc, proposal.Changes, hard.ModuleName, hard.KeyMoneyMarkets, &incomingMMs)
+	if err != nil {
+		return false
+	}
+	if found {
+		subspace, found := pk.GetSubspace(hard.ModuleName)
+		if !found {
+			return false
+		}
+		var currentMMs hard.MoneyMarkets
+		subspace.Get(ctx, hard.KeyMoneyMarkets, &currentMMs)
+
+		// existing money markets must be unchanged
+		for _, currentMM := range currentMMs {
+			incomingMM, found := findMoneyMarket(incomingMMs, currentMM.Denom)
+			if !found || !(AllowedMoneyMarket{Denom: currentMM.Denom}).Allows(currentMM, incomingMM) {
+				return false
+			}
+		}
+		for _, incomingMM := range incomingMMs {
+			if _, found := findMoneyMarket(currentMMs, incomingMM.Denom); found {
+				continue
+			}
+			if !perm.MoneyMarketTemplate.Allows(incomingMM) {
+				return false
+			}
+			newMarketIDs = append(newMarketIDs, incomingMM.SpotMarketID)
+			newMoneyMarketDenoms = append(newMoneyMarketDenoms, incomingMM.Denom)
+		}
+	}
+
+	// Check any new Markets are used by the new collateral types or money markets
+	var incomingMs pricefeedtypes.Markets
+	found, err = getIncomingParam(appCdc, proposal.Changes, pricefeedtypes.ModuleName, pricefeedtypes.KeyMarkets, &incomingMs)
+	if err != nil {
+		return false
+	}
+	if found {
+		subspace, found := pk.GetSubspace(pricefeedtypes.ModuleName)
+		if !found {
+			return false
+		}
+		var currentMs pricefeedtypes.Markets
+		subspace.Get(ctx, pricefeedtypes.KeyMarkets, &currentMs)
+
+		// existing markets must be unchanged
+		for _, currentM := range currentMs {
+			incomingM, found := findMarket(incomingMs, currentM.MarketID)
+			if !found || !(AllowedMarket{MarketID: currentM.MarketID}).Allows(currentM, incomingM) {
+				return false
+			}
+		}
+		for _, incomingM := range incomingMs {
+			if _, found := findMarket(currentMs, incomingM.MarketID); found {
+				continue
+			}
+			if !containsString(newMarketIDs, incomingM.MarketID) || !perm.allowsOracles(incomingM.Oracles) {
+				return false
+			}
+		}
+	}
+
+	// Check any new reward periods are for the new collateral types or money markets and fit the template
+	return perm.allowsRewardPeriods(ctx, appCdc, pk, proposal.Changes, newCollateralTypes, newMoneyMarketDenoms)
+}
+
+// allowsOracles returns true if a new market has oracles, and they are all allowed by the permission
+func (perm AssetListingPermission) allowsOracles(oracles []sdk.AccAddress) bool {
+	if len(oracles) == 0 {
+		return false
+	}
+	for _, oracle := range oracles {
+		allowed := false
+		for _, allowedOracle := range perm.AllowedOracles {
+			if oracle.Equals(allowedOracle) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
+
+// allowsRewardPeriods returns true if incentive reward period changes only add reward periods for the new collateral types
+// (usdx minting) or money markets (hard supply and borrow), and the new reward periods fit the template.
+func (perm AssetListingPermission) allowsRewardPeriods(ctx sdk.Context, appCdc *codec.Codec, pk ParamKeeper, changes []paramstypes.ParamChange, newCollateralTypes, newMoneyMarketDenoms []string) bool {
+	var incomingRPs incentivetypes.RewardPeriods
+	found, err := getIncomingParam(appCdc, changes, incentivetypes.ModuleName, incentivetypes.KeyUSDXMintingRewardPeriods, &incomingRPs)
+	if err != nil {
+		return false
+	}
+	if found {
+		subspace, found := pk.GetSubspace(incentivetypes.ModuleName)
+		if !found {
+			return false
+		}
+		var currentRPs incentivetypes.RewardPeriods
+		subspace.Get(ctx, incentivetypes.KeyUSDXMintingRewardPeriods, &currentRPs)
+
+		// existing reward periods must be unchanged
+		for _, currentRP := range currentRPs {
+			incomingRP, found := findRewardPeriod(incomingRPs, currentRP.CollateralType)
+			if !found || !rewardPeriodsEqual(currentRP, incomingRP) {
+				return false
+			}
+		}
+		for _, incomingRP := range incomingRPs {
+			if _, found := findRewardPeriod(currentRPs, incomingRP.CollateralType); found {
+				continue
+			}
+			if !containsString(newCollateralTypes, incomingRP.CollateralType) ||
+				!perm.RewardPeriodTemplate.Allows(sdk.NewCoins(incomingRP.RewardsPerSecond)) {
+				return false
+			}
+		}
+	}
+
+	for _, key := range [][]byte{incentivetypes.KeyHardSupplyRewardPeriods, incentivetypes.KeyHardBorrowRewardPeriods} {
+		var incomingMRPs incentivetypes.MultiRewardPeriods
+		found, err := getIncomingParam(appCdc, changes, incentivetypes.ModuleName, key, &incomingMRPs)
+		if err != nil {
+			return false
+		}
+		if !found {
+			continue
+		}
+		subspace, found := pk.GetSubspace(incentivetypes.ModuleName)
+		if !found {
+			return false
+		}
+		var currentMRPs incentivetypes.MultiRewardPeriods
+		subspace.Get(ctx, key, &currentMRPs)
+
+		// existing reward periods must be unchanged
+		for _, currentMRP := range currentMRPs {
+			incomingMRP, found := findMultiRewardPeriod(incomingMRPs, currentMRP.CollateralType)
+			if !found || !multiRewardPeriodsEqual(currentMRP, incomingMRP) {
+				return false
+			}
+		}
+		for _, incomingMRP := range incomingMRPs {
+			if _, found := findMultiRewardPeriod(currentMRPs, incomingMRP.CollateralType); found {
+				continue
+			}
+			if !containsString(newMoneyMarketDenoms, incomingMRP.CollateralType) ||
+				!perm.RewardPeriodTemplate.Allows(incomingMRP.RewardsPerSecond) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// CollateralParamTemplate defines the cdp collateral types an asset listing committee can add
+type CollateralParamTemplate struct {
+	MinLiquidationRatio       sdk.Dec `json:"min_liquidation_ratio" yaml:"min_liquidation_ratio"`
+	MaxDebtLimit              sdk.Int `json:"max_debt_limit" yaml:"max_debt_limit"`
+	MaxStabilityFee           sdk.Dec `json:"max_stability_fee" yaml:"max_stability_fee"`
+	MaxLiquidationPenalty     sdk.Dec `json:"max_liquidation_penalty" yaml:"max_liquidation_penalty"`
+	MinAuctionSize            sdk.Int `json:"min_auction_size" yaml:"min_auction_size"`
+	MaxAuctionSize            sdk.Int `json:"max_auction_size" yaml:"max_auction_size"`
+	MaxKeeperRewardPercentage sdk.Dec `json:"max_keeper_reward_percentage" yaml:"max_keeper_reward_percentage"`
+	MinPrefix                 byte    `json:"min_prefix" yaml:"min_prefix"`
+	MaxPrefix                 byte    `json:"max_prefix" yaml:"max_prefix"`
+}
+
+// NewCollateralParamTemplate returns a new CollateralParamTemplate
+func NewCollateralParamTemplate(minLiquidationRatio sdk.Dec, maxDebtLimit sdk.Int, maxStabilityFee, maxLiquidationPenalty sdk.Dec,
+	minAuctionSize, maxAuctionSize sdk.Int, maxKeeperRewardPercentage sdk.Dec, minPrefix, maxPrefix byte) CollateralParamTemplate {
+	return CollateralParamTemplate{
+		MinLiquidationRatio:       minLiquidationRatio,
+		MaxDebtLimit:              maxDebtLimit,
+		MaxStabilityFee:           maxStabilityFee,
+		MaxLiquidationPenalty:     maxLiquidationPenalty,
+		MinAuctionSize:            minAuctionSize,
+		MaxAuctionSize:            maxAuctionSize,
+		MaxKeeperRewardPercentage: maxKeeperRewardPercentage,
+		MinPrefix:                 minPrefix,
+		MaxPrefix:                 maxPrefix,
+	}
+}
+
+// Validate checks the template is well formed
+func (t CollateralParamTemplate) Validate() error {
+	if t.MinLiquidationRatio.IsNil() || !t.MinLiquidationRatio.IsPositive() {
+		return fmt.Errorf("min liquidation ratio must be positive: %s", t.MinLiquidationRatio)
+	}
+	if t.MaxDebtLimit.IsNil() || t.MaxDebtLimit.IsNegative() {
+		return fmt.Errorf("max debt limit cannot be negative: %s", t.MaxDebtLimit)
+	}
+	if t.MaxStabilityFee.IsNil() || !t.MaxStabilityFee.IsPositive() {
+		return fmt.Errorf("max stability fee must be positive: %s", t.MaxStabilityFee)
+	}
+	if t.MaxLiquidationPenalty.IsNil() || t.MaxLiquidationPenalty.IsNegative() || t.MaxLiquidationPenalty.GT(sdk.OneDec()) {
+		return fmt.Errorf("max liquidation penalty must be between 0 and 1: %s", t.MaxLiquidationPenalty)
+	}
+	if t.MinAuctionSize.IsNil() || !t.MinAuctionSize.IsPositive() {
+		return fmt.Errorf("min auction size must be positive: %s", t.MinAuctionSize)
+	}
+	if t.MaxAuctionSize.IsNil() || t.MaxAuctionSize.LT(t.MinAuctionSize) {
+		return fmt.Errorf("max auction size %s cannot be less than min auction size %s", t.MaxAuctionSize, t.MinAuctionSize)
+	}
+	if t.MaxKeeperRewardPercentage.IsNil() || t.MaxKeeperRewardPercentage.IsNegative() || t.MaxKeeperRewardPercentage.GT(sdk.OneDec()) {
+		return fmt.Errorf("max keeper reward percentage must be between 0 and 1: %s", t.MaxKeeperRewardPercentage)
+	}
+	if t.MinPrefix > t.MaxPrefix {
+		return fmt.Errorf("min prefix %#x cannot be greater than max prefix %#x", t.MinPrefix, t.MaxPrefix)
+	}
+	return nil
+}
+
+// Allows determines if a new collateral param fits the template
+func (t CollateralParamTemplate) Allows(cp cdptypes.CollateralParam) bool {
+	return !cp.LiquidationRatio.IsNil() && cp.LiquidationRatio.GTE(t.MinLiquidationRatio) &&
+		!cp.DebtLimit.Amount.IsNil() && cp.DebtLimit.Amount.LTE(t.MaxDebtLimit) &&
+		!cp.StabilityFee.IsNil() && cp.StabilityFee.LTE(t.MaxStabilityFee) &&
+		!cp.LiquidationPenalty.IsNil() && cp.LiquidationPenalty.LTE(t.MaxLiquidationPenalty) &&
+		!cp.AuctionSize.IsNil() && cp.AuctionSize.GTE(t.MinAuctionSize) && cp.AuctionSize.LTE(t.MaxAuctionSize) &&
+		!cp.KeeperRewardPercentage.IsNil() && cp.KeeperRewardPercentage.LTE(t.MaxKeeperRewardPercentage) &&
+		cp.Prefix >= t.MinPrefix && cp.Prefix <= t.MaxPrefix
+}
+
+// MoneyMarketTemplate defines the hard money markets an asset listing committee can add
+type MoneyMarketTemplate struct {
+	MaxLoanToValue            sdk.Dec `json:"max_loan_to_value" yaml:"max_loan_to_value"`
+	MaxBorrowLimit            sdk.Dec `json:"max_borrow_limit" yaml:"max_borrow_limit"`
+	MaxKeeperRewardPercentage sdk.Dec `json:"max_keeper_reward_percentage" yaml:"max_keeper_reward_percentage"`
+}
+
+// NewMoneyMarketTemplate returns a new MoneyMarketTemplate
+func NewMoneyMarketTemplate(maxLoanToValue, maxBorrowLimit, maxKeeperRewardPercentage sdk.Dec) MoneyMarketTemplate {
+	return MoneyMarketTemplate{
+		MaxLoanToValue:            maxLoanToValue,
+		MaxBorrowLimit:            maxBorrowLimit,
+		MaxKeeperRewardPercentage: maxKeeperRewardPercentage,
+	}
+}
+
+// Validate checks the template is well formed
+func (t MoneyMarketTemplate) Validate() error {
+	if t.MaxLoanToValue.IsNil() || t.MaxLoanToValue.IsNegative() || t.MaxLoanToValue.GT(sdk.OneDec()) {
+		return fmt.Errorf("max loan to value must be between 0 and 1: %s", t.MaxLoanToValue)
+	}
+	if t.MaxBorrowLimit.IsNil() || t.MaxBorrowLimit.IsNegative() {
+		return fmt.Errorf("max borrow limit cannot be negative: %s", t.MaxBorrowLimit)
+	}
+	if t.MaxKeeperRewardPercentage.IsNil() || t.MaxKeeperRewardPercentage.IsNegative() || t.MaxKeeperRewardPercentage.GT(sdk.OneDec()) {
+		return fmt.Errorf("max keeper reward percentage must be between 0 and 1: %s", t.MaxKeeperRewardPercentage)
+	}
+	return nil
+}
+
+// Allows determines if a new money market fits the template. New money markets must have a borrow limit.
+func (t MoneyMarketTemplate) Allows(mm hard.MoneyMarket) bool {
+	return !mm.BorrowLimit.LoanToValue.IsNil() && mm.BorrowLimit.LoanToValue.LTE(t.MaxLoanToValue) &&
+		mm.BorrowLimit.HasMaxLimit &&
+		!mm.BorrowLimit.MaximumLimit.IsNil() && mm.BorrowLimit.MaximumLimit.LTE(t.MaxBorrowLimit) &&
+		!mm.KeeperRewardPercentage.IsNil() && mm.KeeperRewardPercentage.LTE(t.MaxKeeperRewardPercentage)
+}
+
+// RewardPeriodTemplate defines the incentive reward periods an asset listing committee can add for new assets
+type RewardPeriodTemplate struct {
+	MaxRewardsPerSecond sdk.Coins `json:"max_rewards_per_second" yaml:"max_rewards_per_second"`
+}
+
+// NewRewardPeriodTemplate returns a new RewardPeriodTemplate
+func NewRewardPeriodTemplate(maxRewardsPerSecond sdk.Coins) RewardPeriodTemplate {
+	return RewardPeriodTemplate{
+		MaxRewardsPerSecond: maxRewardsPerSecond,
+	}
+}
+
+// Validate checks the template is well formed
+func (t RewardPeriodTemplate) Validate() error {
+	if t.MaxRewardsPerSecond.Empty() || !t.MaxRewardsPerSecond.IsValid() {
+		return fmt.Errorf("max rewards per second must be valid and not empty: %s", t.MaxRewardsPerSecond)
+	}
+	return nil
+}
+
+// Allows determines if a new reward period's rewards per second fit the template
+func (t RewardPeriodTemplate) Allows(rewardsPerSecond sdk.Coins) bool {
+	return rewardsPerSecond.IsValid() && rewardsPerSecond.IsAllLTE(t.MaxRewardsPerSecond)
+}
+
+// findRewardPeriod returns the reward period with a collateral type
+func findRewardPeriod(rps incentivetypes.RewardPeriods, collateralType string) (incentivetypes.RewardPeriod, bool) {
+	for _, rp := range rps {
+		if rp.CollateralType == collateralType {
+			return rp, true
+		}
+	}
+	return incentivetypes.RewardPeriod{}, false
+}
+
+// findMultiRewardPeriod returns the multi reward period with a collateral type
+func findMultiRewardPeriod(mrps incentivetypes.MultiRewardPeriods, collateralType string) (incentivetypes.MultiRewardPeriod, bool) {
+	for _, mrp := range mrps {
+		if mrp.CollateralType == collateralType {
+			return mrp, true
+		}
+	}
+	return incentivetypes.MultiRewardPeriod{}, false
+}
+
+// rewardPeriodsEqual returns true if two reward periods have the same values
+func rewardPeriodsEqual(a, b incentivetypes.RewardPeriod) bool {
+	return a.Active == b.Active && a.CollateralType == b.CollateralType &&
+		a.Start.Equal(b.Start) && a.End.Equal(b.End) &&
+		a.RewardsPerSecond.IsEqual(b.RewardsPerSecond)
+}
+
+// multiRewardPeriodsEqual returns true if two multi reward periods have the same values
+func multiRewardPeriodsEqual(a, b incentivetypes.MultiRewardPeriod) bool {
+	return a.Active == b.Active && a.CollateralType == b.CollateralType &&
+		a.Start.Equal(b.Start) && a.End.Equal(b.End) &&
+		a.RewardsPerSecond.IsEqual(b.RewardsPerSecond)
+}
+
+// getIncomingParam unmarshals the value of a param change into ptr, returning false if the param is not changed.
+// In case of duplicate changes the last value is used.
+func getIncomingParam(appCdc *codec.Codec, changes []paramstypes.ParamChange, subspace string, key []byte, ptr interface{}) (bool, error) {
+	var value string
+	var found bool
+	for _, change := range changes {
+		if change.Subspace == subspace && change.Key == string(key) {
+			value = change.Value
+			found = true
+		}
+	}
+	if !found {
+		return false, nil
+	}
+	return true, appCdc.UnmarshalJSON([]byte(value), ptr)
+}
+
+// findMarket returns the pricefeed market with a market id
+func findMarket(markets pricefeedtypes.Markets, marketID string) (pricefeedtypes.Market, bool) {
+	for _, m := range markets {
+		if m.MarketID == marketID {
+			return m, true
+		}
+	}
+	return pricefeedtypes.Market{}, false
+}
+
+// containsString returns true if a string is in a slice
+func containsString(strs []string, str string) bool {
+	for _, s := range strs {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+// ------------------------------------------
+//				ParamBounds
+// ------------------------------------------
+
+// MaxParamBoundWindow is the longest time window a param bound can limit changes over
+const MaxParamBoundWindow = 365 * 24 * time.Hour
+
+// ParamBound limits the values a numeric param field can be changed to.
+// A nil Min, Max, or MaxRelativeChange means that limit is not set.
+type ParamBound struct {
+	Field             string        `json:"field" yaml:"field"`                                                 // json name of the field, eg "stability_fee"
+	Min               *sdk.Dec      `json:"min,omitempty" yaml:"min,omitempty"`                                 // lowest value the field can be changed to
+	Max               *sdk.Dec      `json:"max,omitempty" yaml:"max,omitempty"`                                 // highest value the field can be changed to
+	MaxRelativeChange *sdk.Dec      `json:"max_relative_change,omitempty" yaml:"max_relative_change,omitempty"` // largest change as a fraction of the previous value, eg 0.1 for 10%
+	Window            time.Duration `json:"window" yaml:"window"`                                               // period the relative change is measured over, zero for a limit per proposal
+}
+
+// NewParamBound returns a new ParamBound. Pass nil for limits that are not set.
+func NewParamBound(field string, min, max, maxRelativeChange *sdk.Dec, window time.Duration) ParamBound {
+	return ParamBound{
+		Field:             field,
+		Min:               min,
+		Max:               max,
+		MaxRelativeChange: maxRelativeChange,
+		Window:            window,
+	}
+}
+
+// Validate checks the bound is well formed
+func (b ParamBound) Validate() error {
+	if strings.TrimSpace(b.Field) == "" {
+		return fmt.Errorf("param bound field cannot be blank")
+	}
+	if b.Min != nil && (b.Min.IsNil() || b.Min.IsNegative()) {
+		return fmt.Errorf("param bound min cannot be negative: %s", b.Min)
+	}
+	if b.Max != nil && (b.Max.IsNil() || b.Max.IsNegative()) {
+		return fmt.Errorf("param bound max cannot be negative: %s", b.Max)
+	}
+	if b.Min != nil && b.Max != nil && b.Min.GT(*b.Max) {
+		return fmt.Errorf("param bound min %s cannot be greater than max %s", b.Min, b.Max)
+	}
+	if b.MaxRelativeChange != nil && (b.MaxRelativeChange.IsNil() || b.MaxRelativeChange.IsNegative()) {
+		return fmt.Errorf("param bound max relative change cannot be negative: %s", b.MaxRelativeChange)
+	}
+	if b.Window < 0 || b.Window > MaxParamBoundWindow {
+		return fmt.Errorf("param bound window must be between 0 and %s: %s", MaxParamBoundWindow, b.Window)
+	}
+	if b.Window > 0 && b.MaxRelativeChange == nil {
+		return fmt.Errorf("param bound with a window must set a max relative change")
+	}
+	return nil
+}
+
+// allowsValue returns true if the value is within the min and max
+func (b ParamBound) allowsValue(value sdk.Dec) bool {
+	return (b.Min == nil || value.GTE(*b.Min)) &&
+		(b.Max == nil || value.LTE(*b.Max))
+}
+
+// allowsChange returns true if the change from the previous value is within the max relative change
+func (b ParamBound) allowsChange(previous, value sdk.Dec) bool {
+	if b.MaxRelativeChange == nil {
+		return true
+	}
+	change := value.Sub(previous).Abs()
+	if change.IsZero() {
+		return true
+	}
+	if previous.IsZero() {
+		return false // any change from zero is an infinite relative change
+	}
+	return change.Quo(previous.Abs()).LTE(*b.MaxRelativeChange)
+}
+
+// intToDec converts an Int to a Dec, leaving nil values unset
+func intToDec(i sdk.Int) sdk.Dec {
+	if i.IsNil() {
+		return sdk.Dec{}
+	}
+	return i.ToDec()
+}
+
+// ParamBounds slice of ParamBound
+type ParamBounds []ParamBound
+
+// Validate checks the bounds are well formed and there is at most one bound per field
+func (bounds ParamBounds) Validate() error {
+	fields := make(map[string]bool, len(bounds))
+	for _, b := range bounds {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		if fields[b.Field] {
+			return fmt.Errorf("duplicate param bound field: %s", b.Field)
+		}
+		fields[b.Field] = true
+	}
+	return nil
+}
+
+// validateBounds checks the bounds are well formed and only reference fields from a param's bounded values
+func validateBounds(bounds ParamBounds, values map[string]sdk.Dec) error {
+	if err := bounds.Validate(); err != nil {
+		return err
+	}
+	for _, b := range bounds {
+		if _, found := values[b.Field]; !found {
+			return fmt.Errorf("param bound field cannot be bounded: %s", b.Field)
+		}
+	}
+	return nil
+}
+
+// Allows determines if changed fields are within the min and max, and within any max relative change per proposal.
+// Bounds that apply over a time window are checked separately against the values at the start of the window.
+func (bounds ParamBounds) Allows(current, incoming map[string]sdk.Dec) bool {
+	for _, b := range bounds {
+		currentValue, foundCurrent := current[b.Field]
+		incomingValue, foundIncoming := incoming[b.Field]
+		if !foundCurrent || !foundIncoming {
+			return false // unknown field, so just disallow
+		}
+		if currentValue.Equal(incomingValue) {
+			continue
+		}
+		if !b.allowsValue(incomingValue) {
+			return false
+		}
+		if b.Window == 0 && !b.allowsChange(currentValue, incomingValue) {
+			return false
+		}
+	}
+	return true
+}
+
+// allowsValues determines if the values are within the min and max, used when there are no previous values
+func (bounds ParamBounds) allowsValues(values map[string]sdk.Dec) bool {
+	for _, b := range bounds {
+		value, found := values[b.Field]
+		if !found || !b.allowsValue(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// allowsWindowed determines if changed fields are within any max relative change over a time window.
+// getReference returns the field values from the start of a window.
+func (bounds ParamBounds) allowsWindowed(current, incoming map[string]sdk.Dec, getReference func(time.Duration) (map[string]sdk.Dec, bool)) bool {
+	for _, b := range bounds {
+		if b.Window == 0 {
+			continue
+		}
+		currentValue, foundCurrent := current[b.Field]
+		incomingValue, foundIncoming := incoming[b.Field]
+		if !foundCurrent || !foundIncoming {
+			return false
+		}
+		if currentValue.Equal(incomingValue) {
+			continue
+		}
+		reference, found := getReference(b.Window)
+		if !found {
+			return false
+		}
+		referenceValue, found := reference[b.Field]
+		if !found || !b.allowsChange(referenceValue, incomingValue) {
+			return false
+		}
+	}
+	return true
+}
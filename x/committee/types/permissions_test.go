@@ -10,6 +10,7 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	upgrade "github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/tendermint/tendermint/crypto"
 )
 
 type PermissionsTestSuite struct {
@@ -352,6 +353,179 @@ func (suite *PermissionsTestSuite) TestSoftwareUpgradePermission_Allows() {
 	}
 }
 
+func (suite *PermissionsTestSuite) TestAssetListingPermission_Validate() {
+	collateralTemplate := NewCollateralParamTemplate(sdk.MustNewDecFromStr("1.5"), sdk.NewInt(5000000000), sdk.MustNewDecFromStr("1.000000003"),
+		sdk.MustNewDecFromStr("0.15"), sdk.NewInt(100), sdk.NewInt(10000), sdk.MustNewDecFromStr("0.05"), 0x30, 0x3f)
+	moneyMarketTemplate := NewMoneyMarketTemplate(sdk.MustNewDecFromStr("0.6"), sdk.NewDec(5000000000), sdk.MustNewDecFromStr("0.05"))
+	rewardPeriodTemplate := NewRewardPeriodTemplate(sdk.NewCoins(sdk.NewInt64Coin("hard", 1000)))
+
+	testcases := []struct {
+		name       string
+		permission AssetListingPermission
+		expectPass bool
+	}{
+		{
+			name:       "valid",
+			permission: AssetListingPermission{CollateralTemplate: &collateralTemplate, MoneyMarketTemplate: &moneyMarketTemplate},
+			expectPass: true,
+		},
+		{
+			name: "valid with reward periods and oracles",
+			permission: AssetListingPermission{
+				CollateralTemplate:   &collateralTemplate,
+				RewardPeriodTemplate: &rewardPeriodTemplate,
+				AllowedOracles:       []sdk.AccAddress{sdk.AccAddress(crypto.AddressHash([]byte("oracle")))},
+			},
+			expectPass: true,
+		},
+		{
+			name:       "valid collateral only",
+			permission: AssetListingPermission{CollateralTemplate: &collateralTemplate},
+			expectPass: true,
+		},
+		{
+			name:       "no templates",
+			permission: AssetListingPermission{},
+			expectPass: false,
+		},
+		{
+			name: "invalid prefix range",
+			permission: AssetListingPermission{CollateralTemplate: func() *CollateralParamTemplate {
+				t := collateralTemplate
+				t.MinPrefix, t.MaxPrefix = 0x40, 0x30
+				return &t
+			}()},
+			expectPass: false,
+		},
+		{
+			name: "missing min liquidation ratio",
+			permission: AssetListingPermission{CollateralTemplate: func() *CollateralParamTemplate {
+				t := collateralTemplate
+				t.MinLiquidationRatio = sdk.Dec{}
+				return &t
+			}()},
+			expectPass: false,
+		},
+		{
+			name: "liquidation penalty greater than one",
+			permission: AssetListingPermission{CollateralTemplate: func() *CollateralParamTemplate {
+				t := collateralTemplate
+				t.MaxLiquidationPenalty = sdk.MustNewDecFromStr("1.1")
+				return &t
+			}()},
+			expectPass: false,
+		},
+		{
+			name: "max auction size less than min",
+			permission: AssetListingPermission{CollateralTemplate: func() *CollateralParamTemplate {
+				t := collateralTemplate
+				t.MaxAuctionSize = sdk.NewInt(10)
+				return &t
+			}()},
+			expectPass: false,
+		},
+		{
+			name: "missing keeper reward percentage",
+			permission: AssetListingPermission{MoneyMarketTemplate: func() *MoneyMarketTemplate {
+				t := moneyMarketTemplate
+				t.MaxKeeperRewardPercentage = sdk.Dec{}
+				return &t
+			}()},
+			expectPass: false,
+		},
+		{
+			name:       "empty reward period template",
+			permission: AssetListingPermission{CollateralTemplate: &collateralTemplate, RewardPeriodTemplate: &RewardPeriodTemplate{}},
+			expectPass: false,
+		},
+		{
+			name: "duplicate allowed oracle",
+			permission: AssetListingPermission{
+				CollateralTemplate: &collateralTemplate,
+				AllowedOracles:     []sdk.AccAddress{sdk.AccAddress(crypto.AddressHash([]byte("oracle"))), sdk.AccAddress(crypto.AddressHash([]byte("oracle")))},
+			},
+			expectPass: false,
+		},
+		{
+			name: "loan to value greater than one",
+			permission: AssetListingPermission{MoneyMarketTemplate: func() *MoneyMarketTemplate {
+				t := moneyMarketTemplate
+				t.MaxLoanToValue = sdk.MustNewDecFromStr("1.1")
+				return &t
+			}()},
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			err := tc.permission.Validate()
+			if tc.expectPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *PermissionsTestSuite) TestMembersChangePermission_Validate() {
+	suite.NoError(NewMembersChangePermission(1, 1).Validate())
+	suite.NoError(NewMembersChangePermission(3, 7).Validate())
+	suite.Error(NewMembersChangePermission(0, 7).Validate())
+	suite.Error(NewMembersChangePermission(5, 3).Validate())
+}
+
+func (suite *PermissionsTestSuite) TestVotingRulesChangePermission_Allows() {
+	permission := NewVotingRulesChangePermission(sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("0.8"), time.Hour, 7*24*time.Hour)
+
+	testcases := []struct {
+		name          string
+		pubProposal   PubProposal
+		expectAllowed bool
+	}{
+		{
+			name:          "normal",
+			pubProposal:   NewVotingRulesChangeProposal("A Title", "A description for this proposal.", sdk.MustNewDecFromStr("0.667"), 24*time.Hour),
+			expectAllowed: true,
+		},
+		{
+			name:          "not allowed (threshold below min)",
+			pubProposal:   NewVotingRulesChangeProposal("A Title", "A description for this proposal.", sdk.MustNewDecFromStr("0.4"), 24*time.Hour),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (threshold above max)",
+			pubProposal:   NewVotingRulesChangeProposal("A Title", "A description for this proposal.", sdk.MustNewDecFromStr("0.9"), 24*time.Hour),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (duration above max)",
+			pubProposal:   NewVotingRulesChangeProposal("A Title", "A description for this proposal.", sdk.MustNewDecFromStr("0.667"), 8*24*time.Hour),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (wrong pubproposal type)",
+			pubProposal:   govtypes.NewTextProposal("A Title", "A description for this proposal."),
+			expectAllowed: false,
+		},
+		{
+			name:          "not allowed (nil pubproposal)",
+			pubProposal:   nil,
+			expectAllowed: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.Equal(
+				tc.expectAllowed,
+				permission.Allows(sdk.Context{}, nil, nil, tc.pubProposal),
+			)
+		})
+	}
+}
+
 func TestPermissionsTestSuite(t *testing.T) {
 	suite.Run(t, new(PermissionsTestSuite))
 }
@@ -1,20 +1,27 @@
 package types
 
 import (
+	"time"
+
 	yaml "gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 const (
-	ProposalTypeCommitteeChange = "CommitteeChange"
-	ProposalTypeCommitteeDelete = "CommitteeDelete"
+	ProposalTypeCommitteeChange   = "CommitteeChange"
+	ProposalTypeCommitteeDelete   = "CommitteeDelete"
+	ProposalTypeCommitteeVeto     = "CommitteeVeto"
+	ProposalTypeMembersChange     = "MembersChange"
+	ProposalTypeVotingRulesChange = "VotingRulesChange"
+	ProposalTypeBundle            = "Bundle"
 )
 
 // ensure proposal types fulfill the PubProposal interface and the gov Content interface.
-var _, _ govtypes.Content = CommitteeChangeProposal{}, CommitteeDeleteProposal{}
-var _, _ PubProposal = CommitteeChangeProposal{}, CommitteeDeleteProposal{}
+var _, _, _, _, _, _ govtypes.Content = CommitteeChangeProposal{}, CommitteeDeleteProposal{}, CommitteeVetoProposal{}, MembersChangeProposal{}, VotingRulesChangeProposal{}, BundleProposal{}
+var _, _, _, _, _, _ PubProposal = CommitteeChangeProposal{}, CommitteeDeleteProposal{}, CommitteeVetoProposal{}, MembersChangeProposal{}, VotingRulesChangeProposal{}, BundleProposal{}
 
 func init() {
 	// Gov proposals need to be registered on gov's ModuleCdc so MsgSubmitProposal can be encoded.
@@ -23,6 +30,11 @@ func init() {
 
 	govtypes.RegisterProposalType(ProposalTypeCommitteeDelete)
 	govtypes.RegisterProposalTypeCodec(CommitteeDeleteProposal{}, "kava/CommitteeDeleteProposal")
+
+	// Veto proposals are only submitted to committees, but the type must be registered to pass gov's ValidateAbstract.
+	govtypes.RegisterProposalType(ProposalTypeCommitteeVeto)
+	govtypes.RegisterProposalType(ProposalTypeBundle)
+	// Members and voting rules changes apply to the committee that enacts them, so they are not registered with gov, which rejects unregistered proposal types.
 }
 
 // CommitteeChangeProposal is a gov proposal for creating a new committee or modifying an existing one.
@@ -106,3 +118,254 @@ func (cdp CommitteeDeleteProposal) String() string {
 	bz, _ := yaml.Marshal(cdp)
 	return string(bz)
 }
+
+// CommitteeVetoProposal is a committee proposal for cancelling a queued proposal before it is enacted.
+// It can only be enacted by the guardian committee of the committee that passed the queued proposal.
+type CommitteeVetoProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	ProposalID  uint64 `json:"proposal_id" yaml:"proposal_id"`
+}
+
+func NewCommitteeVetoProposal(title string, description string, proposalID uint64) CommitteeVetoProposal {
+	return CommitteeVetoProposal{
+		Title:       title,
+		Description: description,
+		ProposalID:  proposalID,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (cvp CommitteeVetoProposal) GetTitle() string { return cvp.Title }
+
+// GetDescription returns the description of the proposal.
+func (cvp CommitteeVetoProposal) GetDescription() string { return cvp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (cvp CommitteeVetoProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (cvp CommitteeVetoProposal) ProposalType() string { return ProposalTypeCommitteeVeto }
+
+// ValidateBasic runs basic stateless validity checks
+func (cvp CommitteeVetoProposal) ValidateBasic() error {
+	return govtypes.ValidateAbstract(cvp)
+}
+
+// String implements the Stringer interface.
+func (cvp CommitteeVetoProposal) String() string {
+	bz, _ := yaml.Marshal(cvp)
+	return string(bz)
+}
+
+// MembersChangeProposal is a committee proposal for adding or removing members of the committee that enacts it.
+type MembersChangeProposal struct {
+	Title         string           `json:"title" yaml:"title"`
+	Description   string           `json:"description" yaml:"description"`
+	AddMembers    []sdk.AccAddress `json:"add_members" yaml:"add_members"`
+	RemoveMembers []sdk.AccAddress `json:"remove_members" yaml:"remove_members"`
+}
+
+func NewMembersChangeProposal(title string, description string, addMembers, removeMembers []sdk.AccAddress) MembersChangeProposal {
+	return MembersChangeProposal{
+		Title:         title,
+		Description:   description,
+		AddMembers:    addMembers,
+		RemoveMembers: removeMembers,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (mcp MembersChangeProposal) GetTitle() string { return mcp.Title }
+
+// GetDescription returns the description of the proposal.
+func (mcp MembersChangeProposal) GetDescription() string { return mcp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (mcp MembersChangeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (mcp MembersChangeProposal) ProposalType() string { return ProposalTypeMembersChange }
+
+// ValidateBasic runs basic stateless validity checks
+func (mcp MembersChangeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(mcp); err != nil {
+		return err
+	}
+	if len(mcp.AddMembers) == 0 && len(mcp.RemoveMembers) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPubProposal, "proposal must add or remove at least one member")
+	}
+	addressMap := make(map[string]bool, len(mcp.AddMembers)+len(mcp.RemoveMembers))
+	for _, m := range append(mcp.AddMembers, mcp.RemoveMembers...) {
+		if m.Empty() {
+			return sdkerrors.Wrap(ErrInvalidPubProposal, "member address cannot be empty")
+		}
+		if addressMap[m.String()] {
+			return sdkerrors.Wrapf(ErrInvalidPubProposal, "duplicate member address %s", m)
+		}
+		addressMap[m.String()] = true
+	}
+	return nil
+}
+
+// Apply returns the committee with the members added and removed.
+func (mcp MembersChangeProposal) Apply(com Committee) (Committee, error) {
+	var members []sdk.AccAddress
+	for _, m := range com.Members {
+		if !containsAddress(mcp.RemoveMembers, m) {
+			members = append(members, m)
+		}
+	}
+	for _, m := range mcp.RemoveMembers {
+		if !com.HasMember(m) {
+			return Committee{}, sdkerrors.Wrapf(ErrInvalidPubProposal, "cannot remove %s, not a member of committee %d", m, com.ID)
+		}
+	}
+	for _, m := range mcp.AddMembers {
+		if com.HasMember(m) {
+			return Committee{}, sdkerrors.Wrapf(ErrInvalidPubProposal, "cannot add %s, already a member of committee %d", m, com.ID)
+		}
+		members = append(members, m)
+	}
+	if !com.allowsMemberCount(len(members)) {
+		return Committee{}, sdkerrors.Wrapf(ErrInvalidPubProposal, "committee %d cannot have %d members", com.ID, len(members))
+	}
+	com.Members = members
+	if err := com.Validate(); err != nil {
+		return Committee{}, sdkerrors.Wrap(ErrInvalidCommittee, err.Error())
+	}
+	return com, nil
+}
+
+// String implements the Stringer interface.
+func (mcp MembersChangeProposal) String() string {
+	bz, _ := yaml.Marshal(mcp)
+	return string(bz)
+}
+
+// VotingRulesChangeProposal is a committee proposal for changing the vote threshold and proposal duration of the committee that enacts it.
+type VotingRulesChangeProposal struct {
+	Title            string        `json:"title" yaml:"title"`
+	Description      string        `json:"description" yaml:"description"`
+	VoteThreshold    sdk.Dec       `json:"vote_threshold" yaml:"vote_threshold"`
+	ProposalDuration time.Duration `json:"proposal_duration" yaml:"proposal_duration"`
+}
+
+func NewVotingRulesChangeProposal(title string, description string, voteThreshold sdk.Dec, proposalDuration time.Duration) VotingRulesChangeProposal {
+	return VotingRulesChangeProposal{
+		Title:            title,
+		Description:      description,
+		VoteThreshold:    voteThreshold,
+		ProposalDuration: proposalDuration,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (vrcp VotingRulesChangeProposal) GetTitle() string { return vrcp.Title }
+
+// GetDescription returns the description of the proposal.
+func (vrcp VotingRulesChangeProposal) GetDescription() string { return vrcp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (vrcp VotingRulesChangeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (vrcp VotingRulesChangeProposal) ProposalType() string { return ProposalTypeVotingRulesChange }
+
+// ValidateBasic runs basic stateless validity checks
+func (vrcp VotingRulesChangeProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(vrcp); err != nil {
+		return err
+	}
+	// threshold must be in the range (0,1]
+	if vrcp.VoteThreshold.IsNil() || vrcp.VoteThreshold.LTE(sdk.ZeroDec()) || vrcp.VoteThreshold.GT(sdk.OneDec()) {
+		return sdkerrors.Wrapf(ErrInvalidPubProposal, "invalid threshold: %s", vrcp.VoteThreshold)
+	}
+	if vrcp.ProposalDuration < 0 {
+		return sdkerrors.Wrapf(ErrInvalidPubProposal, "invalid proposal duration: %s", vrcp.ProposalDuration)
+	}
+	return nil
+}
+
+// Apply returns the committee with the new vote threshold and proposal duration.
+func (vrcp VotingRulesChangeProposal) Apply(com Committee) (Committee, error) {
+	com.VoteThreshold = vrcp.VoteThreshold
+	com.ProposalDuration = vrcp.ProposalDuration
+	if err := com.Validate(); err != nil {
+		return Committee{}, sdkerrors.Wrap(ErrInvalidCommittee, err.Error())
+	}
+	return com, nil
+}
+
+// String implements the Stringer interface.
+func (vrcp VotingRulesChangeProposal) String() string {
+	bz, _ := yaml.Marshal(vrcp)
+	return string(bz)
+}
+
+// BundleProposal is a committee proposal for enacting several proposals together.
+// The proposals are enacted in order, and if any of them fail none of their changes are kept.
+type BundleProposal struct {
+	Title       string        `json:"title" yaml:"title"`
+	Description string        `json:"description" yaml:"description"`
+	Proposals   []PubProposal `json:"proposals" yaml:"proposals"`
+}
+
+func NewBundleProposal(title string, description string, proposals []PubProposal) BundleProposal {
+	return BundleProposal{
+		Title:       title,
+		Description: description,
+		Proposals:   proposals,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (bp BundleProposal) GetTitle() string { return bp.Title }
+
+// GetDescription returns the description of the proposal.
+func (bp BundleProposal) GetDescription() string { return bp.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (bp BundleProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (bp BundleProposal) ProposalType() string { return ProposalTypeBundle }
+
+// ValidateBasic runs basic stateless validity checks.
+// Bundles cannot contain other bundles, or proposals that are handled by the committee module itself.
+func (bp BundleProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(bp); err != nil {
+		return err
+	}
+	if len(bp.Proposals) == 0 {
+		return sdkerrors.Wrap(ErrInvalidPubProposal, "bundle must contain at least one proposal")
+	}
+	for i, p := range bp.Proposals {
+		switch p.(type) {
+		case nil:
+			return sdkerrors.Wrapf(ErrInvalidPubProposal, "bundled proposal %d cannot be nil", i)
+		case BundleProposal, CommitteeVetoProposal, MembersChangeProposal, VotingRulesChangeProposal:
+			return sdkerrors.Wrapf(ErrInvalidPubProposal, "bundled proposal %d cannot be a %T", i, p)
+		}
+		if err := p.ValidateBasic(); err != nil {
+			return sdkerrors.Wrapf(err, "bundled proposal %d", i)
+		}
+	}
+	return nil
+}
+
+// String implements the Stringer interface.
+func (bp BundleProposal) String() string {
+	bz, _ := yaml.Marshal(bp)
+	return string(bz)
+}
+
+// containsAddress returns true if an address is in a slice
+func containsAddress(addrs []sdk.AccAddress, addr sdk.AccAddress) bool {
+	for _, a := range addrs {
+		if a.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,188 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/tendermint/tendermint/crypto"
+)
+
+func TestMembersChangeProposal_Apply(t *testing.T) {
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest2"))),
+		sdk.AccAddress(crypto.AddressHash([]byte("KavaTest3"))),
+	}
+	com := NewCommittee(1, "This committee is for testing.", addresses[:2], []Permission{NewMembersChangePermission(1, 2)}, d("0.5"), time.Hour)
+
+	testCases := []struct {
+		name          string
+		proposal      MembersChangeProposal
+		expectMembers []sdk.AccAddress
+		expectPass    bool
+	}{
+		{
+			name:          "add and remove",
+			proposal:      NewMembersChangeProposal("A Title", "A description of this proposal.", addresses[2:], addresses[:1]),
+			expectMembers: []sdk.AccAddress{addresses[1], addresses[2]},
+			expectPass:    true,
+		},
+		{
+			name:       "add existing member",
+			proposal:   NewMembersChangeProposal("A Title", "A description of this proposal.", addresses[1:2], nil),
+			expectPass: false,
+		},
+		{
+			name:       "remove non member",
+			proposal:   NewMembersChangeProposal("A Title", "A description of this proposal.", nil, addresses[2:]),
+			expectPass: false,
+		},
+		{
+			name:       "above max members",
+			proposal:   NewMembersChangeProposal("A Title", "A description of this proposal.", addresses[2:], nil),
+			expectPass: false,
+		},
+		{
+			name:       "remove all members",
+			proposal:   NewMembersChangeProposal("A Title", "A description of this proposal.", nil, addresses[:2]),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, tc.proposal.ValidateBasic())
+
+			updatedCom, err := tc.proposal.Apply(com)
+			if tc.expectPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectMembers, updatedCom.Members)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMembersChangeProposal_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1")))
+
+	require.Error(t, NewMembersChangeProposal("A Title", "A description of this proposal.", nil, nil).ValidateBasic())
+	require.Error(t, NewMembersChangeProposal("A Title", "A description of this proposal.", []sdk.AccAddress{addr}, []sdk.AccAddress{addr}).ValidateBasic())
+	require.Error(t, NewMembersChangeProposal("A Title", "A description of this proposal.", []sdk.AccAddress{{}}, nil).ValidateBasic())
+}
+
+func TestCommitteeSelfChangeProposals_NotGovProposals(t *testing.T) {
+	proposer := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest1")))
+	addr := sdk.AccAddress(crypto.AddressHash([]byte("KavaTest2")))
+
+	for _, content := range []govtypes.Content{
+		NewMembersChangeProposal("A Title", "A description of this proposal.", []sdk.AccAddress{addr}, nil),
+		NewVotingRulesChangeProposal("A Title", "A description of this proposal.", d("0.667"), time.Hour),
+	} {
+		require.NoError(t, content.ValidateBasic())
+		msg := govtypes.NewMsgSubmitProposal(content, sdk.NewCoins(sdk.NewInt64Coin("ukava", 1000)), proposer)
+		require.Error(t, msg.ValidateBasic())
+	}
+}
+
+func TestVotingRulesChangeProposal_ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		proposal   VotingRulesChangeProposal
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			proposal:   NewVotingRulesChangeProposal("A Title", "A description of this proposal.", d("0.667"), time.Hour),
+			expectPass: true,
+		},
+		{
+			name:       "threshold above one",
+			proposal:   NewVotingRulesChangeProposal("A Title", "A description of this proposal.", d("1.1"), time.Hour),
+			expectPass: false,
+		},
+		{
+			name:       "zero threshold",
+			proposal:   NewVotingRulesChangeProposal("A Title", "A description of this proposal.", d("0"), time.Hour),
+			expectPass: false,
+		},
+		{
+			name:       "negative duration",
+			proposal:   NewVotingRulesChangeProposal("A Title", "A description of this proposal.", d("0.667"), -time.Hour),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestBundleProposal_ValidateBasic(t *testing.T) {
+	textProposal := govtypes.NewTextProposal("A Title", "A description of this proposal.")
+
+	testCases := []struct {
+		name       string
+		proposal   BundleProposal
+		expectPass bool
+	}{
+		{
+			name:       "normal",
+			proposal:   NewBundleProposal("A Title", "A description of this proposal.", []PubProposal{textProposal, textProposal}),
+			expectPass: true,
+		},
+		{
+			name:       "empty",
+			proposal:   NewBundleProposal("A Title", "A description of this proposal.", nil),
+			expectPass: false,
+		},
+		{
+			name:       "nil proposal",
+			proposal:   NewBundleProposal("A Title", "A description of this proposal.", []PubProposal{textProposal, nil}),
+			expectPass: false,
+		},
+		{
+			name:       "invalid proposal",
+			proposal:   NewBundleProposal("A Title", "A description of this proposal.", []PubProposal{govtypes.NewTextProposal("", "")}),
+			expectPass: false,
+		},
+		{
+			name: "nested bundle",
+			proposal: NewBundleProposal("A Title", "A description of this proposal.", []PubProposal{
+				NewBundleProposal("A Title", "A description of this proposal.", []PubProposal{textProposal}),
+			}),
+			expectPass: false,
+		},
+		{
+			name: "committee proposal",
+			proposal: NewBundleProposal("A Title", "A description of this proposal.", []PubProposal{
+				NewVotingRulesChangeProposal("A Title", "A description of this proposal.", d("0.667"), time.Hour),
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -6,15 +6,16 @@ import (
 
 // Query endpoints supported by the Querier
 const (
-	QueryCommittees     = "committees"
-	QueryCommittee      = "committee"
-	QueryProposals      = "proposals"
-	QueryProposal       = "proposal"
-	QueryNextProposalID = "next-proposal-id"
-	QueryVotes          = "votes"
-	QueryVote           = "vote"
-	QueryTally          = "tally"
-	QueryRawParams      = "raw_params"
+	QueryCommittees      = "committees"
+	QueryCommittee       = "committee"
+	QueryProposals       = "proposals"
+	QueryProposal        = "proposal"
+	QueryNextProposalID  = "next-proposal-id"
+	QueryVotes           = "votes"
+	QueryVote            = "vote"
+	QueryTally           = "tally"
+	QueryRawParams       = "raw_params"
+	QueryQueuedProposals = "queued-proposals"
 )
 
 type QueryCommitteeParams struct {
@@ -27,6 +28,17 @@ func NewQueryCommitteeParams(committeeID uint64) QueryCommitteeParams {
 	}
 }
 
+// QueryQueuedProposalsParams lists the queued proposals of a committee, or of all committees if CommitteeID is zero.
+type QueryQueuedProposalsParams struct {
+	CommitteeID uint64 `json:"committee_id" yaml:"committee_id"`
+}
+
+func NewQueryQueuedProposalsParams(committeeID uint64) QueryQueuedProposalsParams {
+	return QueryQueuedProposalsParams{
+		CommitteeID: committeeID,
+	}
+}
+
 type QueryProposalParams struct {
 	ProposalID uint64 `json:"proposal_id" yaml:"proposal_id"`
 }
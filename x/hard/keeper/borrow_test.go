@@ -322,42 +322,42 @@ func (suite *KeeperTestSuite) TestBorrow() {
 			pricefeedGS := pricefeed.GenesisState{
 				Params: pricefeed.Params{
 					Markets: []pricefeed.Market{
-						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "busd:usd", BaseAsset: "busd", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "btcb:usd", BaseAsset: "btcb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "xyz:usd", BaseAsset: "xyz", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "busd:usd", BaseAsset: "busd", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "btcb:usd", BaseAsset: "btcb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "xyz:usd", BaseAsset: "xyz", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
 					},
 				},
 				PostedPrices: []pricefeed.PostedPrice{
 					{
 						MarketID:      "usdx:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("1.00"),
 						Expiry:        time.Now().Add(1 * time.Hour),
 					},
 					{
 						MarketID:      "busd:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("1.00"),
 						Expiry:        time.Now().Add(1 * time.Hour),
 					},
 					{
 						MarketID:      "kava:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         tc.args.priceKAVA,
 						Expiry:        time.Now().Add(1 * time.Hour),
 					},
 					{
 						MarketID:      "btcb:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         tc.args.priceBTCB,
 						Expiry:        time.Now().Add(1 * time.Hour),
 					},
 					{
 						MarketID:      "bnb:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         tc.args.priceBNB,
 						Expiry:        time.Now().Add(1 * time.Hour),
 					},
@@ -476,20 +476,20 @@ func (suite *KeeperTestSuite) TestValidateBorrow() {
 	pricefeedGS := pricefeed.GenesisState{
 		Params: pricefeed.Params{
 			Markets: []pricefeed.Market{
-				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+				{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
 			},
 		},
 		PostedPrices: []pricefeed.PostedPrice{
 			{
 				MarketID:      "usdx:usd",
-				OracleAddress: sdk.AccAddress{},
+				OracleAddress: oracle,
 				Price:         sdk.MustNewDecFromStr("1.00"),
 				Expiry:        time.Now().Add(1 * time.Hour),
 			},
 			{
 				MarketID:      "kava:usd",
-				OracleAddress: sdk.AccAddress{},
+				OracleAddress: oracle,
 				Price:         sdk.MustNewDecFromStr("2.00"),
 				Expiry:        time.Now().Add(1 * time.Hour),
 			},
@@ -120,34 +120,34 @@ func (suite *KeeperTestSuite) TestDeposit() {
 			pricefeedGS := pricefeed.GenesisState{
 				Params: pricefeed.Params{
 					Markets: []pricefeed.Market{
-						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "btcb:usd", BaseAsset: "btcb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "btcb:usd", BaseAsset: "btcb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
 					},
 				},
 				PostedPrices: []pricefeed.PostedPrice{
 					{
 						MarketID:      "usdx:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("1.00"),
 						Expiry:        time.Now().Add(1 * time.Hour),
 					},
 					{
 						MarketID:      "kava:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("2.00"),
 						Expiry:        time.Now().Add(1 * time.Hour),
 					},
 					{
 						MarketID:      "btcb:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("100.00"),
 						Expiry:        time.Now().Add(1 * time.Hour),
 					},
 					{
 						MarketID:      "bnb:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("10.00"),
 						Expiry:        time.Now().Add(1 * time.Hour),
 					},
@@ -277,27 +277,27 @@ func (suite *KeeperTestSuite) TestDecrementSuppliedCoins() {
 			pricefeedGS := pricefeed.GenesisState{
 				Params: pricefeed.Params{
 					Markets: []pricefeed.Market{
-						{MarketID: "xrpb:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "busd:usd", BaseAsset: "btcb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "xrpb:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "busd:usd", BaseAsset: "btcb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
 					},
 				},
 				PostedPrices: []pricefeed.PostedPrice{
 					{
 						MarketID:      "busd:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("1.00"),
 						Expiry:        time.Now().Add(1 * time.Hour),
 					},
 					{
 						MarketID:      "xrpb:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("2.00"),
 						Expiry:        time.Now().Add(1 * time.Hour),
 					},
 					{
 						MarketID:      "bnb:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("200.00"),
 						Expiry:        time.Now().Add(1 * time.Hour),
 					},
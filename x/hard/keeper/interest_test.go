@@ -836,13 +836,13 @@ func (suite *KeeperTestSuite) TestBorrowInterest() {
 			pricefeedGS := pricefeed.GenesisState{
 				Params: pricefeed.Params{
 					Markets: []pricefeed.Market{
-						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
 					},
 				},
 				PostedPrices: []pricefeed.PostedPrice{
 					{
 						MarketID:      "kava:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("2.00"),
 						Expiry:        time.Now().Add(100 * time.Hour),
 					},
@@ -1250,20 +1250,20 @@ func (suite *KeeperTestSuite) TestSupplyInterest() {
 			pricefeedGS := pricefeed.GenesisState{
 				Params: pricefeed.Params{
 					Markets: []pricefeed.Market{
-						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
 					},
 				},
 				PostedPrices: []pricefeed.PostedPrice{
 					{
 						MarketID:      "kava:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("2.00"),
 						Expiry:        time.Now().Add(100 * time.Hour),
 					},
 					{
 						MarketID:      "bnb:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("20.00"),
 						Expiry:        time.Now().Add(100 * time.Hour),
 					},
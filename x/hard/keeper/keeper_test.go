@@ -13,6 +13,7 @@ import (
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
 	tmtime "github.com/tendermint/tendermint/types/time"
 
 	"github.com/kava-labs/kava/app"
@@ -22,6 +23,9 @@ import (
 	pfKeeper "github.com/kava-labs/kava/x/pricefeed/keeper"
 )
 
+// oracle is the address that posts prices in the pricefeed genesis states used by tests
+var oracle = sdk.AccAddress(crypto.AddressHash([]byte("oracle")))
+
 // Test suite used for all keeper tests
 type KeeperTestSuite struct {
 	suite.Suite
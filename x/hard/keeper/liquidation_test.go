@@ -90,6 +90,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 8004766),
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("ukava"),
 					},
 				},
 			},
@@ -129,6 +130,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 8004765),
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("ukava"),
 					},
 				},
 			},
@@ -196,6 +198,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("bnb", 200003287),
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("ukava"),
 					},
 					auctypes.CollateralAuction{
 						BaseAuction: auctypes.BaseAuction{
@@ -211,6 +214,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("btc", 20000032),
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("ukava"),
 					},
 					auctypes.CollateralAuction{
 						BaseAuction: auctypes.BaseAuction{
@@ -226,6 +230,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 10000782),
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("ukava"),
 					},
 					auctypes.CollateralAuction{
 						BaseAuction: auctypes.BaseAuction{
@@ -241,6 +246,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("usdc", 20003284),
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("ukava"),
 					},
 				},
 			},
@@ -282,6 +288,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 40036023),
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("bnb"),
 					},
 					auctypes.CollateralAuction{
 						BaseAuction: auctypes.BaseAuction{
@@ -297,6 +304,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 40036023),
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("btc"),
 					},
 					auctypes.CollateralAuction{
 						BaseAuction: auctypes.BaseAuction{
@@ -312,6 +320,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 40040087),
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("ukava"),
 					},
 				},
 			},
@@ -357,6 +366,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("bnb", 900097134), // $90.00
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("usdc"),
 					},
 					auctypes.CollateralAuction{
 						BaseAuction: auctypes.BaseAuction{
@@ -372,6 +382,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("bnb", 99985020), // $10.00
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("usdt"),
 					},
 					auctypes.CollateralAuction{
 						BaseAuction: auctypes.BaseAuction{
@@ -387,6 +398,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("btc", 80011211), // $80.01
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("usdt"),
 					},
 					auctypes.CollateralAuction{
 						BaseAuction: auctypes.BaseAuction{
@@ -402,6 +414,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("btc", 19989610), // $19.99
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("usdx"),
 					},
 					auctypes.CollateralAuction{
 						BaseAuction: auctypes.BaseAuction{
@@ -417,6 +430,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("ukava", 35010052), // $70.02
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("usdx"),
 					},
 				},
 			},
@@ -461,6 +475,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("usdt", 250507897),
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("dai"),
 					},
 					auctypes.CollateralAuction{
 						BaseAuction: auctypes.BaseAuction{
@@ -476,6 +491,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("usdx", 65125788),
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("dai"),
 					},
 					auctypes.CollateralAuction{
 						BaseAuction: auctypes.BaseAuction{
@@ -491,6 +507,7 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 						CorrespondingDebt: sdk.NewInt64Coin("debt", 0),
 						MaxBid:            sdk.NewInt64Coin("usdx", 180362106),
 						LotReturns:        lotReturns,
+						Params:            auctypes.DefaultParams().ForDenom("usdc"),
 					},
 				},
 			},
@@ -601,55 +618,55 @@ func (suite *KeeperTestSuite) TestKeeperLiquidation() {
 			pricefeedGS := pricefeed.GenesisState{
 				Params: pricefeed.Params{
 					Markets: []pricefeed.Market{
-						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "usdt:usd", BaseAsset: "usdt", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "usdc:usd", BaseAsset: "usdc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "dai:usd", BaseAsset: "dai", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-						{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+						{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "usdt:usd", BaseAsset: "usdt", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "usdc:usd", BaseAsset: "usdc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "dai:usd", BaseAsset: "dai", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+						{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
 					},
 				},
 				PostedPrices: []pricefeed.PostedPrice{
 					{
 						MarketID:      "usdx:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("1.00"),
 						Expiry:        time.Now().Add(100 * time.Hour),
 					},
 					{
 						MarketID:      "usdt:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("1.00"),
 						Expiry:        time.Now().Add(100 * time.Hour),
 					},
 					{
 						MarketID:      "usdc:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("1.00"),
 						Expiry:        time.Now().Add(100 * time.Hour),
 					},
 					{
 						MarketID:      "dai:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("1.00"),
 						Expiry:        time.Now().Add(100 * time.Hour),
 					},
 					{
 						MarketID:      "kava:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("2.00"),
 						Expiry:        time.Now().Add(100 * time.Hour),
 					},
 					{
 						MarketID:      "bnb:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("10.00"),
 						Expiry:        time.Now().Add(100 * time.Hour),
 					},
 					{
 						MarketID:      "btc:usd",
-						OracleAddress: sdk.AccAddress{},
+						OracleAddress: oracle,
 						Price:         sdk.MustNewDecFromStr("100.00"),
 						Expiry:        time.Now().Add(100 * time.Hour),
 					},
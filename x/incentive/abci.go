@@ -33,4 +33,14 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 			panic(err)
 		}
 	}
+	for _, rp := range params.SourceRewardPeriods {
+		err := k.AccumulateSourceRewards(ctx, rp)
+		if err != nil {
+			panic(err)
+		}
+	}
+	err := k.ExpireClaimRewards(ctx)
+	if err != nil {
+		panic(err)
+	}
 }
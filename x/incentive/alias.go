@@ -8,13 +8,17 @@ import (
 )
 
 const (
+	DefaultClaimExpiriesLimit      = keeper.DefaultClaimExpiriesLimit
 	BeginningOfMonth               = keeper.BeginningOfMonth
+	MaxClaimExpiriesPerBlock       = keeper.MaxClaimExpiriesPerBlock
 	MidMonth                       = keeper.MidMonth
 	PaymentHour                    = keeper.PaymentHour
 	AttributeKeyClaimAmount        = types.AttributeKeyClaimAmount
+	AttributeKeyClaimOwner         = types.AttributeKeyClaimOwner
 	AttributeKeyClaimPeriod        = types.AttributeKeyClaimPeriod
 	AttributeKeyClaimType          = types.AttributeKeyClaimType
 	AttributeKeyClaimedBy          = types.AttributeKeyClaimedBy
+	AttributeKeyExpiredTo          = types.AttributeKeyExpiredTo
 	AttributeKeyRewardPeriod       = types.AttributeKeyRewardPeriod
 	AttributeValueCategory         = types.AttributeValueCategory
 	BondDenom                      = types.BondDenom
@@ -22,22 +26,26 @@ const (
 	EventTypeClaim                 = types.EventTypeClaim
 	EventTypeClaimPeriod           = types.EventTypeClaimPeriod
 	EventTypeClaimPeriodExpiry     = types.EventTypeClaimPeriodExpiry
+	EventTypeRewardExpiry          = types.EventTypeRewardExpiry
 	EventTypeRewardPeriod          = types.EventTypeRewardPeriod
 	HardLiquidityProviderClaimType = types.HardLiquidityProviderClaimType
 	Large                          = types.Large
 	Medium                         = types.Medium
 	ModuleName                     = types.ModuleName
 	QuerierRoute                   = types.QuerierRoute
+	QueryGetClaimExpiries          = types.QueryGetClaimExpiries
 	QueryGetClaimPeriods           = types.QueryGetClaimPeriods
 	QueryGetHardRewards            = types.QueryGetHardRewards
 	QueryGetParams                 = types.QueryGetParams
 	QueryGetRewardPeriods          = types.QueryGetRewardPeriods
 	QueryGetRewards                = types.QueryGetRewards
+	QueryGetSourceRewards          = types.QueryGetSourceRewards
 	QueryGetUSDXMintingRewards     = types.QueryGetUSDXMintingRewards
 	RestClaimCollateralType        = types.RestClaimCollateralType
 	RestClaimOwner                 = types.RestClaimOwner
 	RestClaimType                  = types.RestClaimType
 	RouterKey                      = types.RouterKey
+	SourceClaimType                = types.SourceClaimType
 	Small                          = types.Small
 	StoreKey                       = types.StoreKey
 	USDXMintingClaimType           = types.USDXMintingClaimType
@@ -46,49 +54,71 @@ const (
 var (
 	// function aliases
 	CalculateTimeElapsed             = keeper.CalculateTimeElapsed
+	NewDelegatorRewardSource         = keeper.NewDelegatorRewardSource
 	NewKeeper                        = keeper.NewKeeper
 	NewQuerier                       = keeper.NewQuerier
 	DefaultGenesisState              = types.DefaultGenesisState
 	DefaultParams                    = types.DefaultParams
+	GetClaimAccrualQueueKey          = types.GetClaimAccrualQueueKey
+	GetClaimAccrualStartKey          = types.GetClaimAccrualStartKey
+	GetClaimTypePrefix               = types.GetClaimTypePrefix
 	GetTotalVestingPeriodLength      = types.GetTotalVestingPeriodLength
+	NewClaimExpiry                   = types.NewClaimExpiry
+	NewClaimWindow                   = types.NewClaimWindow
+	NewFixedClaimWindows             = types.NewFixedClaimWindows
 	NewGenesisAccumulationTime       = types.NewGenesisAccumulationTime
+	NewGenesisClaimAccrualTime       = types.NewGenesisClaimAccrualTime
+	NewGenesisClaimExpiryStart       = types.NewGenesisClaimExpiryStart
 	NewGenesisState                  = types.NewGenesisState
 	NewHardLiquidityProviderClaim    = types.NewHardLiquidityProviderClaim
 	NewMsgClaimHardReward            = types.NewMsgClaimHardReward
+	NewMsgClaimSourceReward          = types.NewMsgClaimSourceReward
 	NewMsgClaimUSDXMintingReward     = types.NewMsgClaimUSDXMintingReward
 	NewMultiRewardIndex              = types.NewMultiRewardIndex
 	NewMultiRewardPeriod             = types.NewMultiRewardPeriod
 	NewMultiplier                    = types.NewMultiplier
 	NewParams                        = types.NewParams
 	NewPeriod                        = types.NewPeriod
+	NewQueryClaimExpiriesParams      = types.NewQueryClaimExpiriesParams
 	NewQueryHardRewardsParams        = types.NewQueryHardRewardsParams
 	NewQueryRewardsParams            = types.NewQueryRewardsParams
+	NewQuerySourceRewardsParams      = types.NewQuerySourceRewardsParams
 	NewQueryUSDXMintingRewardsParams = types.NewQueryUSDXMintingRewardsParams
 	NewRewardIndex                   = types.NewRewardIndex
 	NewRewardPeriod                  = types.NewRewardPeriod
+	NewSourceClaim                   = types.NewSourceClaim
 	NewUSDXMintingClaim              = types.NewUSDXMintingClaim
 	ParamKeyTable                    = types.ParamKeyTable
 	RegisterCodec                    = types.RegisterCodec
+	ValidateClaimType                = types.ValidateClaimType
 
 	// variable aliases
 	DefaultActive                                   = types.DefaultActive
-	DefaultClaimEnd                                 = types.DefaultClaimEnd
+	DefaultClaimWindows                             = types.DefaultClaimWindows
 	DefaultGenesisAccumulationTimes                 = types.DefaultGenesisAccumulationTimes
+	DefaultGenesisClaimAccrualTimes                 = types.DefaultGenesisClaimAccrualTimes
+	DefaultGenesisClaimExpiryStarts                 = types.DefaultGenesisClaimExpiryStarts
 	DefaultHardClaims                               = types.DefaultHardClaims
 	DefaultMultiRewardPeriods                       = types.DefaultMultiRewardPeriods
 	DefaultMultipliers                              = types.DefaultMultipliers
 	DefaultRewardPeriods                            = types.DefaultRewardPeriods
+	DefaultSourceClaims                             = types.DefaultSourceClaims
 	DefaultUSDXClaims                               = types.DefaultUSDXClaims
 	ErrAccountNotFound                              = types.ErrAccountNotFound
 	ErrClaimExpired                                 = types.ErrClaimExpired
 	ErrClaimNotFound                                = types.ErrClaimNotFound
+	ErrClaimWindowNotFound                          = types.ErrClaimWindowNotFound
 	ErrInsufficientModAccountBalance                = types.ErrInsufficientModAccountBalance
 	ErrInvalidAccountType                           = types.ErrInvalidAccountType
 	ErrInvalidClaimType                             = types.ErrInvalidClaimType
 	ErrInvalidMultiplier                            = types.ErrInvalidMultiplier
 	ErrNoClaimsFound                                = types.ErrNoClaimsFound
 	ErrRewardPeriodNotFound                         = types.ErrRewardPeriodNotFound
+	ErrRewardSourceNotFound                         = types.ErrRewardSourceNotFound
 	ErrZeroClaim                                    = types.ErrZeroClaim
+	ClaimAccrualQueueKeyPrefix                      = types.ClaimAccrualQueueKeyPrefix
+	ClaimAccrualStartKeyPrefix                      = types.ClaimAccrualStartKeyPrefix
+	ClaimExpiryStartKeyPrefix                       = types.ClaimExpiryStartKeyPrefix
 	GovDenom                                        = types.GovDenom
 	HardBorrowRewardIndexesKeyPrefix                = types.HardBorrowRewardIndexesKeyPrefix
 	HardDelegatorRewardFactorKeyPrefix              = types.HardDelegatorRewardFactorKeyPrefix
@@ -96,24 +126,29 @@ var (
 	HardLiquidityRewardDenom                        = types.HardLiquidityRewardDenom
 	HardSupplyRewardIndexesKeyPrefix                = types.HardSupplyRewardIndexesKeyPrefix
 	IncentiveMacc                                   = types.IncentiveMacc
-	KeyClaimEnd                                     = types.KeyClaimEnd
+	KeyClaimWindows                                 = types.KeyClaimWindows
 	KeyHardBorrowRewardPeriods                      = types.KeyHardBorrowRewardPeriods
 	KeyHardDelegatorRewardPeriods                   = types.KeyHardDelegatorRewardPeriods
 	KeyHardSupplyRewardPeriods                      = types.KeyHardSupplyRewardPeriods
 	KeyMultipliers                                  = types.KeyMultipliers
+	KeySourceRewardPeriods                          = types.KeySourceRewardPeriods
 	KeyUSDXMintingRewardPeriods                     = types.KeyUSDXMintingRewardPeriods
 	ModuleCdc                                       = types.ModuleCdc
 	PreviousHardBorrowRewardAccrualTimeKeyPrefix    = types.PreviousHardBorrowRewardAccrualTimeKeyPrefix
 	PreviousHardDelegatorRewardAccrualTimeKeyPrefix = types.PreviousHardDelegatorRewardAccrualTimeKeyPrefix
 	PreviousHardSupplyRewardAccrualTimeKeyPrefix    = types.PreviousHardSupplyRewardAccrualTimeKeyPrefix
+	PreviousSourceRewardAccrualTimeKeyPrefix        = types.PreviousSourceRewardAccrualTimeKeyPrefix
 	PreviousUSDXMintingRewardAccrualTimeKeyPrefix   = types.PreviousUSDXMintingRewardAccrualTimeKeyPrefix
 	PrincipalDenom                                  = types.PrincipalDenom
+	SourceClaimKeyPrefix                            = types.SourceClaimKeyPrefix
+	SourceRewardIndexesKeyPrefix                    = types.SourceRewardIndexesKeyPrefix
 	USDXMintingClaimKeyPrefix                       = types.USDXMintingClaimKeyPrefix
 	USDXMintingRewardDenom                          = types.USDXMintingRewardDenom
 	USDXMintingRewardFactorKeyPrefix                = types.USDXMintingRewardFactorKeyPrefix
 )
 
 type (
+	DelegatorRewardSource         = keeper.DelegatorRewardSource
 	Hooks                         = keeper.Hooks
 	Keeper                        = keeper.Keeper
 	AccountKeeper                 = types.AccountKeeper
@@ -122,15 +157,25 @@ type (
 	CDPHooks                      = types.CDPHooks
 	CdpKeeper                     = types.CdpKeeper
 	Claim                         = types.Claim
+	ClaimExpiries                 = types.ClaimExpiries
+	ClaimExpiry                   = types.ClaimExpiry
+	ClaimWindow                   = types.ClaimWindow
+	ClaimWindows                  = types.ClaimWindows
 	Claims                        = types.Claims
+	DistrKeeper                   = types.DistrKeeper
 	GenesisAccumulationTime       = types.GenesisAccumulationTime
 	GenesisAccumulationTimes      = types.GenesisAccumulationTimes
+	GenesisClaimAccrualTime       = types.GenesisClaimAccrualTime
+	GenesisClaimAccrualTimes      = types.GenesisClaimAccrualTimes
+	GenesisClaimExpiryStart       = types.GenesisClaimExpiryStart
+	GenesisClaimExpiryStarts      = types.GenesisClaimExpiryStarts
 	GenesisState                  = types.GenesisState
 	HARDHooks                     = types.HARDHooks
 	HardKeeper                    = types.HardKeeper
 	HardLiquidityProviderClaim    = types.HardLiquidityProviderClaim
 	HardLiquidityProviderClaims   = types.HardLiquidityProviderClaims
 	MsgClaimHardReward            = types.MsgClaimHardReward
+	MsgClaimSourceReward          = types.MsgClaimSourceReward
 	MsgClaimUSDXMintingReward     = types.MsgClaimUSDXMintingReward
 	MultiRewardIndex              = types.MultiRewardIndex
 	MultiRewardIndexes            = types.MultiRewardIndexes
@@ -140,13 +185,19 @@ type (
 	MultiplierName                = types.MultiplierName
 	Multipliers                   = types.Multipliers
 	Params                        = types.Params
+	QueryClaimExpiriesParams      = types.QueryClaimExpiriesParams
 	QueryHardRewardsParams        = types.QueryHardRewardsParams
 	QueryRewardsParams            = types.QueryRewardsParams
+	QuerySourceRewardsParams      = types.QuerySourceRewardsParams
 	QueryUSDXMintingRewardsParams = types.QueryUSDXMintingRewardsParams
 	RewardIndex                   = types.RewardIndex
 	RewardIndexes                 = types.RewardIndexes
 	RewardPeriod                  = types.RewardPeriod
 	RewardPeriods                 = types.RewardPeriods
+	RewardSource                  = types.RewardSource
+	RewardSourceHooks             = types.RewardSourceHooks
+	SourceClaim                   = types.SourceClaim
+	SourceClaims                  = types.SourceClaims
 	StakingKeeper                 = types.StakingKeeper
 	SupplyKeeper                  = types.SupplyKeeper
 	USDXMintingClaim              = types.USDXMintingClaim
@@ -34,6 +34,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		queryParamsCmd(queryRoute, cdc),
 		queryRewardsCmd(queryRoute, cdc),
 		queryRewardFactorsCmd(queryRoute, cdc),
+		queryClaimExpiriesCmd(queryRoute, cdc),
 	)...)
 
 	return incentiveQueryCmd
@@ -51,12 +52,14 @@ func queryRewardsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			$ %s query %s rewards --owner kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
 			$ %s query %s rewards --type hard
 			$ %s query %s rewards --type usdx-minting
+			$ %s query %s rewards --type source
 			$ %s query %s rewards --type hard --owner kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
 			$ %s query %s rewards --type hard --unsynced true
 			`,
 				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
 				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
-				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName)),
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+				version.ClientName, types.ModuleName)),
 		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -106,6 +109,17 @@ func queryRewardsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 					}
 				}
 				return cliCtx.PrintOutput(claims)
+			case "source":
+				params := types.NewQuerySourceRewardsParams(page, limit, owner)
+				route := types.QueryGetSourceRewards
+				if boolUnsynced {
+					route = types.QueryGetSourceRewardsUnsynced
+				}
+				claims, err := executeSourceRewardsQuery(queryRoute, route, cdc, cliCtx, params)
+				if err != nil {
+					return err
+				}
+				return cliCtx.PrintOutput(claims)
 			default:
 				var hardClaims types.HardLiquidityProviderClaims
 				var usdxMintingClaims types.USDXMintingClaims
@@ -220,6 +234,68 @@ func queryRewardFactorsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+func queryClaimExpiriesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-expiries",
+		Short: "query when unclaimed rewards expire",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query upcoming reward expiries, soonest first, with optional flags for owner and claim type
+
+			Example:
+			$ %s query %s claim-expiries
+			$ %s query %s claim-expiries --owner kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw
+			$ %s query %s claim-expiries --type hard_liquidity_provider
+			`,
+				version.ClientName, types.ModuleName, version.ClientName, types.ModuleName,
+				version.ClientName, types.ModuleName)),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+			strOwner := viper.GetString(flagOwner)
+			claimType := strings.ToLower(viper.GetString(flagType))
+
+			var owner sdk.AccAddress
+			if len(strOwner) != 0 {
+				var err error
+				owner, err = sdk.AccAddressFromBech32(strOwner)
+				if err != nil {
+					return err
+				}
+			}
+
+			// Construct query with params
+			params := types.NewQueryClaimExpiriesParams(page, limit, owner, claimType)
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Execute query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetClaimExpiries)
+			res, height, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			cliCtx = cliCtx.WithHeight(height)
+
+			// Decode and print results
+			var expiries types.ClaimExpiries
+			if err := cdc.UnmarshalJSON(res, &expiries); err != nil {
+				return fmt.Errorf("failed to unmarshal claim expiries: %w", err)
+			}
+			return cliCtx.PrintOutput(expiries)
+		},
+	}
+	cmd.Flags().String(flagOwner, "", "(optional) filter by owner address")
+	cmd.Flags().String(flagType, "", "(optional) filter by claim type")
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of claim expiries to query for")
+	cmd.Flags().Int(flags.FlagLimit, 100, "pagination limit of claim expiries to query for")
+	return cmd
+}
+
 func executeHardRewardsQuery(queryRoute string, cdc *codec.Codec, cliCtx context.CLIContext,
 	params types.QueryHardRewardsParams) (types.HardLiquidityProviderClaims, error) {
 	bz, err := cdc.MarshalJSON(params)
@@ -311,3 +387,26 @@ func executeUSDXMintingRewardsUnsyncedQuery(queryRoute string, cdc *codec.Codec,
 
 	return claims, nil
 }
+
+func executeSourceRewardsQuery(queryRoute, queryPath string, cdc *codec.Codec, cliCtx context.CLIContext,
+	params types.QuerySourceRewardsParams) (types.SourceClaims, error) {
+	bz, err := cdc.MarshalJSON(params)
+	if err != nil {
+		return types.SourceClaims{}, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", queryRoute, queryPath)
+	res, height, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		return types.SourceClaims{}, err
+	}
+
+	cliCtx = cliCtx.WithHeight(height)
+
+	var claims types.SourceClaims
+	if err := cdc.UnmarshalJSON(res, &claims); err != nil {
+		return types.SourceClaims{}, fmt.Errorf("failed to unmarshal claims: %w", err)
+	}
+
+	return claims, nil
+}
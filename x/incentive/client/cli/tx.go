@@ -28,6 +28,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	incentiveTxCmd.AddCommand(flags.PostCommands(
 		getCmdClaimCdp(cdc),
 		getCmdClaimHard(cdc),
+		getCmdClaimSource(cdc),
 	)...)
 
 	return incentiveTxCmd
@@ -92,3 +93,33 @@ func getCmdClaimHard(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+func getCmdClaimSource(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "claim-source [multiplier]",
+		Short: "claim sender's reward source rewards using a given multiplier",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim sender's outstanding rewards from all reward sources using given multiplier
+
+			Example:
+			$ %s tx %s claim-source large
+		`, version.ClientName, types.ModuleName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			sender := cliCtx.GetFromAddress()
+			multiplier := args[0]
+
+			msg := types.NewMsgClaimSourceReward(sender, multiplier)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -19,6 +19,7 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/rewards", types.ModuleName), queryRewardsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/parameters", types.ModuleName), queryParamsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/reward-factors", types.ModuleName), queryRewardFactorsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/claim-expiries", types.ModuleName), queryClaimExpiriesHandlerFn(cliCtx)).Methods("GET")
 }
 
 func queryRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -66,6 +67,9 @@ func queryRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			case "usdx_minting":
 				params := types.NewQueryUSDXMintingRewardsUnsyncedParams(page, limit, owner)
 				executeUSDXMintingRewardsUnsyncedQuery(w, cliCtx, params)
+			case "source":
+				params := types.NewQuerySourceRewardsParams(page, limit, owner)
+				executeSourceRewardsQuery(w, cliCtx, types.QueryGetSourceRewardsUnsynced, params)
 			default:
 				hardParams := types.NewQueryHardRewardsUnsyncedParams(page, limit, owner)
 				usdxMintingParams := types.NewQueryUSDXMintingRewardsUnsyncedParams(page, limit, owner)
@@ -79,6 +83,9 @@ func queryRewardsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			case "usdx_minting":
 				params := types.NewQueryUSDXMintingRewardsParams(page, limit, owner)
 				executeUSDXMintingRewardsQuery(w, cliCtx, params)
+			case "source":
+				params := types.NewQuerySourceRewardsParams(page, limit, owner)
+				executeSourceRewardsQuery(w, cliCtx, types.QueryGetSourceRewards, params)
 			default:
 				hardParams := types.NewQueryHardRewardsParams(page, limit, owner)
 				usdxMintingParams := types.NewQueryUSDXMintingRewardsParams(page, limit, owner)
@@ -146,6 +153,51 @@ func queryRewardFactorsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+func queryClaimExpiriesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		var owner sdk.AccAddress
+		if x := r.URL.Query().Get(types.RestClaimOwner); len(x) != 0 {
+			ownerStr := strings.ToLower(strings.TrimSpace(x))
+			owner, err = sdk.AccAddressFromBech32(ownerStr)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("cannot parse address from claim owner %s", ownerStr))
+				return
+			}
+		}
+
+		var claimType string
+		if x := r.URL.Query().Get(types.RestClaimType); len(x) != 0 {
+			claimType = strings.ToLower(strings.TrimSpace(x))
+		}
+
+		params := types.NewQueryClaimExpiriesParams(page, limit, owner, claimType)
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to marshal query params: %s", err))
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryGetClaimExpiries)
+		res, height, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
 func executeHardRewardsQuery(w http.ResponseWriter, cliCtx context.CLIContext, params types.QueryHardRewardsParams) {
 	bz, err := cliCtx.Codec.MarshalJSON(params)
 	if err != nil {
@@ -214,6 +266,23 @@ func executeUSDXMintingRewardsUnsyncedQuery(w http.ResponseWriter, cliCtx contex
 	rest.PostProcessResponse(w, cliCtx, res)
 }
 
+func executeSourceRewardsQuery(w http.ResponseWriter, cliCtx context.CLIContext, queryPath string, params types.QuerySourceRewardsParams) {
+	bz, err := cliCtx.Codec.MarshalJSON(params)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("failed to marshal query params: %s", err))
+		return
+	}
+
+	res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/incentive/%s", queryPath), bz)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	cliCtx = cliCtx.WithHeight(height)
+	rest.PostProcessResponse(w, cliCtx, res)
+}
+
 func executeBothRewardQueries(w http.ResponseWriter, cliCtx context.CLIContext,
 	hardParams types.QueryHardRewardsParams, usdxMintingParams types.QueryUSDXMintingRewardsParams) {
 	hardBz, err := cliCtx.Codec.MarshalJSON(hardParams)
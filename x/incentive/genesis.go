@@ -52,6 +52,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, supplyKeeper types.SupplyKeep
 		k.SetHardDelegatorRewardFactor(ctx, rp.CollateralType, sdk.ZeroDec())
 	}
 
+	for _, mrp := range gs.Params.SourceRewardPeriods {
+		if _, found := k.GetRewardSource(mrp.CollateralType); !found {
+			panic(fmt.Sprintf("reward source %s has not been registered", mrp.CollateralType))
+		}
+		newRewardIndexes := types.RewardIndexes{}
+		for _, rc := range mrp.RewardsPerSecond {
+			ri := types.NewRewardIndex(rc.Denom, sdk.ZeroDec())
+			newRewardIndexes = append(newRewardIndexes, ri)
+		}
+		k.SetSourceRewardIndexes(ctx, mrp.CollateralType, newRewardIndexes)
+	}
+
 	k.SetParams(ctx, gs.Params)
 
 	for _, gat := range gs.USDXAccumulationTimes {
@@ -70,6 +82,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, supplyKeeper types.SupplyKeep
 		k.SetPreviousHardDelegatorRewardAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
 	}
 
+	for _, gat := range gs.SourceAccumulationTimes {
+		k.SetPreviousSourceRewardAccrualTime(ctx, gat.CollateralType, gat.PreviousAccumulationTime)
+	}
+
 	for i, claim := range gs.USDXMintingClaims {
 		for j, ri := range claim.RewardIndexes {
 			if ri.RewardFactor != sdk.ZeroDec() {
@@ -101,6 +117,26 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, supplyKeeper types.SupplyKeep
 		}
 		k.SetHardLiquidityProviderClaim(ctx, claim)
 	}
+
+	for _, claim := range gs.SourceClaims {
+		for i, mri := range claim.RewardIndexes {
+			for j := range mri.RewardIndexes {
+				claim.RewardIndexes[i].RewardIndexes[j].RewardFactor = sdk.ZeroDec()
+			}
+		}
+		k.SetSourceClaim(ctx, claim)
+	}
+
+	// claims with rewards start an accrual period at genesis time when they are set, unless genesis has an earlier start
+	for _, gcat := range gs.ClaimAccrualTimes {
+		if _, found := k.GetClaimAccrualStart(ctx, gcat.ClaimType, gcat.Owner); found {
+			k.SetClaimAccrualStart(ctx, gcat.ClaimType, gcat.Owner, gcat.AccrualStart)
+		}
+	}
+
+	for _, gces := range gs.ClaimExpiryStarts {
+		k.SetClaimExpiryStart(ctx, gces.ClaimType, gces.ExpiryStart)
+	}
 }
 
 // ExportGenesis export genesis state for incentive module
@@ -109,9 +145,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 
 	usdxClaims := k.GetAllUSDXMintingClaims(ctx)
 	hardClaims := k.GetAllHardLiquidityProviderClaims(ctx)
+	sourceClaims := k.GetAllSourceClaims(ctx)
 
 	synchronizedUsdxClaims := types.USDXMintingClaims{}
 	synchronizedHardClaims := types.HardLiquidityProviderClaims{}
+	synchronizedSourceClaims := types.SourceClaims{}
 
 	for _, usdxClaim := range usdxClaims {
 		claim, err := k.SynchronizeUSDXMintingClaim(ctx, usdxClaim)
@@ -146,6 +184,44 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		synchronizedHardClaims = append(synchronizedHardClaims, claim)
 	}
 
+	for _, sourceClaim := range sourceClaims {
+		k.SynchronizeSourceClaim(ctx, sourceClaim.Owner)
+		claim, found := k.GetSourceClaim(ctx, sourceClaim.Owner)
+		if !found {
+			panic("source claim should always be found after synchronization")
+		}
+		for i, mri := range claim.RewardIndexes {
+			for j := range mri.RewardIndexes {
+				claim.RewardIndexes[i].RewardIndexes[j].RewardFactor = sdk.ZeroDec()
+			}
+		}
+		synchronizedSourceClaims = append(synchronizedSourceClaims, claim)
+	}
+
+	claimAccrualTimes := types.GenesisClaimAccrualTimes{}
+	for _, claim := range synchronizedUsdxClaims {
+		if start, found := k.GetClaimAccrualStart(ctx, types.USDXMintingClaimType, claim.Owner); found {
+			claimAccrualTimes = append(claimAccrualTimes, types.NewGenesisClaimAccrualTime(types.USDXMintingClaimType, claim.Owner, start))
+		}
+	}
+	for _, claim := range synchronizedHardClaims {
+		if start, found := k.GetClaimAccrualStart(ctx, types.HardLiquidityProviderClaimType, claim.Owner); found {
+			claimAccrualTimes = append(claimAccrualTimes, types.NewGenesisClaimAccrualTime(types.HardLiquidityProviderClaimType, claim.Owner, start))
+		}
+	}
+	for _, claim := range synchronizedSourceClaims {
+		if start, found := k.GetClaimAccrualStart(ctx, types.SourceClaimType, claim.Owner); found {
+			claimAccrualTimes = append(claimAccrualTimes, types.NewGenesisClaimAccrualTime(types.SourceClaimType, claim.Owner, start))
+		}
+	}
+
+	claimExpiryStarts := types.GenesisClaimExpiryStarts{}
+	for _, window := range params.ClaimWindows {
+		if start, found := k.GetClaimExpiryStart(ctx, window.ClaimType); found {
+			claimExpiryStarts = append(claimExpiryStarts, types.NewGenesisClaimExpiryStart(window.ClaimType, start))
+		}
+	}
+
 	var usdxMintingGats GenesisAccumulationTimes
 	for _, rp := range params.USDXMintingRewardPeriods {
 		pat, found := k.GetPreviousUSDXMintingAccrualTime(ctx, rp.CollateralType)
@@ -186,6 +262,17 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 		hardDelegatorGats = append(hardDelegatorGats, gat)
 	}
 
+	var sourceGats GenesisAccumulationTimes
+	for _, rp := range params.SourceRewardPeriods {
+		pat, found := k.GetPreviousSourceRewardAccrualTime(ctx, rp.CollateralType)
+		if !found {
+			panic(fmt.Sprintf("expected previous source reward accrual time to be set in state for %s", rp.CollateralType))
+		}
+		gat := types.NewGenesisAccumulationTime(rp.CollateralType, pat)
+		sourceGats = append(sourceGats, gat)
+	}
+
 	return types.NewGenesisState(params, usdxMintingGats, hardSupplyGats,
-		hardBorrowGats, hardDelegatorGats, synchronizedUsdxClaims, synchronizedHardClaims)
+		hardBorrowGats, hardDelegatorGats, synchronizedUsdxClaims, synchronizedHardClaims,
+		sourceGats, synchronizedSourceClaims, claimAccrualTimes, claimExpiryStarts)
 }
@@ -66,8 +66,9 @@ func (suite *GenesisTestSuite) SetupTest() {
 			incentive.MultiRewardPeriods{incentive.NewMultiRewardPeriod(true, "bnb", suite.genesisTime.Add(-1*oneYear), suite.genesisTime.Add(oneYear), cs(c("hard", 122354)))},
 			incentive.MultiRewardPeriods{incentive.NewMultiRewardPeriod(true, "bnb", suite.genesisTime.Add(-1*oneYear), suite.genesisTime.Add(oneYear), cs(c("hard", 122354)))},
 			incentive.RewardPeriods{incentive.NewRewardPeriod(true, "ukava", suite.genesisTime.Add(-1*oneYear), suite.genesisTime.Add(oneYear), c("hard", 122354))},
+			incentive.DefaultMultiRewardPeriods,
 			incentive.Multipliers{incentive.NewMultiplier(incentive.Small, 1, d("0.25")), incentive.NewMultiplier(incentive.Large, 12, d("1.0"))},
-			suite.genesisTime.Add(5*oneYear),
+			incentive.NewFixedClaimWindows(suite.genesisTime.Add(5*oneYear)),
 		),
 		incentive.DefaultGenesisAccumulationTimes,
 		incentive.DefaultGenesisAccumulationTimes,
@@ -75,6 +76,10 @@ func (suite *GenesisTestSuite) SetupTest() {
 		incentive.DefaultGenesisAccumulationTimes,
 		incentive.DefaultUSDXClaims,
 		incentive.DefaultHardClaims,
+		incentive.DefaultGenesisAccumulationTimes,
+		incentive.DefaultSourceClaims,
+		incentive.DefaultGenesisClaimAccrualTimes,
+		incentive.DefaultGenesisClaimExpiryStarts,
 	)
 	tApp.InitializeFromGenesisStatesWithTime(
 		suite.genesisTime,
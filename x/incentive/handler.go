@@ -17,6 +17,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgClaimUSDXMintingReward(ctx, k, msg)
 		case types.MsgClaimHardReward:
 			return handleMsgClaimHardReward(ctx, k, msg)
+		case types.MsgClaimSourceReward:
+			return handleMsgClaimSourceReward(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -44,3 +46,14 @@ func handleMsgClaimHardReward(ctx sdk.Context, k keeper.Keeper, msg types.MsgCla
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+func handleMsgClaimSourceReward(ctx sdk.Context, k keeper.Keeper, msg types.MsgClaimSourceReward) (*sdk.Result, error) {
+
+	err := k.ClaimSourceReward(ctx, msg.Sender, types.MultiplierName(msg.MultiplierName))
+	if err != nil {
+		return nil, err
+	}
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
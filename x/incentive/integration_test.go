@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/tendermint/tendermint/crypto"
+
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp"
 	"github.com/kava-labs/kava/x/incentive"
@@ -12,6 +14,9 @@ import (
 	"github.com/kava-labs/kava/x/pricefeed"
 )
 
+// oracle is the address that posts prices in the pricefeed genesis states used by tests
+var oracle = sdk.AccAddress(crypto.AddressHash([]byte("oracle")))
+
 func NewCDPGenStateMulti() app.GenesisState {
 	cdpGenesis := cdp.GenesisState{
 		Params: cdp.Params{
@@ -105,34 +110,34 @@ func NewPricefeedGenStateMulti() app.GenesisState {
 	pfGenesis := pricefeed.GenesisState{
 		Params: pricefeed.Params{
 			Markets: []pricefeed.Market{
-				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-				{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
-				{MarketID: "busd:usd", BaseAsset: "busd", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true},
+				{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+				{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+				{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
+				{MarketID: "busd:usd", BaseAsset: "busd", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true},
 			},
 		},
 		PostedPrices: []pricefeed.PostedPrice{
 			{
 				MarketID:      "btc:usd",
-				OracleAddress: sdk.AccAddress{},
+				OracleAddress: oracle,
 				Price:         sdk.MustNewDecFromStr("8000.00"),
 				Expiry:        time.Now().Add(1 * time.Hour),
 			},
 			{
 				MarketID:      "xrp:usd",
-				OracleAddress: sdk.AccAddress{},
+				OracleAddress: oracle,
 				Price:         sdk.MustNewDecFromStr("0.25"),
 				Expiry:        time.Now().Add(1 * time.Hour),
 			},
 			{
 				MarketID:      "bnb:usd",
-				OracleAddress: sdk.AccAddress{},
+				OracleAddress: oracle,
 				Price:         sdk.MustNewDecFromStr("17.25"),
 				Expiry:        time.Now().Add(1 * time.Hour),
 			},
 			{
 				MarketID:      "busd:usd",
-				OracleAddress: sdk.AccAddress{},
+				OracleAddress: oracle,
 				Price:         sdk.OneDec(),
 				Expiry:        time.Now().Add(1 * time.Hour),
 			},
@@ -158,11 +163,12 @@ func NewIncentiveGenState(previousAccumTime, endTime time.Time, rewardPeriods ..
 			types.MultiRewardPeriods{},
 			types.MultiRewardPeriods{},
 			types.RewardPeriods{},
+			incentive.DefaultMultiRewardPeriods,
 			incentive.Multipliers{
 				incentive.NewMultiplier(incentive.Small, 1, d("0.25")),
 				incentive.NewMultiplier(incentive.Large, 12, d("1.0")),
 			},
-			endTime,
+			incentive.NewFixedClaimWindows(endTime),
 		),
 		accumulationTimes,
 		accumulationTimes,
@@ -170,6 +176,10 @@ func NewIncentiveGenState(previousAccumTime, endTime time.Time, rewardPeriods ..
 		incentive.DefaultGenesisAccumulationTimes,
 		incentive.DefaultUSDXClaims,
 		incentive.DefaultHardClaims,
+		incentive.DefaultGenesisAccumulationTimes,
+		incentive.DefaultSourceClaims,
+		incentive.DefaultGenesisClaimAccrualTimes,
+		incentive.DefaultGenesisClaimExpiryStarts,
 	)
 	return app.GenesisState{incentive.ModuleName: incentive.ModuleCdc.MustMarshalJSON(genesis)}
 }
@@ -0,0 +1,343 @@
+package keeper
+
+import (
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+const (
+	// MaxClaimExpiriesPerBlock is the most claims of each type whose rewards expire in a block
+	MaxClaimExpiriesPerBlock = 100
+	// DefaultClaimExpiriesLimit is the default number of claim expiries returned by a query
+	DefaultClaimExpiriesLimit = 100
+)
+
+// GetClaimAccrualStart returns the start of the current accrual period of the owner's claim of the input type
+func (k Keeper) GetClaimAccrualStart(ctx sdk.Context, claimType string, owner sdk.AccAddress) (time.Time, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ClaimAccrualStartKeyPrefix)
+	bz := store.Get(types.GetClaimAccrualStartKey(claimType, owner))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	var start time.Time
+	k.cdc.MustUnmarshalBinaryBare(bz, &start)
+	return start, true
+}
+
+// SetClaimAccrualStart starts a new accrual period for the owner's claim of the input type, replacing any previous one
+func (k Keeper) SetClaimAccrualStart(ctx sdk.Context, claimType string, owner sdk.AccAddress, start time.Time) {
+	k.DeleteClaimAccrualStart(ctx, claimType, owner)
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ClaimAccrualStartKeyPrefix)
+	store.Set(types.GetClaimAccrualStartKey(claimType, owner), k.cdc.MustMarshalBinaryBare(start))
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.key), types.ClaimAccrualQueueKeyPrefix)
+	queueStore.Set(types.GetClaimAccrualQueueKey(claimType, start, owner), owner)
+}
+
+// DeleteClaimAccrualStart removes the accrual period of the owner's claim of the input type
+func (k Keeper) DeleteClaimAccrualStart(ctx sdk.Context, claimType string, owner sdk.AccAddress) {
+	start, found := k.GetClaimAccrualStart(ctx, claimType, owner)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ClaimAccrualStartKeyPrefix)
+	store.Delete(types.GetClaimAccrualStartKey(claimType, owner))
+
+	queueStore := prefix.NewStore(ctx.KVStore(k.key), types.ClaimAccrualQueueKeyPrefix)
+	queueStore.Delete(types.GetClaimAccrualQueueKey(claimType, start, owner))
+}
+
+// IterateClaimAccrualQueue iterates over the owners of claims of the input type whose accrual period started at or before
+// the cutoff time, in order of start time, and performs a callback function
+func (k Keeper) IterateClaimAccrualQueue(ctx sdk.Context, claimType string, inclusiveCutoffTime time.Time, cb func(owner sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), append(types.ClaimAccrualQueueKeyPrefix, types.GetClaimTypePrefix(claimType)...))
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(inclusiveCutoffTime)))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// IterateClaimAccrualOwners iterates over the owners of all claims of the input type with an accrual period,
+// in order of start time, and performs a callback function
+func (k Keeper) IterateClaimAccrualOwners(ctx sdk.Context, claimType string, cb func(owner sdk.AccAddress) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), append(types.ClaimAccrualQueueKeyPrefix, types.GetClaimTypePrefix(claimType)...))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(sdk.AccAddress(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// updateClaimAccrualPeriod keeps only claims with rewards in the accrual queue. An accrual period starts at the current block time
+// when a claim first holds rewards, and ends when its rewards are claimed or expire.
+func (k Keeper) updateClaimAccrualPeriod(ctx sdk.Context, claimType string, owner sdk.AccAddress, reward sdk.Coins) {
+	if reward.IsZero() {
+		k.DeleteClaimAccrualStart(ctx, claimType, owner)
+		return
+	}
+	if _, found := k.GetClaimAccrualStart(ctx, claimType, owner); !found {
+		k.SetClaimAccrualStart(ctx, claimType, owner, ctx.BlockTime())
+	}
+}
+
+// GetClaimExpiryStart returns when the expiry period of the claim type's window took effect
+func (k Keeper) GetClaimExpiryStart(ctx sdk.Context, claimType string) (time.Time, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ClaimExpiryStartKeyPrefix)
+	bz := store.Get([]byte(claimType))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	var start time.Time
+	k.cdc.MustUnmarshalBinaryBare(bz, &start)
+	return start, true
+}
+
+// SetClaimExpiryStart sets when the expiry period of the claim type's window took effect
+func (k Keeper) SetClaimExpiryStart(ctx sdk.Context, claimType string, start time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.ClaimExpiryStartKeyPrefix)
+	store.Set([]byte(claimType), k.cdc.MustMarshalBinaryBare(start))
+}
+
+// UpdateClaimExpiryStarts records the current block time as the start of each claim window's expiry period if it was enabled
+// or shortened since the previous claim windows, so that the new expiry period does not apply retroactively.
+// Lengthened expiry periods don't need a new start as they only give owners more time to claim.
+func (k Keeper) UpdateClaimExpiryStarts(ctx sdk.Context, previousWindows types.ClaimWindows) {
+	for _, window := range k.GetParams(ctx).ClaimWindows {
+		if window.ExpiryPeriod <= 0 {
+			continue
+		}
+		previous, found := previousWindows.Get(window.ClaimType)
+		if found && previous.ExpiryPeriod > 0 && previous.ExpiryPeriod <= window.ExpiryPeriod {
+			continue
+		}
+		k.SetClaimExpiryStart(ctx, window.ClaimType, ctx.BlockTime())
+	}
+}
+
+// getClaimExpiresAt returns when the rewards of an accrual period starting at accrualStart can no longer be claimed.
+// Accrual periods that started before the window's expiry period took effect are treated as starting when it did.
+func (k Keeper) getClaimExpiresAt(ctx sdk.Context, window types.ClaimWindow, accrualStart time.Time) time.Time {
+	if expiryStart, found := k.GetClaimExpiryStart(ctx, window.ClaimType); found && expiryStart.After(accrualStart) {
+		accrualStart = expiryStart
+	}
+	return window.ExpiresAt(accrualStart)
+}
+
+// validateClaimWindow returns an error if rewards of the input claim type cannot be claimed at the current block time
+func (k Keeper) validateClaimWindow(ctx sdk.Context, claimType string) error {
+	window, found := k.GetClaimWindow(ctx, claimType)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrClaimWindowNotFound, claimType)
+	}
+	if ctx.BlockTime().After(window.End) {
+		return sdkerrors.Wrapf(types.ErrClaimExpired, "block time %s > %s claim end time %s", ctx.BlockTime(), claimType, window.End)
+	}
+	return nil
+}
+
+// ExpireClaimRewards expires the rewards of claims whose accrual period has lasted longer than the expiry period
+// of their claim window, oldest first. At most MaxClaimExpiriesPerBlock claims of each type expire per block, the rest
+// are left in the queue for the following blocks.
+func (k Keeper) ExpireClaimRewards(ctx sdk.Context) error {
+	params := k.GetParams(ctx)
+	for _, window := range params.ClaimWindows {
+		if window.ExpiryPeriod <= 0 {
+			continue
+		}
+		cutoff := ctx.BlockTime().Add(-window.ExpiryPeriod)
+		// nothing expires until the expiry period has passed since it took effect
+		if expiryStart, found := k.GetClaimExpiryStart(ctx, window.ClaimType); found && expiryStart.After(cutoff) {
+			continue
+		}
+		var owners []sdk.AccAddress
+		k.IterateClaimAccrualQueue(ctx, window.ClaimType, cutoff, func(owner sdk.AccAddress) bool {
+			owners = append(owners, owner)
+			return len(owners) >= MaxClaimExpiriesPerBlock
+		})
+		for _, owner := range owners {
+			if err := k.expireClaimReward(ctx, window, owner); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// expireClaimReward zeroes out the synchronized reward of the owner's claim, which ends its accrual period.
+// The expired coins stay in the incentive module account, or are sent to the community pool if the claim window requires it.
+func (k Keeper) expireClaimReward(ctx sdk.Context, window types.ClaimWindow, owner sdk.AccAddress) error {
+	var expired sdk.Coins
+	switch window.ClaimType {
+	case types.USDXMintingClaimType:
+		claim, found := k.GetUSDXMintingClaim(ctx, owner)
+		if !found {
+			k.DeleteClaimAccrualStart(ctx, window.ClaimType, owner)
+			return nil
+		}
+		claim, err := k.SynchronizeUSDXMintingClaim(ctx, claim)
+		if err != nil {
+			return err
+		}
+		expired = sdk.NewCoins(claim.Reward)
+		k.ZeroUSDXMintingClaim(ctx, claim)
+	case types.HardLiquidityProviderClaimType:
+		k.SynchronizeHardLiquidityProviderClaim(ctx, owner)
+		claim, found := k.GetHardLiquidityProviderClaim(ctx, owner)
+		if !found {
+			k.DeleteClaimAccrualStart(ctx, window.ClaimType, owner)
+			return nil
+		}
+		expired = claim.Reward
+		k.ZeroHardLiquidityProviderClaim(ctx, claim)
+	case types.SourceClaimType:
+		k.SynchronizeSourceClaim(ctx, owner)
+		claim, found := k.GetSourceClaim(ctx, owner)
+		if !found {
+			k.DeleteClaimAccrualStart(ctx, window.ClaimType, owner)
+			return nil
+		}
+		expired = claim.Reward
+		k.ZeroSourceClaim(ctx, claim)
+	default:
+		return sdkerrors.Wrap(types.ErrInvalidClaimType, window.ClaimType)
+	}
+	// the claim is left out of the queue if it had no rewards to expire
+	k.DeleteClaimAccrualStart(ctx, window.ClaimType, owner)
+
+	if expired.IsZero() {
+		return nil
+	}
+
+	expiredTo := types.IncentiveMacc
+	macc := k.supplyKeeper.GetModuleAccount(ctx, types.IncentiveMacc)
+	// if the module account can't cover the expired rewards they were never funded, so there is nothing to return
+	if window.FundCommunityPool && macc.GetCoins().IsAllGTE(expired) {
+		if err := k.distrKeeper.FundCommunityPool(ctx, expired, macc.GetAddress()); err != nil {
+			return err
+		}
+		expiredTo = distrtypes.ModuleName
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardExpiry,
+			sdk.NewAttribute(types.AttributeKeyClaimOwner, owner.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimAmount, expired.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimType, window.ClaimType),
+			sdk.NewAttribute(types.AttributeKeyExpiredTo, expiredTo),
+		),
+	)
+	return nil
+}
+
+// GetClaimExpiry returns when the outstanding rewards of the owner's claim of the input type stop being claimable,
+// along with the rewards that would be lost
+func (k Keeper) GetClaimExpiry(ctx sdk.Context, claimType string, owner sdk.AccAddress) (types.ClaimExpiry, bool) {
+	window, found := k.GetClaimWindow(ctx, claimType)
+	if !found {
+		return types.ClaimExpiry{}, false
+	}
+	start, found := k.GetClaimAccrualStart(ctx, claimType, owner)
+	if !found {
+		return types.ClaimExpiry{}, false
+	}
+
+	var reward sdk.Coins
+	switch claimType {
+	case types.USDXMintingClaimType:
+		claim, found := k.GetUSDXMintingClaim(ctx, owner)
+		if !found {
+			return types.ClaimExpiry{}, false
+		}
+		reward = sdk.NewCoins(k.SimulateUSDXMintingSynchronization(ctx, claim).Reward)
+	case types.HardLiquidityProviderClaimType:
+		claim, found := k.GetHardLiquidityProviderClaim(ctx, owner)
+		if !found {
+			return types.ClaimExpiry{}, false
+		}
+		reward = k.SimulateHardSynchronization(ctx, claim).Reward
+	case types.SourceClaimType:
+		claim, found := k.GetSourceClaim(ctx, owner)
+		if !found {
+			return types.ClaimExpiry{}, false
+		}
+		reward = k.SimulateSourceSynchronization(ctx, claim).Reward
+	default:
+		return types.ClaimExpiry{}, false
+	}
+	return types.NewClaimExpiry(owner, claimType, reward, start, k.getClaimExpiresAt(ctx, window, start)), true
+}
+
+// GetClaimExpiries returns a page of claim expiries for each claim window matching the claim type filter.
+// The expiries of a single owner are sorted by expiry time. If owner is empty, the owners of every claim with an accrual period
+// are paged through in order of claim type and accrual start, so only the claims on the page are simulated.
+func (k Keeper) GetClaimExpiries(ctx sdk.Context, owner sdk.AccAddress, claimType string, page, limit int) types.ClaimExpiries {
+	params := k.GetParams(ctx)
+	var windows types.ClaimWindows
+	for _, window := range params.ClaimWindows {
+		if claimType == "" || window.ClaimType == claimType {
+			windows = append(windows, window)
+		}
+	}
+
+	if !owner.Empty() {
+		expiries := types.ClaimExpiries{}
+		for _, window := range windows {
+			expiry, found := k.GetClaimExpiry(ctx, window.ClaimType, owner)
+			if found {
+				expiries = append(expiries, expiry)
+			}
+		}
+		sort.SliceStable(expiries, func(i, j int) bool { return expiries[i].ExpiresAt.Before(expiries[j].ExpiresAt) })
+		start, end := client.Paginate(len(expiries), page, limit, DefaultClaimExpiriesLimit)
+		if start < 0 || end < 0 {
+			return types.ClaimExpiries{}
+		}
+		return expiries[start:end]
+	}
+
+	if page <= 0 {
+		return types.ClaimExpiries{}
+	}
+	if limit <= 0 {
+		limit = DefaultClaimExpiriesLimit
+	}
+	skip := (page - 1) * limit
+	expiries := types.ClaimExpiries{}
+	for _, window := range windows {
+		if len(expiries) >= limit {
+			break
+		}
+		var owners []sdk.AccAddress
+		k.IterateClaimAccrualOwners(ctx, window.ClaimType, func(o sdk.AccAddress) bool {
+			if skip > 0 {
+				skip--
+				return false
+			}
+			owners = append(owners, o)
+			return len(expiries)+len(owners) >= limit
+		})
+		for _, o := range owners {
+			expiry, found := k.GetClaimExpiry(ctx, window.ClaimType, o)
+			if found {
+				expiries = append(expiries, expiry)
+			}
+		}
+	}
+	return expiries
+}
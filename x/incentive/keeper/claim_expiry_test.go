@@ -0,0 +1,270 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/incentive/keeper"
+	"github.com/kava-labs/kava/x/incentive/types"
+	"github.com/kava-labs/kava/x/kavadist"
+)
+
+func (suite *KeeperTestSuite) setSourceClaimWindows(rewardPeriod types.MultiRewardPeriod, windows types.ClaimWindows) {
+	params := types.NewParams(
+		types.DefaultRewardPeriods,
+		types.DefaultMultiRewardPeriods,
+		types.DefaultMultiRewardPeriods,
+		types.DefaultRewardPeriods,
+		types.MultiRewardPeriods{rewardPeriod},
+		types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
+		windows,
+	)
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestClaimWindows() {
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+
+	source := newMockRewardSource()
+	suite.keeper.RegisterRewardSource("locked", source)
+	rewardPeriod := types.NewMultiRewardPeriod(true, "locked", initialTime, initialTime.Add(time.Hour*24*365*4), cs(c("hard", 122354)))
+	suite.setSourceClaimWindows(rewardPeriod, types.ClaimWindows{
+		types.NewClaimWindow(types.USDXMintingClaimType, initialTime.Add(time.Hour*24*365), 0, false),
+	})
+	suite.Require().NoError(suite.keeper.AccumulateSourceRewards(suite.ctx, rewardPeriod))
+
+	suite.keeper.Hooks().BeforeSourceSharesModified(suite.ctx, "locked", suite.addrs[0])
+	source.shares[suite.addrs[0].String()] = d("1000000")
+	// claims without rewards have no accrual period
+	_, found := suite.keeper.GetClaimAccrualStart(suite.ctx, types.SourceClaimType, suite.addrs[0])
+	suite.Require().False(found)
+
+	// source claims have no claim window
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(100 * time.Second))
+	suite.Require().NoError(suite.keeper.AccumulateSourceRewards(suite.ctx, rewardPeriod))
+	err := suite.keeper.ClaimSourceReward(suite.ctx, suite.addrs[0], types.Large)
+	suite.Require().True(types.ErrClaimWindowNotFound.Is(err))
+
+	// the source claim window has ended
+	suite.setSourceClaimWindows(rewardPeriod, types.ClaimWindows{
+		types.NewClaimWindow(types.USDXMintingClaimType, initialTime.Add(time.Hour*24*365), 0, false),
+		types.NewClaimWindow(types.SourceClaimType, initialTime.Add(time.Minute), 0, false),
+	})
+	err = suite.keeper.ClaimSourceReward(suite.ctx, suite.addrs[0], types.Large)
+	suite.Require().True(types.ErrClaimExpired.Is(err))
+
+	// claiming inside the window pays out the reward and ends the accrual period
+	suite.setSourceClaimWindows(rewardPeriod, types.NewFixedClaimWindows(initialTime.Add(time.Hour*24*365)))
+	ak := suite.app.GetAccountKeeper()
+	ak.SetAccount(suite.ctx, ak.NewAccountWithAddress(suite.ctx, suite.addrs[0]))
+	sk := suite.app.GetSupplyKeeper()
+	suite.Require().NoError(sk.MintCoins(suite.ctx, kavadist.ModuleName, cs(c("hard", 1000000000000))))
+	suite.Require().NoError(suite.keeper.ClaimSourceReward(suite.ctx, suite.addrs[0], types.Large))
+	suite.Require().Equal(cs(c("hard", 12235400)), suite.getAccount(suite.addrs[0]).GetCoins())
+	_, found = suite.keeper.GetClaimAccrualStart(suite.ctx, types.SourceClaimType, suite.addrs[0])
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestExpireClaimRewards() {
+	type args struct {
+		fundCommunityPool bool
+		expectedExpiredTo string
+	}
+	type test struct {
+		name string
+		args args
+	}
+	testCases := []test{
+		{
+			"expired rewards stay in kavadist",
+			args{
+				fundCommunityPool: false,
+				expectedExpiredTo: kavadist.ModuleName,
+			},
+		},
+		{
+			"expired rewards fund the community pool",
+			args{
+				fundCommunityPool: true,
+				expectedExpiredTo: "distribution",
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+			suite.ctx = suite.ctx.WithBlockTime(initialTime)
+
+			source := newMockRewardSource()
+			suite.keeper.RegisterRewardSource("locked", source)
+			rewardPeriod := types.NewMultiRewardPeriod(true, "locked", initialTime, initialTime.Add(time.Hour*24*365*4), cs(c("hard", 122354)))
+			suite.setSourceClaimWindows(rewardPeriod, types.ClaimWindows{
+				types.NewClaimWindow(types.SourceClaimType, initialTime.Add(time.Hour*24*365), time.Hour*24, tc.args.fundCommunityPool),
+			})
+			suite.Require().NoError(suite.keeper.AccumulateSourceRewards(suite.ctx, rewardPeriod))
+			sk := suite.app.GetSupplyKeeper()
+			suite.Require().NoError(sk.MintCoins(suite.ctx, kavadist.ModuleName, cs(c("hard", 1000000000000))))
+
+			hooks := suite.keeper.Hooks()
+			for _, addr := range suite.addrs[:2] {
+				hooks.BeforeSourceSharesModified(suite.ctx, "locked", addr)
+				source.shares[addr.String()] = d("1000000")
+			}
+
+			// the first owner's rewards are synchronized, starting an accrual period, and the second owner claims theirs
+			accrualStart := initialTime.Add(100 * time.Second)
+			suite.ctx = suite.ctx.WithBlockTime(accrualStart)
+			suite.Require().NoError(suite.keeper.AccumulateSourceRewards(suite.ctx, rewardPeriod))
+			suite.keeper.SynchronizeSourceClaim(suite.ctx, suite.addrs[0])
+			ak := suite.app.GetAccountKeeper()
+			ak.SetAccount(suite.ctx, ak.NewAccountWithAddress(suite.ctx, suite.addrs[1]))
+			suite.Require().NoError(suite.keeper.ClaimSourceReward(suite.ctx, suite.addrs[1], types.Large))
+
+			// only claims with rewards have an expiry
+			expiries := suite.keeper.GetClaimExpiries(suite.ctx, nil, "", 1, 10)
+			suite.Require().Equal(types.ClaimExpiries{
+				types.NewClaimExpiry(suite.addrs[0], types.SourceClaimType, cs(c("hard", 6117700)), accrualStart, accrualStart.Add(time.Hour*24)),
+			}, expiries)
+			suite.Require().Len(suite.keeper.GetClaimExpiries(suite.ctx, suite.addrs[0], types.SourceClaimType, 1, 10), 1)
+			suite.Require().Empty(suite.keeper.GetClaimExpiries(suite.ctx, suite.addrs[0], types.USDXMintingClaimType, 1, 10))
+			suite.Require().Empty(suite.keeper.GetClaimExpiries(suite.ctx, suite.addrs[1], types.SourceClaimType, 1, 10))
+
+			// the accrual period has not yet run for longer than the expiry period
+			suite.ctx = suite.ctx.WithBlockTime(accrualStart.Add(time.Hour*24 - time.Second))
+			suite.Require().NoError(suite.keeper.ExpireClaimRewards(suite.ctx))
+			_, found := suite.keeper.GetClaimAccrualStart(suite.ctx, types.SourceClaimType, suite.addrs[0])
+			suite.Require().True(found)
+
+			expiryTime := accrualStart.Add(time.Hour * 24)
+			suite.ctx = suite.ctx.WithBlockTime(expiryTime)
+			suite.Require().NoError(suite.keeper.AccumulateSourceRewards(suite.ctx, rewardPeriod))
+			kavadistBalance := suite.getModuleAccount(kavadist.ModuleName).GetCoins()
+			suite.Require().NoError(suite.keeper.ExpireClaimRewards(suite.ctx))
+
+			expired := cs(c("hard", 5291810500))
+			claim, _ := suite.keeper.GetSourceClaim(suite.ctx, suite.addrs[0])
+			suite.Require().True(claim.Reward.IsZero())
+			_, found = suite.keeper.GetClaimAccrualStart(suite.ctx, types.SourceClaimType, suite.addrs[0])
+			suite.Require().False(found)
+
+			communityPool := suite.app.GetDistrKeeper().GetFeePoolCommunityCoins(suite.ctx)
+			if tc.args.fundCommunityPool {
+				suite.Require().Equal(kavadistBalance.Sub(expired), suite.getModuleAccount(kavadist.ModuleName).GetCoins())
+				suite.Require().Equal(sdk.NewDecCoinsFromCoins(expired...), communityPool)
+			} else {
+				suite.Require().Equal(kavadistBalance, suite.getModuleAccount(kavadist.ModuleName).GetCoins())
+				suite.Require().True(communityPool.IsZero())
+			}
+
+			expectedEvent := sdk.NewEvent(
+				types.EventTypeRewardExpiry,
+				sdk.NewAttribute(types.AttributeKeyClaimOwner, suite.addrs[0].String()),
+				sdk.NewAttribute(types.AttributeKeyClaimAmount, expired.String()),
+				sdk.NewAttribute(types.AttributeKeyClaimType, types.SourceClaimType),
+				sdk.NewAttribute(types.AttributeKeyExpiredTo, tc.args.expectedExpiredTo),
+			)
+			suite.Require().Contains(suite.ctx.EventManager().Events(), expectedEvent)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestExpireClaimRewardsPerBlock() {
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+	suite.setSourceClaimWindows(types.NewMultiRewardPeriod(true, "locked", initialTime, initialTime.Add(time.Hour*24*365*4), cs(c("hard", 122354))), types.ClaimWindows{
+		types.NewClaimWindow(types.SourceClaimType, initialTime.Add(time.Hour*24*365), time.Hour*24, false),
+	})
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(keeper.MaxClaimExpiriesPerBlock + 2)
+	owners, zeroOwner := addrs[:keeper.MaxClaimExpiriesPerBlock+1], addrs[keeper.MaxClaimExpiriesPerBlock+1]
+	for _, owner := range owners {
+		suite.keeper.SetSourceClaim(suite.ctx, types.NewSourceClaim(owner, cs(c("hard", 1)), nil))
+	}
+	// claims without rewards are not queued
+	suite.keeper.SetSourceClaim(suite.ctx, types.NewSourceClaim(zeroOwner, cs(), nil))
+	_, found := suite.keeper.GetClaimAccrualStart(suite.ctx, types.SourceClaimType, zeroOwner)
+	suite.Require().False(found)
+
+	countQueued := func() int {
+		count := 0
+		suite.keeper.IterateClaimAccrualOwners(suite.ctx, types.SourceClaimType, func(sdk.AccAddress) bool {
+			count++
+			return false
+		})
+		return count
+	}
+	suite.Require().Equal(len(owners), countQueued())
+
+	// the claims are paged through in order of accrual start
+	page := suite.keeper.GetClaimExpiries(suite.ctx, nil, types.SourceClaimType, 2, keeper.MaxClaimExpiriesPerBlock)
+	suite.Require().Len(page, 1)
+	suite.Require().Empty(suite.keeper.GetClaimExpiries(suite.ctx, nil, types.SourceClaimType, 3, keeper.MaxClaimExpiriesPerBlock))
+	suite.Require().Empty(suite.keeper.GetClaimExpiries(suite.ctx, nil, types.USDXMintingClaimType, 1, keeper.MaxClaimExpiriesPerBlock))
+
+	// the rewards left over in a block expire in the following blocks
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(time.Hour * 24))
+	suite.Require().NoError(suite.keeper.ExpireClaimRewards(suite.ctx))
+	suite.Require().Equal(1, countQueued())
+	claim, _ := suite.keeper.GetSourceClaim(suite.ctx, page[0].Owner)
+	suite.Require().Equal(cs(c("hard", 1)), claim.Reward)
+
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(time.Hour*24 + time.Second))
+	suite.Require().NoError(suite.keeper.ExpireClaimRewards(suite.ctx))
+	suite.Require().Equal(0, countQueued())
+	claim, _ = suite.keeper.GetSourceClaim(suite.ctx, page[0].Owner)
+	suite.Require().True(claim.Reward.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestUpdateClaimExpiryStarts() {
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+	rewardPeriod := types.NewMultiRewardPeriod(true, "locked", initialTime, initialTime.Add(time.Hour*24*365*4), cs(c("hard", 122354)))
+	claimEnd := initialTime.Add(time.Hour * 24 * 365)
+	suite.setSourceClaimWindows(rewardPeriod, types.NewFixedClaimWindows(claimEnd))
+	suite.keeper.SetSourceClaim(suite.ctx, types.NewSourceClaim(suite.addrs[0], cs(c("hard", 1)), nil))
+
+	// enabling an expiry period long after the accrual period started doesn't expire its rewards straight away
+	enabledAt := initialTime.Add(time.Hour * 24 * 30)
+	suite.ctx = suite.ctx.WithBlockTime(enabledAt)
+	previous := suite.keeper.GetParams(suite.ctx).ClaimWindows
+	suite.setSourceClaimWindows(rewardPeriod, types.ClaimWindows{
+		types.NewClaimWindow(types.SourceClaimType, claimEnd, time.Hour*24, false),
+	})
+	suite.keeper.UpdateClaimExpiryStarts(suite.ctx, previous)
+	start, found := suite.keeper.GetClaimExpiryStart(suite.ctx, types.SourceClaimType)
+	suite.Require().True(found)
+	suite.Require().Equal(enabledAt, start)
+
+	suite.Require().NoError(suite.keeper.ExpireClaimRewards(suite.ctx))
+	claim, _ := suite.keeper.GetSourceClaim(suite.ctx, suite.addrs[0])
+	suite.Require().Equal(cs(c("hard", 1)), claim.Reward)
+	expiry, found := suite.keeper.GetClaimExpiry(suite.ctx, types.SourceClaimType, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().Equal(initialTime, expiry.AccrualStart)
+	suite.Require().Equal(enabledAt.Add(time.Hour*24), expiry.ExpiresAt)
+
+	// lengthening the expiry period keeps its start
+	suite.ctx = suite.ctx.WithBlockTime(enabledAt.Add(time.Hour))
+	previous = suite.keeper.GetParams(suite.ctx).ClaimWindows
+	suite.setSourceClaimWindows(rewardPeriod, types.ClaimWindows{
+		types.NewClaimWindow(types.SourceClaimType, claimEnd, time.Hour*48, false),
+	})
+	suite.keeper.UpdateClaimExpiryStarts(suite.ctx, previous)
+	start, _ = suite.keeper.GetClaimExpiryStart(suite.ctx, types.SourceClaimType)
+	suite.Require().Equal(enabledAt, start)
+
+	// the rewards expire once the expiry period has run from when it took effect
+	suite.ctx = suite.ctx.WithBlockTime(enabledAt.Add(time.Hour*48 - time.Second))
+	suite.Require().NoError(suite.keeper.ExpireClaimRewards(suite.ctx))
+	claim, _ = suite.keeper.GetSourceClaim(suite.ctx, suite.addrs[0])
+	suite.Require().Equal(cs(c("hard", 1)), claim.Reward)
+
+	suite.ctx = suite.ctx.WithBlockTime(enabledAt.Add(time.Hour * 48))
+	suite.Require().NoError(suite.keeper.ExpireClaimRewards(suite.ctx))
+	claim, _ = suite.keeper.GetSourceClaim(suite.ctx, suite.addrs[0])
+	suite.Require().True(claim.Reward.IsZero())
+}
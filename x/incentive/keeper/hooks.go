@@ -6,6 +6,7 @@ import (
 
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	hardtypes "github.com/kava-labs/kava/x/hard/types"
+	"github.com/kava-labs/kava/x/incentive/types"
 )
 
 // Hooks wrapper struct for hooks
@@ -16,6 +17,7 @@ type Hooks struct {
 var _ cdptypes.CDPHooks = Hooks{}
 var _ hardtypes.HARDHooks = Hooks{}
 var _ stakingtypes.StakingHooks = Hooks{}
+var _ types.RewardSourceHooks = Hooks{}
 
 // Hooks create new incentive hooks
 func (k Keeper) Hooks() Hooks { return Hooks{k} }
@@ -66,10 +68,18 @@ func (h Hooks) AfterBorrowModified(ctx sdk.Context, borrow hardtypes.Borrow) {
 	h.k.UpdateHardBorrowIndexDenoms(ctx, borrow)
 }
 
+// ------------------- Reward Source Hooks -------------------
+
+// BeforeSourceSharesModified function that runs before an owner's shares of a reward source are created or modified
+func (h Hooks) BeforeSourceSharesModified(ctx sdk.Context, sourceID string, owner sdk.AccAddress) {
+	h.k.SynchronizeSourceReward(ctx, sourceID, owner)
+}
+
 /* ------------------- Staking Module Hooks -------------------
 
 Rewards are calculated based on total delegated tokens to bonded validators (not shares).
 We need to sync the claim before the user's delegated tokens are changed.
+This applies to hard delegator rewards, and to source rewards when the delegator reward source is registered.
 
 When delegated tokens (to bonded validators) are changed:
 - user creates new delegation
@@ -88,12 +98,14 @@ When delegated tokens (to bonded validators) are changed:
 func (h Hooks) BeforeDelegationCreated(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	// Add a claim if one doesn't exist, otherwise sync the existing.
 	h.k.InitializeHardDelegatorReward(ctx, delAddr)
+	h.k.SynchronizeDelegatorSourceReward(ctx, delAddr, nil, false)
 }
 
 // BeforeDelegationSharesModified runs before an existing delegation is modified
 func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) {
 	// Sync rewards based on total delegated to bonded validators.
 	h.k.SynchronizeHardDelegatorRewards(ctx, delAddr, nil, false)
+	h.k.SynchronizeDelegatorSourceReward(ctx, delAddr, nil, false)
 }
 
 // BeforeValidatorSlashed is called before a validator is slashed
@@ -103,6 +115,7 @@ func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, f
 	// For each claim, sync based on the total delegated to bonded validators.
 	for _, delegation := range h.k.stakingKeeper.GetValidatorDelegations(ctx, valAddr) {
 		h.k.SynchronizeHardDelegatorRewards(ctx, delegation.DelegatorAddress, nil, false)
+		h.k.SynchronizeDelegatorSourceReward(ctx, delegation.DelegatorAddress, nil, false)
 	}
 }
 
@@ -114,6 +127,7 @@ func (h Hooks) AfterValidatorBeginUnbonding(ctx sdk.Context, consAddr sdk.ConsAd
 	// valAddr's status has just been set to Unbonding, but we want to include delegations to it in the sync.
 	for _, delegation := range h.k.stakingKeeper.GetValidatorDelegations(ctx, valAddr) {
 		h.k.SynchronizeHardDelegatorRewards(ctx, delegation.DelegatorAddress, valAddr, true)
+		h.k.SynchronizeDelegatorSourceReward(ctx, delegation.DelegatorAddress, valAddr, true)
 	}
 }
 
@@ -125,6 +139,7 @@ func (h Hooks) AfterValidatorBonded(ctx sdk.Context, consAddr sdk.ConsAddress, v
 	// valAddr's status has just been set to Bonded, but we don't want to include delegations to it in the sync
 	for _, delegation := range h.k.stakingKeeper.GetValidatorDelegations(ctx, valAddr) {
 		h.k.SynchronizeHardDelegatorRewards(ctx, delegation.DelegatorAddress, valAddr, false)
+		h.k.SynchronizeDelegatorSourceReward(ctx, delegation.DelegatorAddress, valAddr, false)
 	}
 }
 
@@ -16,9 +16,11 @@ type Keeper struct {
 	accountKeeper types.AccountKeeper
 	cdc           *codec.Codec
 	cdpKeeper     types.CdpKeeper
+	distrKeeper   types.DistrKeeper
 	hardKeeper    types.HardKeeper
 	key           sdk.StoreKey
 	paramSubspace subspace.Subspace
+	sources       map[string]types.RewardSource
 	supplyKeeper  types.SupplyKeeper
 	stakingKeeper types.StakingKeeper
 }
@@ -26,16 +28,18 @@ type Keeper struct {
 // NewKeeper creates a new keeper
 func NewKeeper(
 	cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace, sk types.SupplyKeeper,
-	cdpk types.CdpKeeper, hk types.HardKeeper, ak types.AccountKeeper, stk types.StakingKeeper,
+	cdpk types.CdpKeeper, hk types.HardKeeper, ak types.AccountKeeper, stk types.StakingKeeper, dk types.DistrKeeper,
 ) Keeper {
 
 	return Keeper{
 		accountKeeper: ak,
 		cdc:           cdc,
 		cdpKeeper:     cdpk,
+		distrKeeper:   dk,
 		hardKeeper:    hk,
 		key:           key,
 		paramSubspace: paramstore.WithKeyTable(types.ParamKeyTable()),
+		sources:       make(map[string]types.RewardSource),
 		supplyKeeper:  sk,
 		stakingKeeper: stk,
 	}
@@ -58,13 +62,14 @@ func (k Keeper) SetUSDXMintingClaim(ctx sdk.Context, c types.USDXMintingClaim) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingClaimKeyPrefix)
 	bz := k.cdc.MustMarshalBinaryBare(c)
 	store.Set(c.Owner, bz)
-
+	k.updateClaimAccrualPeriod(ctx, types.USDXMintingClaimType, c.Owner, sdk.NewCoins(c.Reward))
 }
 
 // DeleteUSDXMintingClaim deletes the claim in the store corresponding to the input address, collateral type, and id
 func (k Keeper) DeleteUSDXMintingClaim(ctx sdk.Context, owner sdk.AccAddress) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.USDXMintingClaimKeyPrefix)
 	store.Delete(owner)
+	k.DeleteClaimAccrualStart(ctx, types.USDXMintingClaimType, owner)
 }
 
 // IterateUSDXMintingClaims iterates over all claim  objects in the store and preforms a callback function
@@ -172,12 +177,14 @@ func (k Keeper) SetHardLiquidityProviderClaim(ctx sdk.Context, c types.HardLiqui
 	store := prefix.NewStore(ctx.KVStore(k.key), types.HardLiquidityClaimKeyPrefix)
 	bz := k.cdc.MustMarshalBinaryBare(c)
 	store.Set(c.Owner, bz)
+	k.updateClaimAccrualPeriod(ctx, types.HardLiquidityProviderClaimType, c.Owner, c.Reward)
 }
 
 // DeleteHardLiquidityProviderClaim deletes the claim in the store corresponding to the input address, collateral type, and id
 func (k Keeper) DeleteHardLiquidityProviderClaim(ctx sdk.Context, owner sdk.AccAddress) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.HardLiquidityClaimKeyPrefix)
 	store.Delete(owner)
+	k.DeleteClaimAccrualStart(ctx, types.HardLiquidityProviderClaimType, owner)
 }
 
 // IterateHardLiquidityProviderClaims iterates over all claim  objects in the store and preforms a callback function
@@ -351,3 +358,104 @@ func (k Keeper) SetPreviousHardDelegatorRewardAccrualTime(ctx sdk.Context, denom
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousHardDelegatorRewardAccrualTimeKeyPrefix)
 	store.Set([]byte(denom), k.cdc.MustMarshalBinaryBare(blockTime))
 }
+
+// GetSourceClaim returns the reward source claim in the store corresponding the the input address and a boolean for if the claim was found
+func (k Keeper) GetSourceClaim(ctx sdk.Context, addr sdk.AccAddress) (types.SourceClaim, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceClaimKeyPrefix)
+	bz := store.Get(addr)
+	if bz == nil {
+		return types.SourceClaim{}, false
+	}
+	var c types.SourceClaim
+	k.cdc.MustUnmarshalBinaryBare(bz, &c)
+	return c, true
+}
+
+// SetSourceClaim sets the reward source claim in the store corresponding to the input address
+func (k Keeper) SetSourceClaim(ctx sdk.Context, c types.SourceClaim) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceClaimKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(c)
+	store.Set(c.Owner, bz)
+	k.updateClaimAccrualPeriod(ctx, types.SourceClaimType, c.Owner, c.Reward)
+}
+
+// DeleteSourceClaim deletes the reward source claim in the store corresponding to the input address
+func (k Keeper) DeleteSourceClaim(ctx sdk.Context, owner sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceClaimKeyPrefix)
+	store.Delete(owner)
+	k.DeleteClaimAccrualStart(ctx, types.SourceClaimType, owner)
+}
+
+// IterateSourceClaims iterates over all reward source claim objects in the store and preforms a callback function
+func (k Keeper) IterateSourceClaims(ctx sdk.Context, cb func(c types.SourceClaim) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceClaimKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var c types.SourceClaim
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &c)
+		if cb(c) {
+			break
+		}
+	}
+}
+
+// GetAllSourceClaims returns all reward source claim objects in the store
+func (k Keeper) GetAllSourceClaims(ctx sdk.Context) types.SourceClaims {
+	cs := types.SourceClaims{}
+	k.IterateSourceClaims(ctx, func(c types.SourceClaim) (stop bool) {
+		cs = append(cs, c)
+		return false
+	})
+	return cs
+}
+
+// SetSourceRewardIndexes sets the current reward indexes for an individual reward source
+func (k Keeper) SetSourceRewardIndexes(ctx sdk.Context, sourceID string, indexes types.RewardIndexes) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceRewardIndexesKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryBare(indexes)
+	store.Set([]byte(sourceID), bz)
+}
+
+// GetSourceRewardIndexes gets the current reward indexes for an individual reward source
+func (k Keeper) GetSourceRewardIndexes(ctx sdk.Context, sourceID string) (types.RewardIndexes, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceRewardIndexesKeyPrefix)
+	bz := store.Get([]byte(sourceID))
+	if bz == nil {
+		return types.RewardIndexes{}, false
+	}
+	var rewardIndexes types.RewardIndexes
+	k.cdc.MustUnmarshalBinaryBare(bz, &rewardIndexes)
+	return rewardIndexes, true
+}
+
+// IterateSourceRewardIndexes iterates over all reward source reward index objects in the store and preforms a callback function
+func (k Keeper) IterateSourceRewardIndexes(ctx sdk.Context, cb func(sourceID string, indexes types.RewardIndexes) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SourceRewardIndexesKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var indexes types.RewardIndexes
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &indexes)
+		if cb(string(iterator.Key()), indexes) {
+			break
+		}
+	}
+}
+
+// GetPreviousSourceRewardAccrualTime returns the last time a reward source accrued rewards
+func (k Keeper) GetPreviousSourceRewardAccrualTime(ctx sdk.Context, sourceID string) (blockTime time.Time, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSourceRewardAccrualTimeKeyPrefix)
+	bz := store.Get([]byte(sourceID))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	k.cdc.MustUnmarshalBinaryBare(bz, &blockTime)
+	return blockTime, true
+}
+
+// SetPreviousSourceRewardAccrualTime sets the last time a reward source accrued rewards
+func (k Keeper) SetPreviousSourceRewardAccrualTime(ctx sdk.Context, sourceID string, blockTime time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousSourceRewardAccrualTimeKeyPrefix)
+	store.Set([]byte(sourceID), k.cdc.MustMarshalBinaryBare(blockTime))
+}
@@ -1,8 +1,6 @@
 package keeper
 
 import (
-	"time"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/incentive/types"
@@ -64,6 +62,17 @@ func (k Keeper) GetHardDelegatorRewardPeriod(ctx sdk.Context, denom string) (typ
 	return types.RewardPeriod{}, false
 }
 
+// GetSourceRewardPeriods returns the multi reward period of the reward source with the specified id if it's found in the params
+func (k Keeper) GetSourceRewardPeriods(ctx sdk.Context, sourceID string) (types.MultiRewardPeriod, bool) {
+	params := k.GetParams(ctx)
+	for _, rp := range params.SourceRewardPeriods {
+		if rp.CollateralType == sourceID {
+			return rp, true
+		}
+	}
+	return types.MultiRewardPeriod{}, false
+}
+
 // GetMultiplier returns the multiplier with the specified name if it's found in the params
 func (k Keeper) GetMultiplier(ctx sdk.Context, name types.MultiplierName) (types.Multiplier, bool) {
 	params := k.GetParams(ctx)
@@ -75,8 +84,8 @@ func (k Keeper) GetMultiplier(ctx sdk.Context, name types.MultiplierName) (types
 	return types.Multiplier{}, false
 }
 
-// GetClaimEnd returns the claim end time for the params
-func (k Keeper) GetClaimEnd(ctx sdk.Context) time.Time {
+// GetClaimWindow returns the claim window for the specified claim type if it's found in the params
+func (k Keeper) GetClaimWindow(ctx sdk.Context, claimType string) (types.ClaimWindow, bool) {
 	params := k.GetParams(ctx)
-	return params.ClaimEnd
+	return params.ClaimWindows.Get(claimType)
 }
@@ -96,8 +96,9 @@ func (suite *KeeperTestSuite) TestPayoutUSDXMintingClaim() {
 				types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, tc.args.ctype, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), cs(tc.args.rewardsPerSecond))},
 				types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, tc.args.ctype, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), cs(tc.args.rewardsPerSecond))},
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.ctype, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond)},
+				types.DefaultMultiRewardPeriods,
 				tc.args.multipliers,
-				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.NewFixedClaimWindows(tc.args.initialTime.Add(time.Hour*24*365*5)),
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousUSDXMintingAccrualTime(suite.ctx, tc.args.ctype, tc.args.initialTime)
@@ -319,8 +320,9 @@ func (suite *KeeperTestSuite) TestPayoutHardLiquidityProviderClaim() {
 			// Set up generic reward periods
 			params := types.NewParams(
 				rewardPeriods, multiRewardPeriods, multiRewardPeriods, rewardPeriods,
+				types.DefaultMultiRewardPeriods,
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
-				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.NewFixedClaimWindows(tc.args.initialTime.Add(time.Hour*24*365*5)),
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 
@@ -25,8 +25,14 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryGetUSDXMintingRewards(ctx, req, k)
 		case types.QueryGetUSDXMintingRewardsUnsynced:
 			return queryGetUSDXMintingRewardsUnsynced(ctx, req, k)
+		case types.QueryGetSourceRewards:
+			return queryGetSourceRewards(ctx, req, k)
+		case types.QueryGetSourceRewardsUnsynced:
+			return queryGetSourceRewardsUnsynced(ctx, req, k)
 		case types.QueryGetRewardFactors:
 			return queryGetRewardFactors(ctx, req, k)
+		case types.QueryGetClaimExpiries:
+			return queryGetClaimExpiries(ctx, req, k)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -198,6 +204,84 @@ func queryGetUSDXMintingRewardsUnsynced(ctx sdk.Context, req abci.RequestQuery,
 	return bz, nil
 }
 
+func queryGetSourceRewards(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySourceRewardsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	sourceClaims := getSourceClaims(ctx, k, params)
+
+	var augmentedSourceClaims types.SourceClaims
+	for _, claim := range sourceClaims {
+		augmentedClaim := k.SimulateSourceSynchronization(ctx, claim)
+		augmentedSourceClaims = append(augmentedSourceClaims, augmentedClaim)
+	}
+
+	// Marshal source claims
+	bz, err := codec.MarshalJSONIndent(k.cdc, augmentedSourceClaims)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryGetSourceRewardsUnsynced(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QuerySourceRewardsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	// Marshal source claims
+	bz, err := codec.MarshalJSONIndent(k.cdc, getSourceClaims(ctx, k, params))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// getSourceClaims returns the page of source claims matching the query params
+func getSourceClaims(ctx sdk.Context, k Keeper, params types.QuerySourceRewardsParams) types.SourceClaims {
+	var sourceClaims types.SourceClaims
+	if len(params.Owner) > 0 {
+		sourceClaim, foundSourceClaim := k.GetSourceClaim(ctx, params.Owner)
+		if foundSourceClaim {
+			sourceClaims = append(sourceClaims, sourceClaim)
+		}
+	} else {
+		sourceClaims = k.GetAllSourceClaims(ctx)
+	}
+
+	start, end := client.Paginate(len(sourceClaims), params.Page, params.Limit, 100)
+	if start < 0 || end < 0 {
+		return types.SourceClaims{}
+	}
+	return sourceClaims[start:end]
+}
+
+func queryGetClaimExpiries(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
+	var params types.QueryClaimExpiriesParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	if len(params.Type) > 0 {
+		if err := types.ValidateClaimType(params.Type); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidClaimType, err.Error())
+		}
+	}
+
+	expiries := k.GetClaimExpiries(ctx, params.Owner, params.Type, params.Page, params.Limit)
+
+	bz, err := codec.MarshalJSONIndent(k.cdc, expiries)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
 func queryGetRewardFactors(ctx sdk.Context, req abci.RequestQuery, k Keeper) ([]byte, error) {
 	var params types.QueryRewardFactorsParams
 	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
@@ -25,51 +25,14 @@ func (k Keeper) AccumulateHardBorrowRewards(ctx sdk.Context, rewardPeriod types.
 		return nil
 	}
 
-	totalBorrowedCoins, foundTotalBorrowedCoins := k.hardKeeper.GetBorrowedCoins(ctx)
-	if !foundTotalBorrowedCoins {
+	totalShares := k.hardBorrowSource(rewardPeriod.CollateralType).GetTotalShares(ctx)
+	if !totalShares.IsPositive() {
 		k.SetPreviousHardBorrowRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
 
-	totalBorrowed := totalBorrowedCoins.AmountOf(rewardPeriod.CollateralType).ToDec()
-	if totalBorrowed.IsZero() {
-		k.SetPreviousHardBorrowRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
-		return nil
-	}
-
-	previousRewardIndexes, found := k.GetHardBorrowRewardIndexes(ctx, rewardPeriod.CollateralType)
-	if !found {
-		for _, rewardCoin := range rewardPeriod.RewardsPerSecond {
-			rewardIndex := types.NewRewardIndex(rewardCoin.Denom, sdk.ZeroDec())
-			previousRewardIndexes = append(previousRewardIndexes, rewardIndex)
-		}
-		k.SetHardBorrowRewardIndexes(ctx, rewardPeriod.CollateralType, previousRewardIndexes)
-	}
-	hardFactor, found := k.hardKeeper.GetBorrowInterestFactor(ctx, rewardPeriod.CollateralType)
-	if !found {
-		k.SetPreviousHardBorrowRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
-		return nil
-	}
-
-	newRewardIndexes := previousRewardIndexes
-	for _, rewardCoin := range rewardPeriod.RewardsPerSecond {
-		newRewards := rewardCoin.Amount.ToDec().Mul(timeElapsed.ToDec())
-		previousRewardIndex, found := previousRewardIndexes.GetRewardIndex(rewardCoin.Denom)
-		if !found {
-			previousRewardIndex = types.NewRewardIndex(rewardCoin.Denom, sdk.ZeroDec())
-		}
-
-		// Calculate new reward factor and update reward index
-		rewardFactor := newRewards.Mul(hardFactor).Quo(totalBorrowed)
-		newRewardFactorValue := previousRewardIndex.RewardFactor.Add(rewardFactor)
-		newRewardIndex := types.NewRewardIndex(rewardCoin.Denom, newRewardFactorValue)
-		i, found := newRewardIndexes.GetFactorIndex(rewardCoin.Denom)
-		if found {
-			newRewardIndexes[i] = newRewardIndex
-		} else {
-			newRewardIndexes = append(newRewardIndexes, newRewardIndex)
-		}
-	}
+	previousRewardIndexes, _ := k.GetHardBorrowRewardIndexes(ctx, rewardPeriod.CollateralType)
+	newRewardIndexes := accumulateRewardIndexes(previousRewardIndexes, rewardPeriod.RewardsPerSecond, timeElapsed, totalShares)
 	k.SetHardBorrowRewardIndexes(ctx, rewardPeriod.CollateralType, newRewardIndexes)
 	k.SetPreviousHardBorrowRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 	return nil
@@ -122,6 +85,7 @@ func (k Keeper) SynchronizeHardBorrowReward(ctx sdk.Context, borrow hardtypes.Bo
 		if !foundUserRewardIndexIndex {
 			continue
 		}
+		shares := k.hardBorrowSource(coin.Denom).GetOwnerShares(ctx, borrow.Borrower)
 
 		for _, globalRewardIndex := range globalRewardIndexes {
 			userRewardIndex, foundUserRewardIndex := userMultiRewardIndex.RewardIndexes.GetRewardIndex(globalRewardIndex.CollateralType)
@@ -141,7 +105,7 @@ func (k Keeper) SynchronizeHardBorrowReward(ctx sdk.Context, borrow hardtypes.Bo
 				panic(fmt.Sprintf("reward accumulation factor cannot be negative: %s", rewardsAccumulatedFactor))
 			}
 
-			newRewardsAmount := rewardsAccumulatedFactor.Mul(borrow.Amount.AmountOf(coin.Denom).ToDec()).RoundInt()
+			newRewardsAmount := rewardsAccumulatedFactor.Mul(shares).RoundInt()
 
 			factorIndex, foundFactorIndex := userMultiRewardIndex.RewardIndexes.GetFactorIndex(globalRewardIndex.CollateralType)
 			if !foundFactorIndex { // should never trigger
@@ -192,3 +156,38 @@ func (k Keeper) UpdateHardBorrowIndexDenoms(ctx sdk.Context, borrow hardtypes.Bo
 	claim.BorrowRewardIndexes = borrowRewardIndexes
 	k.SetHardLiquidityProviderClaim(ctx, claim)
 }
+
+// hardBorrowSource is the reward source for a denom borrowed in hard.
+// Total shares are normalized by the denom's borrow interest factor, so the rewards earned by a borrow grow with its interest.
+type hardBorrowSource struct {
+	hardKeeper types.HardKeeper
+	denom      string
+}
+
+var _ types.RewardSource = hardBorrowSource{}
+
+func (k Keeper) hardBorrowSource(denom string) hardBorrowSource {
+	return hardBorrowSource{hardKeeper: k.hardKeeper, denom: denom}
+}
+
+// GetTotalShares returns the normalized amount of the denom borrowed
+func (s hardBorrowSource) GetTotalShares(ctx sdk.Context) sdk.Dec {
+	total, found := s.hardKeeper.GetBorrowedCoins(ctx)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	interestFactor, found := s.hardKeeper.GetBorrowInterestFactor(ctx, s.denom)
+	if !found || !interestFactor.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return total.AmountOf(s.denom).ToDec().Quo(interestFactor)
+}
+
+// GetOwnerShares returns the amount of the denom in the owner's borrow
+func (s hardBorrowSource) GetOwnerShares(ctx sdk.Context, owner sdk.AccAddress) sdk.Dec {
+	borrow, found := s.hardKeeper.GetBorrow(ctx, owner)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return borrow.Amount.AmountOf(s.denom).ToDec()
+}
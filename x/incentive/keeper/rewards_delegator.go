@@ -24,8 +24,8 @@ func (k Keeper) AccumulateHardDelegatorRewards(ctx sdk.Context, rewardPeriod typ
 		return nil
 	}
 
-	totalBonded := k.stakingKeeper.TotalBondedTokens(ctx).ToDec()
-	if totalBonded.IsZero() {
+	totalBonded := NewDelegatorRewardSource(k.stakingKeeper).GetTotalShares(ctx)
+	if !totalBonded.IsPositive() {
 		k.SetPreviousHardDelegatorRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
@@ -91,22 +91,61 @@ func (k Keeper) SynchronizeHardDelegatorRewards(ctx sdk.Context, delegator sdk.A
 	}
 	claim.DelegatorRewardIndexes[delegatorIndex].RewardFactor = delagatorFactor
 
+	totalDelegated := NewDelegatorRewardSource(k.stakingKeeper).withValidator(valAddr, shouldIncludeValidator).GetOwnerShares(ctx, delegator)
+	rewardsEarned := rewardsAccumulatedFactor.Mul(totalDelegated).RoundInt()
+
+	// Add rewards to delegator's hard claim
+	newRewardsCoin := sdk.NewCoin(types.HardLiquidityRewardDenom, rewardsEarned)
+	claim.Reward = claim.Reward.Add(newRewardsCoin)
+	k.SetHardLiquidityProviderClaim(ctx, claim)
+}
+
+// DelegatorRewardSource is the reward source for tokens delegated to bonded validators.
+// It is used for hard delegator rewards, and can be registered to earn source rewards under the bond denom.
+type DelegatorRewardSource struct {
+	stakingKeeper    types.StakingKeeper
+	validator        sdk.ValAddress
+	includeValidator bool
+}
+
+var _ types.RewardSource = DelegatorRewardSource{}
+
+// NewDelegatorRewardSource returns a new delegator reward source
+func NewDelegatorRewardSource(sk types.StakingKeeper) DelegatorRewardSource {
+	return DelegatorRewardSource{stakingKeeper: sk}
+}
+
+// withValidator returns a copy of the source that ignores, or includes, delegations to the input validator whatever its status.
+// This is needed as staking hooks are sometimes called on the wrong side of a validator's state update (from this module's perspective).
+func (s DelegatorRewardSource) withValidator(valAddr sdk.ValAddress, include bool) DelegatorRewardSource {
+	s.validator = valAddr
+	s.includeValidator = include
+	return s
+}
+
+// GetTotalShares returns the tokens delegated to bonded validators
+func (s DelegatorRewardSource) GetTotalShares(ctx sdk.Context) sdk.Dec {
+	return s.stakingKeeper.TotalBondedTokens(ctx).ToDec()
+}
+
+// GetOwnerShares returns the tokens the owner has delegated to bonded validators
+func (s DelegatorRewardSource) GetOwnerShares(ctx sdk.Context, owner sdk.AccAddress) sdk.Dec {
 	totalDelegated := sdk.ZeroDec()
 
-	delegations := k.stakingKeeper.GetDelegatorDelegations(ctx, delegator, 200)
+	delegations := s.stakingKeeper.GetDelegatorDelegations(ctx, owner, 200)
 	for _, delegation := range delegations {
-		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
+		validator, found := s.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
 		if !found {
 			continue
 		}
 
-		if valAddr == nil {
+		if s.validator == nil {
 			// Delegators don't accumulate rewards if their validator is unbonded
 			if validator.GetStatus() != sdk.Bonded {
 				continue
 			}
 		} else {
-			if !shouldIncludeValidator && validator.OperatorAddress.Equals(valAddr) {
+			if !s.includeValidator && validator.OperatorAddress.Equals(s.validator) {
 				// ignore tokens delegated to the validator
 				continue
 			}
@@ -122,10 +161,5 @@ func (k Keeper) SynchronizeHardDelegatorRewards(ctx sdk.Context, delegator sdk.A
 		}
 		totalDelegated = totalDelegated.Add(delegatedTokens)
 	}
-	rewardsEarned := rewardsAccumulatedFactor.Mul(totalDelegated).RoundInt()
-
-	// Add rewards to delegator's hard claim
-	newRewardsCoin := sdk.NewCoin(types.HardLiquidityRewardDenom, rewardsEarned)
-	claim.Reward = claim.Reward.Add(newRewardsCoin)
-	k.SetHardLiquidityProviderClaim(ctx, claim)
+	return totalDelegated
 }
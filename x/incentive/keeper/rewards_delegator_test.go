@@ -73,8 +73,9 @@ func (suite *KeeperTestSuite) TestAccumulateHardDelegatorRewards() {
 				types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, tc.args.delegation.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), cs(tc.args.rewardsPerSecond))},
 				types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, tc.args.delegation.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), cs(tc.args.rewardsPerSecond))},
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.delegation.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond)},
+				types.DefaultMultiRewardPeriods,
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
-				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.NewFixedClaimWindows(tc.args.initialTime.Add(time.Hour*24*365*5)),
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousHardDelegatorRewardAccrualTime(suite.ctx, tc.args.delegation.Denom, tc.args.initialTime)
@@ -173,8 +174,9 @@ func (suite *KeeperTestSuite) TestSynchronizeHardDelegatorReward() {
 				types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, tc.args.delegation.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), cs(tc.args.rewardsPerSecond))},
 				types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, tc.args.delegation.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), cs(tc.args.rewardsPerSecond))},
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.delegation.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond)},
+				types.DefaultMultiRewardPeriods,
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
-				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.NewFixedClaimWindows(tc.args.initialTime.Add(time.Hour*24*365*5)),
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousHardDelegatorRewardAccrualTime(suite.ctx, tc.args.delegation.Denom, tc.args.initialTime)
@@ -298,8 +300,9 @@ func (suite *KeeperTestSuite) TestSimulateHardDelegatorRewardSynchronization() {
 				types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, tc.args.delegation.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond)},
 				types.MultiRewardPeriods{types.NewMultiRewardPeriod(true, tc.args.delegation.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond)},
 				types.RewardPeriods{types.NewRewardPeriod(true, tc.args.delegation.Denom, tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond[0])},
+				types.DefaultMultiRewardPeriods,
 				types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
-				tc.args.initialTime.Add(time.Hour*24*365*5),
+				types.NewFixedClaimWindows(tc.args.initialTime.Add(time.Hour*24*365*5)),
 			)
 			suite.keeper.SetParams(suite.ctx, params)
 			suite.keeper.SetPreviousHardDelegatorRewardAccrualTime(suite.ctx, tc.args.delegation.Denom, tc.args.initialTime)
@@ -414,8 +417,9 @@ func (suite *KeeperTestSuite) TestUnbondingValidatorSyncsClaim() {
 		types.RewardPeriods{
 			types.NewRewardPeriod(true, bondDenom, initialTime.Add(-1*oneYear), initialTime.Add(4*oneYear), rewardsPerSecond),
 		},
+		types.DefaultMultiRewardPeriods,
 		types.DefaultMultipliers,
-		initialTime.Add(5*oneYear),
+		types.NewFixedClaimWindows(initialTime.Add(5*oneYear)),
 	)
 	suite.keeper.SetParams(suite.ctx, params)
 	suite.keeper.SetPreviousHardDelegatorRewardAccrualTime(suite.ctx, bondDenom, initialTime)
@@ -508,8 +512,9 @@ func (suite *KeeperTestSuite) TestBondingValidatorSyncsClaim() {
 		types.RewardPeriods{
 			types.NewRewardPeriod(true, bondDenom, initialTime.Add(-1*oneYear), initialTime.Add(4*oneYear), rewardsPerSecond),
 		},
+		types.DefaultMultiRewardPeriods,
 		types.DefaultMultipliers,
-		initialTime.Add(5*oneYear),
+		types.NewFixedClaimWindows(initialTime.Add(5*oneYear)),
 	)
 	suite.keeper.SetParams(suite.ctx, params)
 	suite.keeper.SetPreviousHardDelegatorRewardAccrualTime(suite.ctx, bondDenom, initialTime)
@@ -602,8 +607,9 @@ func (suite *KeeperTestSuite) TestSlashingValidatorSyncsClaim() {
 		types.RewardPeriods{
 			types.NewRewardPeriod(true, bondDenom, initialTime.Add(-1*oneYear), initialTime.Add(4*oneYear), rewardsPerSecond),
 		},
+		types.DefaultMultiRewardPeriods,
 		types.DefaultMultipliers,
-		initialTime.Add(5*oneYear),
+		types.NewFixedClaimWindows(initialTime.Add(5*oneYear)),
 	)
 	suite.keeper.SetParams(suite.ctx, params)
 	suite.keeper.SetPreviousHardDelegatorRewardAccrualTime(suite.ctx, bondDenom, initialTime.Add(-1*blockDuration))
@@ -689,8 +695,9 @@ func (suite *KeeperTestSuite) TestRedelegationSyncsClaim() {
 		types.RewardPeriods{
 			types.NewRewardPeriod(true, bondDenom, initialTime.Add(-1*oneYear), initialTime.Add(4*oneYear), rewardsPerSecond),
 		},
+		types.DefaultMultiRewardPeriods,
 		types.DefaultMultipliers,
-		initialTime.Add(5*oneYear),
+		types.NewFixedClaimWindows(initialTime.Add(5*oneYear)),
 	)
 	suite.keeper.SetParams(suite.ctx, params)
 	suite.keeper.SetPreviousHardDelegatorRewardAccrualTime(suite.ctx, bondDenom, initialTime)
@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// RegisterRewardSource registers a reward source, which earns the rewards of the source reward period with the same id.
+// Sources must be registered when the app is created, before genesis is initialized.
+func (k Keeper) RegisterRewardSource(sourceID string, source types.RewardSource) {
+	if strings.TrimSpace(sourceID) == "" {
+		panic("reward source id cannot be blank")
+	}
+	if _, found := k.sources[sourceID]; found {
+		panic(fmt.Sprintf("reward source %s has already been registered", sourceID))
+	}
+	k.sources[sourceID] = source
+}
+
+// GetRewardSource returns the reward source registered with the input id
+func (k Keeper) GetRewardSource(sourceID string) (types.RewardSource, bool) {
+	source, found := k.sources[sourceID]
+	return source, found
+}
+
+// AccumulateSourceRewards updates the rewards accumulated for the input reward period
+func (k Keeper) AccumulateSourceRewards(ctx sdk.Context, rewardPeriod types.MultiRewardPeriod) error {
+	previousAccrualTime, found := k.GetPreviousSourceRewardAccrualTime(ctx, rewardPeriod.CollateralType)
+	if !found {
+		k.SetPreviousSourceRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
+		return nil
+	}
+	timeElapsed := CalculateTimeElapsed(rewardPeriod.Start, rewardPeriod.End, ctx.BlockTime(), previousAccrualTime)
+	if timeElapsed.IsZero() {
+		return nil
+	}
+	if rewardPeriod.RewardsPerSecond.IsZero() {
+		k.SetPreviousSourceRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
+		return nil
+	}
+
+	// Genesis and param changes reject reward periods for unregistered sources, so this should never happen
+	source, found := k.GetRewardSource(rewardPeriod.CollateralType)
+	if !found {
+		return sdkerrors.Wrap(types.ErrRewardSourceNotFound, rewardPeriod.CollateralType)
+	}
+
+	totalShares := source.GetTotalShares(ctx)
+	if !totalShares.IsPositive() {
+		k.SetPreviousSourceRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
+		return nil
+	}
+
+	previousRewardIndexes, _ := k.GetSourceRewardIndexes(ctx, rewardPeriod.CollateralType)
+	newRewardIndexes := accumulateRewardIndexes(previousRewardIndexes, rewardPeriod.RewardsPerSecond, timeElapsed, totalShares)
+	k.SetSourceRewardIndexes(ctx, rewardPeriod.CollateralType, newRewardIndexes)
+	k.SetPreviousSourceRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
+	return nil
+}
+
+// ValidateSourceRewardPeriods returns an error if any of the source reward periods in the params has no registered reward source
+func (k Keeper) ValidateSourceRewardPeriods(ctx sdk.Context) error {
+	for _, mrp := range k.GetParams(ctx).SourceRewardPeriods {
+		if _, found := k.GetRewardSource(mrp.CollateralType); !found {
+			return sdkerrors.Wrap(types.ErrRewardSourceNotFound, mrp.CollateralType)
+		}
+	}
+	return nil
+}
+
+// SynchronizeSourceReward updates the owner's source claim by adding the rewards accumulated by their shares of the
+// reward source, and setting the claim's reward indexes for the source to the global values.
+// The claim is created if it doesn't exist and the source has a reward period.
+// Sources must call this through the BeforeSourceSharesModified hook before an owner's shares change.
+func (k Keeper) SynchronizeSourceReward(ctx sdk.Context, sourceID string, owner sdk.AccAddress) {
+	source, found := k.GetRewardSource(sourceID)
+	if !found {
+		return
+	}
+	k.synchronizeSourceRewardWith(ctx, sourceID, source, owner)
+}
+
+// SynchronizeDelegatorSourceReward synchronizes the delegator's source claim if the delegator reward source is registered under the bond denom.
+// valAddr and shouldIncludeValidator are used as in SynchronizeHardDelegatorRewards.
+func (k Keeper) SynchronizeDelegatorSourceReward(ctx sdk.Context, delegator sdk.AccAddress, valAddr sdk.ValAddress, shouldIncludeValidator bool) {
+	source, found := k.GetRewardSource(types.BondDenom)
+	if !found {
+		return
+	}
+	delegatorSource, ok := source.(DelegatorRewardSource)
+	if !ok {
+		return
+	}
+	k.synchronizeSourceRewardWith(ctx, types.BondDenom, delegatorSource.withValidator(valAddr, shouldIncludeValidator), delegator)
+}
+
+// synchronizeSourceRewardWith synchronizes the owner's source claim using the owner's shares reported by the input source
+func (k Keeper) synchronizeSourceRewardWith(ctx sdk.Context, sourceID string, source types.RewardSource, owner sdk.AccAddress) {
+	claim, found := k.GetSourceClaim(ctx, owner)
+	if !found {
+		// owners of sources without rewards don't need a claim
+		if _, found := k.GetSourceRewardPeriods(ctx, sourceID); !found {
+			return
+		}
+		claim = types.NewSourceClaim(owner, sdk.Coins{}, nil)
+	}
+	claim = k.synchronizeSourceReward(ctx, claim, sourceID, source)
+	k.SetSourceClaim(ctx, claim)
+}
+
+// SynchronizeSourceClaim adds any rewards accumulated for each of the reward sources in the owner's source claim
+func (k Keeper) SynchronizeSourceClaim(ctx sdk.Context, owner sdk.AccAddress) {
+	claim, found := k.GetSourceClaim(ctx, owner)
+	if !found {
+		return
+	}
+	for _, mri := range claim.RewardIndexes {
+		source, found := k.GetRewardSource(mri.CollateralType)
+		if !found {
+			continue
+		}
+		claim = k.synchronizeSourceReward(ctx, claim, mri.CollateralType, source)
+	}
+	k.SetSourceClaim(ctx, claim)
+}
+
+// SimulateSourceSynchronization calculates a user's outstanding source rewards by simulating reward synchronization
+func (k Keeper) SimulateSourceSynchronization(ctx sdk.Context, claim types.SourceClaim) types.SourceClaim {
+	for _, mri := range claim.RewardIndexes {
+		source, found := k.GetRewardSource(mri.CollateralType)
+		if !found {
+			continue
+		}
+		claim = k.synchronizeSourceReward(ctx, claim, mri.CollateralType, source)
+	}
+	return claim
+}
+
+// ZeroSourceClaim zeroes out the claim object's rewards and returns the updated claim object
+func (k Keeper) ZeroSourceClaim(ctx sdk.Context, claim types.SourceClaim) types.SourceClaim {
+	claim.Reward = sdk.NewCoins()
+	k.SetSourceClaim(ctx, claim)
+	return claim
+}
+
+// synchronizeSourceReward adds the rewards accumulated by the claim owner's shares of a reward source to the claim,
+// and sets the claim's reward indexes for the source to the global values
+func (k Keeper) synchronizeSourceReward(ctx sdk.Context, claim types.SourceClaim, sourceID string, source types.RewardSource) types.SourceClaim {
+	globalRewardIndexes, _ := k.GetSourceRewardIndexes(ctx, sourceID)
+	shares := source.GetOwnerShares(ctx, claim.Owner)
+
+	userMultiRewardIndex, found := claim.RewardIndexes.GetRewardIndex(sourceID)
+	if !found {
+		userMultiRewardIndex = types.NewMultiRewardIndex(sourceID, types.RewardIndexes{})
+	}
+
+	for _, globalRewardIndex := range globalRewardIndexes {
+		// Reward denoms added after the owner's last synchronization have accumulated since a reward factor of 0.0
+		userRewardFactor := sdk.ZeroDec()
+		userRewardIndex, found := userMultiRewardIndex.RewardIndexes.GetRewardIndex(globalRewardIndex.CollateralType)
+		if found {
+			userRewardFactor = userRewardIndex.RewardFactor
+		}
+
+		rewardsAccumulatedFactor := globalRewardIndex.RewardFactor.Sub(userRewardFactor)
+		if rewardsAccumulatedFactor.IsNegative() {
+			panic(fmt.Sprintf("reward accumulation factor cannot be negative: %s", rewardsAccumulatedFactor))
+		}
+
+		newRewardsAmount := rewardsAccumulatedFactor.Mul(shares).RoundInt()
+		if newRewardsAmount.IsPositive() {
+			claim.Reward = claim.Reward.Add(sdk.NewCoin(globalRewardIndex.CollateralType, newRewardsAmount))
+		}
+	}
+
+	userMultiRewardIndex.RewardIndexes = append(types.RewardIndexes{}, globalRewardIndexes...)
+	rewardIndexes := append(types.MultiRewardIndexes{}, claim.RewardIndexes...)
+	i, found := rewardIndexes.GetRewardIndexIndex(sourceID)
+	if found {
+		rewardIndexes[i] = userMultiRewardIndex
+	} else {
+		rewardIndexes = append(rewardIndexes, userMultiRewardIndex)
+	}
+	claim.RewardIndexes = rewardIndexes
+	return claim
+}
+
+// accumulateRewardIndexes returns the reward indexes after adding the rewards earned per share of a reward source over the elapsed time
+func accumulateRewardIndexes(previousRewardIndexes types.RewardIndexes, rewardsPerSecond sdk.Coins, timeElapsed sdk.Int, totalShares sdk.Dec) types.RewardIndexes {
+	newRewardIndexes := append(types.RewardIndexes{}, previousRewardIndexes...)
+	for _, rewardCoin := range rewardsPerSecond {
+		newRewards := rewardCoin.Amount.ToDec().Mul(timeElapsed.ToDec())
+		previousRewardIndex, found := previousRewardIndexes.GetRewardIndex(rewardCoin.Denom)
+		if !found {
+			previousRewardIndex = types.NewRewardIndex(rewardCoin.Denom, sdk.ZeroDec())
+		}
+
+		// Calculate new reward factor and update reward index
+		rewardFactor := newRewards.Quo(totalShares)
+		newRewardFactorValue := previousRewardIndex.RewardFactor.Add(rewardFactor)
+		newRewardIndex := types.NewRewardIndex(rewardCoin.Denom, newRewardFactorValue)
+		i, found := newRewardIndexes.GetFactorIndex(rewardCoin.Denom)
+		if found {
+			newRewardIndexes[i] = newRewardIndex
+		} else {
+			newRewardIndexes = append(newRewardIndexes, newRewardIndex)
+		}
+	}
+	return newRewardIndexes
+}
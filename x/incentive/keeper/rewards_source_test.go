@@ -0,0 +1,243 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/x/incentive/types"
+)
+
+// mockRewardSource is a reward source with owner shares set directly by tests
+type mockRewardSource struct {
+	shares map[string]sdk.Dec
+}
+
+func newMockRewardSource() *mockRewardSource {
+	return &mockRewardSource{shares: make(map[string]sdk.Dec)}
+}
+
+func (s *mockRewardSource) GetTotalShares(ctx sdk.Context) sdk.Dec {
+	total := sdk.ZeroDec()
+	for _, shares := range s.shares {
+		total = total.Add(shares)
+	}
+	return total
+}
+
+func (s *mockRewardSource) GetOwnerShares(ctx sdk.Context, owner sdk.AccAddress) sdk.Dec {
+	shares, found := s.shares[owner.String()]
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return shares
+}
+
+func (suite *KeeperTestSuite) setSourceRewardPeriods(initialTime time.Time, periods ...types.MultiRewardPeriod) {
+	params := types.NewParams(
+		types.DefaultRewardPeriods,
+		types.DefaultMultiRewardPeriods,
+		types.DefaultMultiRewardPeriods,
+		types.DefaultRewardPeriods,
+		periods,
+		types.Multipliers{types.NewMultiplier(types.MultiplierName("small"), 1, d("0.25")), types.NewMultiplier(types.MultiplierName("large"), 12, d("1.0"))},
+		types.NewFixedClaimWindows(initialTime.Add(time.Hour*24*365*5)),
+	)
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestAccumulateSourceRewards() {
+	type args struct {
+		totalShares          sdk.Dec
+		rewardsPerSecond     sdk.Coins
+		initialTime          time.Time
+		timeElapsed          int
+		expectedRewardFactor types.RewardIndexes
+	}
+	type test struct {
+		name string
+		args args
+	}
+	testCases := []test{
+		{
+			"7 seconds",
+			args{
+				totalShares:          d("1000000"),
+				rewardsPerSecond:     cs(c("hard", 122354), c("ukava", 100)),
+				initialTime:          time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC),
+				timeElapsed:          7,
+				expectedRewardFactor: types.RewardIndexes{types.NewRewardIndex("hard", d("0.856478")), types.NewRewardIndex("ukava", d("0.0007"))},
+			},
+		},
+		{
+			"1 day",
+			args{
+				totalShares:          d("1000000"),
+				rewardsPerSecond:     cs(c("hard", 122354), c("ukava", 100)),
+				initialTime:          time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC),
+				timeElapsed:          86400,
+				expectedRewardFactor: types.RewardIndexes{types.NewRewardIndex("hard", d("10571.3856")), types.NewRewardIndex("ukava", d("8.64"))},
+			},
+		},
+		{
+			"no shares",
+			args{
+				totalShares:          sdk.ZeroDec(),
+				rewardsPerSecond:     cs(c("hard", 122354), c("ukava", 100)),
+				initialTime:          time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC),
+				timeElapsed:          7,
+				expectedRewardFactor: types.RewardIndexes{},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.ctx = suite.ctx.WithBlockTime(tc.args.initialTime)
+
+			source := newMockRewardSource()
+			source.shares[suite.addrs[0].String()] = tc.args.totalShares
+			suite.keeper.RegisterRewardSource("locked", source)
+
+			rewardPeriod := types.NewMultiRewardPeriod(true, "locked", tc.args.initialTime, tc.args.initialTime.Add(time.Hour*24*365*4), tc.args.rewardsPerSecond)
+			suite.setSourceRewardPeriods(tc.args.initialTime, rewardPeriod)
+			suite.keeper.SetPreviousSourceRewardAccrualTime(suite.ctx, "locked", tc.args.initialTime)
+
+			updatedBlockTime := tc.args.initialTime.Add(time.Duration(tc.args.timeElapsed) * time.Second)
+			suite.ctx = suite.ctx.WithBlockTime(updatedBlockTime)
+			err := suite.keeper.AccumulateSourceRewards(suite.ctx, rewardPeriod)
+			suite.Require().NoError(err)
+
+			rewardIndexes, _ := suite.keeper.GetSourceRewardIndexes(suite.ctx, "locked")
+			suite.Require().Equal(tc.args.expectedRewardFactor, rewardIndexes)
+			accrualTime, found := suite.keeper.GetPreviousSourceRewardAccrualTime(suite.ctx, "locked")
+			suite.Require().True(found)
+			suite.Require().Equal(updatedBlockTime, accrualTime)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAccumulateUnregisteredSourceRewards() {
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+
+	rewardPeriod := types.NewMultiRewardPeriod(true, "locked", initialTime, initialTime.Add(time.Hour*24*365*4), cs(c("hard", 122354)))
+	suite.setSourceRewardPeriods(initialTime, rewardPeriod)
+	suite.keeper.SetPreviousSourceRewardAccrualTime(suite.ctx, "locked", initialTime)
+
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(time.Hour))
+	err := suite.keeper.AccumulateSourceRewards(suite.ctx, rewardPeriod)
+	suite.Require().True(errors.Is(err, types.ErrRewardSourceNotFound))
+	suite.Require().True(errors.Is(suite.keeper.ValidateSourceRewardPeriods(suite.ctx), types.ErrRewardSourceNotFound))
+
+	_, found := suite.keeper.GetSourceRewardIndexes(suite.ctx, "locked")
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSynchronizeSourceReward() {
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+
+	source := newMockRewardSource()
+	suite.keeper.RegisterRewardSource("locked", source)
+	suite.Require().Panics(func() { suite.keeper.RegisterRewardSource("locked", newMockRewardSource()) })
+
+	rewardPeriod := types.NewMultiRewardPeriod(true, "locked", initialTime, initialTime.Add(time.Hour*24*365*4), cs(c("hard", 122354)))
+	suite.setSourceRewardPeriods(initialTime, rewardPeriod)
+	suite.Require().NoError(suite.keeper.AccumulateSourceRewards(suite.ctx, rewardPeriod))
+
+	// both owners lock shares, syncing through the hook first
+	hooks := suite.keeper.Hooks()
+	for _, addr := range suite.addrs[:2] {
+		hooks.BeforeSourceSharesModified(suite.ctx, "locked", addr)
+		source.shares[addr.String()] = d("1000000")
+	}
+	claim, found := suite.keeper.GetSourceClaim(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().True(claim.Reward.IsZero())
+
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(100 * time.Second))
+	suite.Require().NoError(suite.keeper.AccumulateSourceRewards(suite.ctx, rewardPeriod))
+	globalIndexes, found := suite.keeper.GetSourceRewardIndexes(suite.ctx, "locked")
+	suite.Require().True(found)
+	suite.Require().Equal(types.RewardIndexes{types.NewRewardIndex("hard", d("6.1177"))}, globalIndexes)
+
+	// the first owner's claim is synced before their shares change
+	hooks.BeforeSourceSharesModified(suite.ctx, "locked", suite.addrs[0])
+	source.shares[suite.addrs[0].String()] = d("3000000")
+	claim, _ = suite.keeper.GetSourceClaim(suite.ctx, suite.addrs[0])
+	suite.Require().Equal(cs(c("hard", 6117700)), claim.Reward)
+	suite.Require().Equal(types.MultiRewardIndexes{types.NewMultiRewardIndex("locked", globalIndexes)}, claim.RewardIndexes)
+
+	// the second owner's rewards are calculated without being stored
+	claim, _ = suite.keeper.GetSourceClaim(suite.ctx, suite.addrs[1])
+	simulatedClaim := suite.keeper.SimulateSourceSynchronization(suite.ctx, claim)
+	suite.Require().Equal(cs(c("hard", 6117700)), simulatedClaim.Reward)
+	claim, _ = suite.keeper.GetSourceClaim(suite.ctx, suite.addrs[1])
+	suite.Require().True(claim.Reward.IsZero())
+
+	// rewards after the change are split by the new shares
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(200 * time.Second))
+	suite.Require().NoError(suite.keeper.AccumulateSourceRewards(suite.ctx, rewardPeriod))
+	suite.keeper.SynchronizeSourceClaim(suite.ctx, suite.addrs[0])
+	suite.keeper.SynchronizeSourceClaim(suite.ctx, suite.addrs[1])
+	claim, _ = suite.keeper.GetSourceClaim(suite.ctx, suite.addrs[0])
+	suite.Require().Equal(cs(c("hard", 6117700+9176550)), claim.Reward)
+	claim, _ = suite.keeper.GetSourceClaim(suite.ctx, suite.addrs[1])
+	suite.Require().Equal(cs(c("hard", 6117700+3058850)), claim.Reward)
+}
+
+func (suite *KeeperTestSuite) TestDelegatorSourceSyncsClaim() {
+	suite.SetupWithGenState()
+	initialTime := time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)
+	suite.ctx = suite.ctx.WithBlockTime(initialTime)
+	blockDuration := 10 * time.Second
+
+	// the app registers delegators as a reward source under the bond denom
+	bondDenom := "ukava"
+	_, found := suite.keeper.GetRewardSource(bondDenom)
+	suite.Require().True(found)
+
+	rewardsPerSecond := c("swp", 122354)
+	rewardPeriod := types.NewMultiRewardPeriod(true, bondDenom, initialTime.Add(-1*oneYear), initialTime.Add(4*oneYear), cs(rewardsPerSecond))
+	suite.setSourceRewardPeriods(initialTime, rewardPeriod)
+	suite.keeper.SetPreviousSourceRewardAccrualTime(suite.ctx, bondDenom, initialTime)
+
+	// Create 2 validators
+	err := suite.deliverMsgCreateValidator(suite.ctx, suite.validatorAddrs[0], c(bondDenom, 10_000_000))
+	suite.Require().NoError(err)
+	err = suite.deliverMsgCreateValidator(suite.ctx, suite.validatorAddrs[1], c(bondDenom, 5_000_000))
+	suite.Require().NoError(err)
+
+	// Delegate from the test user. This will create their source claim.
+	err = suite.deliverMsgDelegate(suite.ctx, suite.addrs[0], suite.validatorAddrs[0], c(bondDenom, 1_000_000))
+	suite.Require().NoError(err)
+	claim, found := suite.keeper.GetSourceClaim(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	suite.Require().True(claim.Reward.IsZero())
+
+	// Start a new block to accumulate some source rewards globally.
+	_ = suite.app.EndBlocker(suite.ctx, abci.RequestEndBlock{})
+	suite.ctx = suite.ctx.WithBlockTime(initialTime.Add(1 * blockDuration))
+	_ = suite.app.BeginBlocker(suite.ctx, abci.RequestBeginBlock{}) // height and time in header are ignored by module begin blockers
+
+	// Redelegate the user's delegation between the two validators. This should trigger hooks that sync the user's claim.
+	err = suite.deliverMsgRedelegate(suite.ctx, suite.addrs[0], suite.validatorAddrs[0], suite.validatorAddrs[1], c(bondDenom, 1_000_000))
+	suite.Require().NoError(err)
+
+	claim, found = suite.keeper.GetSourceClaim(suite.ctx, suite.addrs[0])
+	suite.Require().True(found)
+	globalIndexes, found := suite.keeper.GetSourceRewardIndexes(suite.ctx, bondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.MultiRewardIndexes{types.NewMultiRewardIndex(bondDenom, globalIndexes)}, claim.RewardIndexes)
+	suite.Require().Equal(cs(c(rewardsPerSecond.Denom, 76471)), claim.Reward)
+
+	// delegators of a source without rewards don't get a claim
+	suite.setSourceRewardPeriods(initialTime)
+	err = suite.deliverMsgDelegate(suite.ctx, suite.addrs[1], suite.validatorAddrs[0], c(bondDenom, 1_000_000))
+	suite.Require().NoError(err)
+	_, found = suite.keeper.GetSourceClaim(suite.ctx, suite.addrs[1])
+	suite.Require().False(found)
+}
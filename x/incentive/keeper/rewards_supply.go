@@ -27,51 +27,14 @@ func (k Keeper) AccumulateHardSupplyRewards(ctx sdk.Context, rewardPeriod types.
 		return nil
 	}
 
-	totalSuppliedCoins, foundTotalSuppliedCoins := k.hardKeeper.GetSuppliedCoins(ctx)
-	if !foundTotalSuppliedCoins {
+	totalShares := k.hardSupplySource(rewardPeriod.CollateralType).GetTotalShares(ctx)
+	if !totalShares.IsPositive() {
 		k.SetPreviousHardSupplyRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
 
-	totalSupplied := totalSuppliedCoins.AmountOf(rewardPeriod.CollateralType).ToDec()
-	if totalSupplied.IsZero() {
-		k.SetPreviousHardSupplyRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
-		return nil
-	}
-
-	previousRewardIndexes, found := k.GetHardSupplyRewardIndexes(ctx, rewardPeriod.CollateralType)
-	if !found {
-		for _, rewardCoin := range rewardPeriod.RewardsPerSecond {
-			rewardIndex := types.NewRewardIndex(rewardCoin.Denom, sdk.ZeroDec())
-			previousRewardIndexes = append(previousRewardIndexes, rewardIndex)
-		}
-		k.SetHardSupplyRewardIndexes(ctx, rewardPeriod.CollateralType, previousRewardIndexes)
-	}
-	hardFactor, found := k.hardKeeper.GetSupplyInterestFactor(ctx, rewardPeriod.CollateralType)
-	if !found {
-		k.SetPreviousHardSupplyRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
-		return nil
-	}
-
-	newRewardIndexes := previousRewardIndexes
-	for _, rewardCoin := range rewardPeriod.RewardsPerSecond {
-		newRewards := rewardCoin.Amount.ToDec().Mul(timeElapsed.ToDec())
-		previousRewardIndex, found := previousRewardIndexes.GetRewardIndex(rewardCoin.Denom)
-		if !found {
-			previousRewardIndex = types.NewRewardIndex(rewardCoin.Denom, sdk.ZeroDec())
-		}
-
-		// Calculate new reward factor and update reward index
-		rewardFactor := newRewards.Mul(hardFactor).Quo(totalSupplied)
-		newRewardFactorValue := previousRewardIndex.RewardFactor.Add(rewardFactor)
-		newRewardIndex := types.NewRewardIndex(rewardCoin.Denom, newRewardFactorValue)
-		i, found := newRewardIndexes.GetFactorIndex(rewardCoin.Denom)
-		if found {
-			newRewardIndexes[i] = newRewardIndex
-		} else {
-			newRewardIndexes = append(newRewardIndexes, newRewardIndex)
-		}
-	}
+	previousRewardIndexes, _ := k.GetHardSupplyRewardIndexes(ctx, rewardPeriod.CollateralType)
+	newRewardIndexes := accumulateRewardIndexes(previousRewardIndexes, rewardPeriod.RewardsPerSecond, timeElapsed, totalShares)
 	k.SetHardSupplyRewardIndexes(ctx, rewardPeriod.CollateralType, newRewardIndexes)
 	k.SetPreviousHardSupplyRewardAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 	return nil
@@ -125,6 +88,7 @@ func (k Keeper) SynchronizeHardSupplyReward(ctx sdk.Context, deposit hardtypes.D
 		if !foundUserRewardIndexIndex {
 			continue
 		}
+		shares := k.hardSupplySource(coin.Denom).GetOwnerShares(ctx, deposit.Depositor)
 
 		for _, globalRewardIndex := range globalRewardIndexes {
 			userRewardIndex, foundUserRewardIndex := userMultiRewardIndex.RewardIndexes.GetRewardIndex(globalRewardIndex.CollateralType)
@@ -144,7 +108,7 @@ func (k Keeper) SynchronizeHardSupplyReward(ctx sdk.Context, deposit hardtypes.D
 				panic(fmt.Sprintf("reward accumulation factor cannot be negative: %s", rewardsAccumulatedFactor))
 			}
 
-			newRewardsAmount := rewardsAccumulatedFactor.Mul(deposit.Amount.AmountOf(coin.Denom).ToDec()).RoundInt()
+			newRewardsAmount := rewardsAccumulatedFactor.Mul(shares).RoundInt()
 
 			factorIndex, foundFactorIndex := userMultiRewardIndex.RewardIndexes.GetFactorIndex(globalRewardIndex.CollateralType)
 			if !foundFactorIndex { // should never trigger, as we basically do this check at the start of this loop
@@ -255,11 +219,8 @@ func (k Keeper) SimulateHardSynchronization(ctx sdk.Context, claim types.HardLiq
 			if rewardsAccumulatedFactor.IsZero() {
 				continue
 			}
-			deposit, found := k.hardKeeper.GetDeposit(ctx, claim.GetOwner())
-			if !found {
-				continue
-			}
-			newRewardsAmount := rewardsAccumulatedFactor.Mul(deposit.Amount.AmountOf(ri.CollateralType).ToDec()).RoundInt()
+			shares := k.hardSupplySource(ri.CollateralType).GetOwnerShares(ctx, claim.GetOwner())
+			newRewardsAmount := rewardsAccumulatedFactor.Mul(shares).RoundInt()
 			if newRewardsAmount.IsZero() || newRewardsAmount.IsNegative() {
 				continue
 			}
@@ -305,11 +266,8 @@ func (k Keeper) SimulateHardSynchronization(ctx sdk.Context, claim types.HardLiq
 			if rewardsAccumulatedFactor.IsZero() {
 				continue
 			}
-			borrow, found := k.hardKeeper.GetBorrow(ctx, claim.GetOwner())
-			if !found {
-				continue
-			}
-			newRewardsAmount := rewardsAccumulatedFactor.Mul(borrow.Amount.AmountOf(ri.CollateralType).ToDec()).RoundInt()
+			shares := k.hardBorrowSource(ri.CollateralType).GetOwnerShares(ctx, claim.GetOwner())
+			newRewardsAmount := rewardsAccumulatedFactor.Mul(shares).RoundInt()
 			if newRewardsAmount.IsZero() || newRewardsAmount.IsNegative() {
 				continue
 			}
@@ -342,31 +300,7 @@ func (k Keeper) SimulateHardSynchronization(ctx sdk.Context, claim types.HardLiq
 	}
 	claim.DelegatorRewardIndexes[delegatorIndex].RewardFactor = delagatorFactor
 
-	totalDelegated := sdk.ZeroDec()
-
-	delegations := k.stakingKeeper.GetDelegatorDelegations(ctx, claim.GetOwner(), 200)
-	for _, delegation := range delegations {
-		validator, found := k.stakingKeeper.GetValidator(ctx, delegation.GetValidatorAddr())
-		if !found {
-			continue
-		}
-
-		// Delegators don't accumulate rewards if their validator is unbonded/slashed
-		if validator.GetStatus() != sdk.Bonded {
-			continue
-		}
-
-		if validator.GetTokens().IsZero() {
-			continue
-		}
-
-		delegatedTokens := validator.TokensFromShares(delegation.GetShares())
-		if delegatedTokens.IsZero() || delegatedTokens.IsNegative() {
-			continue
-		}
-		totalDelegated = totalDelegated.Add(delegatedTokens)
-	}
-
+	totalDelegated := NewDelegatorRewardSource(k.stakingKeeper).GetOwnerShares(ctx, claim.GetOwner())
 	rewardsEarned := rewardsAccumulatedFactor.Mul(totalDelegated).RoundInt()
 	if rewardsEarned.IsZero() || rewardsEarned.IsNegative() {
 		return claim
@@ -425,3 +359,38 @@ func getDenoms(coins sdk.Coins) []string {
 	}
 	return denoms
 }
+
+// hardSupplySource is the reward source for a denom supplied in hard.
+// Total shares are normalized by the denom's supply interest factor, so the rewards earned by a deposit grow with its interest.
+type hardSupplySource struct {
+	hardKeeper types.HardKeeper
+	denom      string
+}
+
+var _ types.RewardSource = hardSupplySource{}
+
+func (k Keeper) hardSupplySource(denom string) hardSupplySource {
+	return hardSupplySource{hardKeeper: k.hardKeeper, denom: denom}
+}
+
+// GetTotalShares returns the normalized amount of the denom supplied
+func (s hardSupplySource) GetTotalShares(ctx sdk.Context) sdk.Dec {
+	total, found := s.hardKeeper.GetSuppliedCoins(ctx)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	interestFactor, found := s.hardKeeper.GetSupplyInterestFactor(ctx, s.denom)
+	if !found || !interestFactor.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return total.AmountOf(s.denom).ToDec().Quo(interestFactor)
+}
+
+// GetOwnerShares returns the amount of the denom in the owner's deposit
+func (s hardSupplySource) GetOwnerShares(ctx sdk.Context, owner sdk.AccAddress) sdk.Dec {
+	deposit, found := s.hardKeeper.GetDeposit(ctx, owner)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return deposit.Amount.AmountOf(s.denom).ToDec()
+}
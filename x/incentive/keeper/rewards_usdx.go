@@ -22,18 +22,13 @@ func (k Keeper) AccumulateUSDXMintingRewards(ctx sdk.Context, rewardPeriod types
 		k.SetPreviousUSDXMintingAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
-	totalPrincipal := k.cdpKeeper.GetTotalPrincipal(ctx, rewardPeriod.CollateralType, types.PrincipalDenom).ToDec()
-	if totalPrincipal.IsZero() {
+	totalShares := k.usdxMintingSource(rewardPeriod.CollateralType).GetTotalShares(ctx)
+	if !totalShares.IsPositive() {
 		k.SetPreviousUSDXMintingAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
 		return nil
 	}
 	newRewards := timeElapsed.Mul(rewardPeriod.RewardsPerSecond.Amount)
-	cdpFactor, found := k.cdpKeeper.GetInterestFactor(ctx, rewardPeriod.CollateralType)
-	if !found {
-		k.SetPreviousUSDXMintingAccrualTime(ctx, rewardPeriod.CollateralType, ctx.BlockTime())
-		return nil
-	}
-	rewardFactor := newRewards.ToDec().Mul(cdpFactor).Quo(totalPrincipal)
+	rewardFactor := newRewards.ToDec().Quo(totalShares)
 
 	previousRewardFactor, found := k.GetUSDXMintingRewardFactor(ctx, rewardPeriod.CollateralType)
 	if !found {
@@ -108,7 +103,7 @@ func (k Keeper) SynchronizeUSDXMintingReward(ctx sdk.Context, cdp cdptypes.CDP)
 		return
 	}
 	claim.RewardIndexes[index].RewardFactor = globalRewardFactor
-	newRewardsAmount := rewardsAccumulatedFactor.Mul(cdp.GetTotalPrincipal().Amount.ToDec()).RoundInt()
+	newRewardsAmount := rewardsAccumulatedFactor.Mul(k.usdxMintingSource(cdp.Type).GetOwnerShares(ctx, cdp.Owner)).RoundInt()
 	if newRewardsAmount.IsZero() {
 		k.SetUSDXMintingClaim(ctx, claim)
 		return
@@ -144,11 +139,7 @@ func (k Keeper) SimulateUSDXMintingSynchronization(ctx sdk.Context, claim types.
 
 		claim.RewardIndexes[index].RewardFactor = globalRewardFactor
 
-		cdp, found := k.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, claim.GetOwner(), ri.CollateralType)
-		if !found {
-			continue
-		}
-		newRewardsAmount := rewardsAccumulatedFactor.Mul(cdp.GetTotalPrincipal().Amount.ToDec()).RoundInt()
+		newRewardsAmount := rewardsAccumulatedFactor.Mul(k.usdxMintingSource(ri.CollateralType).GetOwnerShares(ctx, claim.GetOwner())).RoundInt()
 		if newRewardsAmount.IsZero() {
 			continue
 		}
@@ -186,3 +177,34 @@ func (k Keeper) ZeroUSDXMintingClaim(ctx sdk.Context, claim types.USDXMintingCla
 	k.SetUSDXMintingClaim(ctx, claim)
 	return claim
 }
+
+// usdxMintingSource is the reward source for the usdx minted against a collateral type.
+// Total shares are normalized by the collateral type's interest factor, so the rewards earned by a cdp grow with its fees.
+type usdxMintingSource struct {
+	cdpKeeper      types.CdpKeeper
+	collateralType string
+}
+
+var _ types.RewardSource = usdxMintingSource{}
+
+func (k Keeper) usdxMintingSource(collateralType string) usdxMintingSource {
+	return usdxMintingSource{cdpKeeper: k.cdpKeeper, collateralType: collateralType}
+}
+
+// GetTotalShares returns the normalized usdx debt of the collateral type
+func (s usdxMintingSource) GetTotalShares(ctx sdk.Context) sdk.Dec {
+	interestFactor, found := s.cdpKeeper.GetInterestFactor(ctx, s.collateralType)
+	if !found || !interestFactor.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return s.cdpKeeper.GetTotalPrincipal(ctx, s.collateralType, types.PrincipalDenom).ToDec().Quo(interestFactor)
+}
+
+// GetOwnerShares returns the usdx debt of the owner's cdp for the collateral type
+func (s usdxMintingSource) GetOwnerShares(ctx sdk.Context, owner sdk.AccAddress) sdk.Dec {
+	cdp, found := s.cdpKeeper.GetCdpByOwnerAndCollateralType(ctx, owner, s.collateralType)
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return cdp.GetTotalPrincipal().Amount.ToDec()
+}
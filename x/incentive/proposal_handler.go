@@ -0,0 +1,35 @@
+package incentive
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// NewParamChangeProposalHandler wraps a param change proposal handler to start the expiry periods of claim windows
+// that are enabled or shortened, so they don't apply retroactively to accrual periods that started before the change,
+// and to reject incentive param changes that add source reward periods for reward sources that have not been registered.
+// Proposals are checked through the handler before they are enacted, and rejected changes are discarded with the cached context they ran in.
+func NewParamChangeProposalHandler(k Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		p, ok := content.(paramstypes.ParameterChangeProposal)
+		if !ok || !changesIncentiveParams(p) {
+			return handler(ctx, content)
+		}
+		previousWindows := k.GetParams(ctx).ClaimWindows
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+		k.UpdateClaimExpiryStarts(ctx, previousWindows)
+		return k.ValidateSourceRewardPeriods(ctx)
+	}
+}
+
+func changesIncentiveParams(p paramstypes.ParameterChangeProposal) bool {
+	for _, change := range p.Changes {
+		if change.Subspace == DefaultParamspace {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,79 @@
+package incentive_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/incentive"
+)
+
+func TestParamChangeProposalHandler_RejectsUnregisteredSources(t *testing.T) {
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)})
+	keeper := tApp.GetIncentiveKeeper()
+	handler := tApp.GetGovKeeper().Router().GetRoute(params.RouterKey)
+
+	newProposal := func(sourceID string) paramstypes.ParameterChangeProposal {
+		periods := incentive.MultiRewardPeriods{
+			incentive.NewMultiRewardPeriod(true, sourceID, ctx.BlockTime(), ctx.BlockTime().Add(time.Hour), sdk.NewCoins(sdk.NewInt64Coin("hard", 1000))),
+		}
+		return paramstypes.NewParameterChangeProposal("A Title", "A description of this proposal.", []paramstypes.ParamChange{
+			paramstypes.NewParamChange(incentive.ModuleName, string(incentive.KeySourceRewardPeriods), string(incentive.ModuleCdc.MustMarshalJSON(periods))),
+		})
+	}
+
+	// gov enacts proposals in a cached context, discarding the changes of rejected proposals
+	cacheCtx, _ := ctx.CacheContext()
+	err := handler(cacheCtx, newProposal("locked"))
+	require.True(t, errors.Is(err, incentive.ErrRewardSourceNotFound))
+
+	// delegators are registered as a reward source by the app
+	require.NoError(t, handler(ctx, newProposal(incentive.BondDenom)))
+	_, found := keeper.GetSourceRewardPeriods(ctx, incentive.BondDenom)
+	require.True(t, found)
+}
+
+func TestParamChangeProposalHandler_StartsClaimExpiryPeriods(t *testing.T) {
+	tApp := app.NewTestApp()
+	tApp.InitializeFromGenesisStates()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Date(2020, 12, 15, 14, 0, 0, 0, time.UTC)})
+	keeper := tApp.GetIncentiveKeeper()
+	handler := tApp.GetGovKeeper().Router().GetRoute(params.RouterKey)
+
+	newProposal := func(expiryPeriod time.Duration) paramstypes.ParameterChangeProposal {
+		windows := incentive.ClaimWindows{
+			incentive.NewClaimWindow(incentive.SourceClaimType, ctx.BlockTime().Add(time.Hour*24*365), expiryPeriod, false),
+		}
+		return paramstypes.NewParameterChangeProposal("A Title", "A description of this proposal.", []paramstypes.ParamChange{
+			paramstypes.NewParamChange(incentive.ModuleName, string(incentive.KeyClaimWindows), string(incentive.ModuleCdc.MustMarshalJSON(windows))),
+		})
+	}
+
+	// enabling an expiry period starts it
+	require.NoError(t, handler(ctx, newProposal(time.Hour*24)))
+	start, found := keeper.GetClaimExpiryStart(ctx, incentive.SourceClaimType)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime(), start)
+
+	// lengthening it keeps the start
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, handler(ctx, newProposal(time.Hour*48)))
+	start, _ = keeper.GetClaimExpiryStart(ctx, incentive.SourceClaimType)
+	require.Equal(t, ctx.BlockTime().Add(-time.Hour), start)
+
+	// shortening it starts it again
+	require.NoError(t, handler(ctx, newProposal(time.Hour)))
+	start, _ = keeper.GetClaimExpiryStart(ctx, incentive.SourceClaimType)
+	require.Equal(t, ctx.BlockTime(), start)
+}
@@ -12,9 +12,19 @@ import (
 const (
 	USDXMintingClaimType           = "usdx_minting"
 	HardLiquidityProviderClaimType = "hard_liquidity_provider"
+	SourceClaimType                = "source"
 	BondDenom                      = "ukava"
 )
 
+// ValidateClaimType returns an error if the input is not one of the claim types paid out by the incentive module
+func ValidateClaimType(claimType string) error {
+	switch claimType {
+	case USDXMintingClaimType, HardLiquidityProviderClaimType, SourceClaimType:
+		return nil
+	}
+	return fmt.Errorf("invalid claim type: %s", claimType)
+}
+
 // Claim is an interface for handling common claim actions
 type Claim interface {
 	GetOwner() sdk.AccAddress
@@ -264,6 +274,68 @@ func (cs HardLiquidityProviderClaims) Validate() error {
 	return nil
 }
 
+// SourceClaim stores the rewards earned by owner from registered reward sources
+type SourceClaim struct {
+	BaseMultiClaim `json:"base_claim" yaml:"base_claim"`
+	RewardIndexes  MultiRewardIndexes `json:"reward_indexes" yaml:"reward_indexes"` // reward indexes by source id
+}
+
+// NewSourceClaim returns a new SourceClaim
+func NewSourceClaim(owner sdk.AccAddress, rewards sdk.Coins, rewardIndexes MultiRewardIndexes) SourceClaim {
+	return SourceClaim{
+		BaseMultiClaim: BaseMultiClaim{
+			Owner:  owner,
+			Reward: rewards,
+		},
+		RewardIndexes: rewardIndexes,
+	}
+}
+
+// GetType returns the claim's type
+func (c SourceClaim) GetType() string { return SourceClaimType }
+
+// GetReward returns the claim's reward coins
+func (c SourceClaim) GetReward() sdk.Coins { return c.Reward }
+
+// GetOwner returns the claim's owner
+func (c SourceClaim) GetOwner() sdk.AccAddress { return c.Owner }
+
+// Validate performs a basic check of a SourceClaim fields
+func (c SourceClaim) Validate() error {
+	if err := c.RewardIndexes.Validate(); err != nil {
+		return err
+	}
+
+	return c.BaseMultiClaim.Validate()
+}
+
+// String implements fmt.Stringer
+func (c SourceClaim) String() string {
+	return fmt.Sprintf(`%s
+	Reward Indexes: %s,
+	`, c.BaseMultiClaim, c.RewardIndexes)
+}
+
+// SourceClaims slice of SourceClaim
+type SourceClaims []SourceClaim
+
+// Validate checks if all the claims are valid and there are no duplicated
+// entries.
+func (cs SourceClaims) Validate() error {
+	seenOwners := make(map[string]bool)
+	for _, c := range cs {
+		if seenOwners[c.Owner.String()] {
+			return fmt.Errorf("duplicated source claim for owner %s", c.Owner)
+		}
+		if err := c.Validate(); err != nil {
+			return err
+		}
+		seenOwners[c.Owner.String()] = true
+	}
+
+	return nil
+}
+
 // ---------------------- Reward periods are used by the params ----------------------
 
 // MultiRewardPeriod supports multiple reward types
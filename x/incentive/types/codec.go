@@ -17,8 +17,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*Claim)(nil), nil)
 	cdc.RegisterConcrete(USDXMintingClaim{}, "incentive/USDXMintingClaim", nil)
 	cdc.RegisterConcrete(HardLiquidityProviderClaim{}, "incentive/HardLiquidityProviderClaim", nil)
+	cdc.RegisterConcrete(SourceClaim{}, "incentive/SourceClaim", nil)
 
 	// Register msgs
 	cdc.RegisterConcrete(MsgClaimUSDXMintingReward{}, "incentive/MsgClaimUSDXMintingReward", nil)
 	cdc.RegisterConcrete(MsgClaimHardReward{}, "incentive/MsgClaimHardReward", nil)
+	cdc.RegisterConcrete(MsgClaimSourceReward{}, "incentive/MsgClaimSourceReward", nil)
 }
@@ -19,4 +19,6 @@ var (
 	ErrClaimExpired                  = sdkerrors.Register(ModuleName, 10, "claim has expired")
 	ErrInvalidClaimType              = sdkerrors.Register(ModuleName, 11, "invalid claim type")
 	ErrInvalidClaimOwner             = sdkerrors.Register(ModuleName, 12, "invalid claim owner")
+	ErrRewardSourceNotFound          = sdkerrors.Register(ModuleName, 13, "reward source not found")
+	ErrClaimWindowNotFound           = sdkerrors.Register(ModuleName, 14, "no claim window found for claim type")
 )
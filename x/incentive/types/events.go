@@ -6,6 +6,7 @@ const (
 	EventTypeRewardPeriod      = "new_reward_period"
 	EventTypeClaimPeriod       = "new_claim_period"
 	EventTypeClaimPeriodExpiry = "claim_period_expiry"
+	EventTypeRewardExpiry      = "reward_expiry"
 
 	AttributeValueCategory   = ModuleName
 	AttributeKeyClaimedBy    = "claimed_by"
@@ -13,4 +14,6 @@ const (
 	AttributeKeyClaimType    = "claim_type"
 	AttributeKeyRewardPeriod = "reward_period"
 	AttributeKeyClaimPeriod  = "claim_period"
+	AttributeKeyClaimOwner   = "claim_owner"
+	AttributeKeyExpiredTo    = "expired_to"
 )
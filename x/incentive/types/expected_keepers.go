@@ -42,6 +42,11 @@ type HardKeeper interface {
 	GetSuppliedCoins(ctx sdk.Context) (coins sdk.Coins, found bool)
 }
 
+// DistrKeeper defines the expected distribution keeper for returning expired rewards to the community pool
+type DistrKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // AccountKeeper defines the expected keeper interface for interacting with account
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authexported.Account
@@ -63,3 +68,15 @@ type HARDHooks interface {
 	BeforeBorrowModified(ctx sdk.Context, borrow hardtypes.Borrow)
 	AfterBorrowModified(ctx sdk.Context, deposit hardtypes.Deposit)
 }
+
+// RewardSource is a long-lived position that earns incentive rewards, such as locked or bridged tokens.
+// Rewards for a source are split between owners in proportion to their shares of the source's total.
+type RewardSource interface {
+	GetTotalShares(ctx sdk.Context) sdk.Dec
+	GetOwnerShares(ctx sdk.Context, owner sdk.AccAddress) sdk.Dec
+}
+
+// RewardSourceHooks event hooks for reward sources to synchronize claims before an owner's shares change
+type RewardSourceHooks interface {
+	BeforeSourceSharesModified(ctx sdk.Context, sourceID string, owner sdk.AccAddress)
+}
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState is the state that must be provided at genesis.
@@ -15,10 +17,15 @@ type GenesisState struct {
 	HardDelegatorAccumulationTimes GenesisAccumulationTimes    `json:"hard_delegator_accumulation_times" yaml:"hard_delegator_accumulation_times"`
 	USDXMintingClaims              USDXMintingClaims           `json:"usdx_minting_claims" yaml:"usdx_minting_claims"`
 	HardLiquidityProviderClaims    HardLiquidityProviderClaims `json:"hard_liquidity_provider_claims" yaml:"hard_liquidity_provider_claims"`
+	SourceAccumulationTimes        GenesisAccumulationTimes    `json:"source_accumulation_times" yaml:"source_accumulation_times"`
+	SourceClaims                   SourceClaims                `json:"source_claims" yaml:"source_claims"`
+	ClaimAccrualTimes              GenesisClaimAccrualTimes    `json:"claim_accrual_times" yaml:"claim_accrual_times"`
+	ClaimExpiryStarts              GenesisClaimExpiryStarts    `json:"claim_expiry_starts" yaml:"claim_expiry_starts"`
 }
 
 // NewGenesisState returns a new genesis state
-func NewGenesisState(params Params, usdxAccumTimes, hardSupplyAccumTimes, hardBorrowAccumTimes, hardDelegatorAccumTimes GenesisAccumulationTimes, c USDXMintingClaims, hc HardLiquidityProviderClaims) GenesisState {
+func NewGenesisState(params Params, usdxAccumTimes, hardSupplyAccumTimes, hardBorrowAccumTimes, hardDelegatorAccumTimes GenesisAccumulationTimes, c USDXMintingClaims, hc HardLiquidityProviderClaims,
+	sourceAccumTimes GenesisAccumulationTimes, sc SourceClaims, claimAccrualTimes GenesisClaimAccrualTimes, claimExpiryStarts GenesisClaimExpiryStarts) GenesisState {
 	return GenesisState{
 		Params:                         params,
 		USDXAccumulationTimes:          usdxAccumTimes,
@@ -27,6 +34,10 @@ func NewGenesisState(params Params, usdxAccumTimes, hardSupplyAccumTimes, hardBo
 		HardDelegatorAccumulationTimes: hardDelegatorAccumTimes,
 		USDXMintingClaims:              c,
 		HardLiquidityProviderClaims:    hc,
+		SourceAccumulationTimes:        sourceAccumTimes,
+		SourceClaims:                   sc,
+		ClaimAccrualTimes:              claimAccrualTimes,
+		ClaimExpiryStarts:              claimExpiryStarts,
 	}
 }
 
@@ -40,6 +51,10 @@ func DefaultGenesisState() GenesisState {
 		HardDelegatorAccumulationTimes: GenesisAccumulationTimes{},
 		USDXMintingClaims:              DefaultUSDXClaims,
 		HardLiquidityProviderClaims:    DefaultHardClaims,
+		SourceAccumulationTimes:        GenesisAccumulationTimes{},
+		SourceClaims:                   DefaultSourceClaims,
+		ClaimAccrualTimes:              DefaultGenesisClaimAccrualTimes,
+		ClaimExpiryStarts:              DefaultGenesisClaimExpiryStarts,
 	}
 }
 
@@ -61,6 +76,18 @@ func (gs GenesisState) Validate() error {
 	if err := gs.HardDelegatorAccumulationTimes.Validate(); err != nil {
 		return err
 	}
+	if err := gs.SourceAccumulationTimes.Validate(); err != nil {
+		return err
+	}
+	if err := gs.SourceClaims.Validate(); err != nil {
+		return err
+	}
+	if err := gs.ClaimAccrualTimes.Validate(); err != nil {
+		return err
+	}
+	if err := gs.ClaimExpiryStarts.Validate(); err != nil {
+		return err
+	}
 
 	if err := gs.HardLiquidityProviderClaims.Validate(); err != nil {
 		return err
@@ -114,3 +141,95 @@ func (gat GenesisAccumulationTime) Validate() error {
 	}
 	return nil
 }
+
+// GenesisClaimAccrualTime stores the start of a claim's current accrual period
+type GenesisClaimAccrualTime struct {
+	ClaimType    string         `json:"claim_type" yaml:"claim_type"`
+	Owner        sdk.AccAddress `json:"owner" yaml:"owner"`
+	AccrualStart time.Time      `json:"accrual_start" yaml:"accrual_start"`
+}
+
+// NewGenesisClaimAccrualTime returns a new GenesisClaimAccrualTime
+func NewGenesisClaimAccrualTime(claimType string, owner sdk.AccAddress, accrualStart time.Time) GenesisClaimAccrualTime {
+	return GenesisClaimAccrualTime{
+		ClaimType:    claimType,
+		Owner:        owner,
+		AccrualStart: accrualStart,
+	}
+}
+
+// Validate performs validation of GenesisClaimAccrualTime
+func (gcat GenesisClaimAccrualTime) Validate() error {
+	if err := ValidateClaimType(gcat.ClaimType); err != nil {
+		return err
+	}
+	if gcat.Owner.Empty() {
+		return fmt.Errorf("genesis claim accrual time's owner cannot be empty")
+	}
+	if gcat.AccrualStart.IsZero() {
+		return fmt.Errorf("genesis claim accrual time for %s cannot be zero", gcat.Owner)
+	}
+	return nil
+}
+
+// GenesisClaimAccrualTimes slice of GenesisClaimAccrualTime
+type GenesisClaimAccrualTimes []GenesisClaimAccrualTime
+
+// Validate performs validation of GenesisClaimAccrualTimes
+func (gcats GenesisClaimAccrualTimes) Validate() error {
+	seen := make(map[string]bool)
+	for _, gcat := range gcats {
+		if err := gcat.Validate(); err != nil {
+			return err
+		}
+		key := gcat.ClaimType + gcat.Owner.String()
+		if seen[key] {
+			return fmt.Errorf("duplicated %s claim accrual time for owner %s", gcat.ClaimType, gcat.Owner)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// GenesisClaimExpiryStart stores when the expiry period of a claim type's window took effect
+type GenesisClaimExpiryStart struct {
+	ClaimType   string    `json:"claim_type" yaml:"claim_type"`
+	ExpiryStart time.Time `json:"expiry_start" yaml:"expiry_start"`
+}
+
+// NewGenesisClaimExpiryStart returns a new GenesisClaimExpiryStart
+func NewGenesisClaimExpiryStart(claimType string, expiryStart time.Time) GenesisClaimExpiryStart {
+	return GenesisClaimExpiryStart{
+		ClaimType:   claimType,
+		ExpiryStart: expiryStart,
+	}
+}
+
+// Validate performs validation of GenesisClaimExpiryStart
+func (gces GenesisClaimExpiryStart) Validate() error {
+	if err := ValidateClaimType(gces.ClaimType); err != nil {
+		return err
+	}
+	if gces.ExpiryStart.IsZero() {
+		return fmt.Errorf("genesis claim expiry start for %s cannot be zero", gces.ClaimType)
+	}
+	return nil
+}
+
+// GenesisClaimExpiryStarts slice of GenesisClaimExpiryStart
+type GenesisClaimExpiryStarts []GenesisClaimExpiryStart
+
+// Validate performs validation of GenesisClaimExpiryStarts
+func (gcess GenesisClaimExpiryStarts) Validate() error {
+	seen := make(map[string]bool)
+	for _, gces := range gcess {
+		if err := gces.Validate(); err != nil {
+			return err
+		}
+		if seen[gces.ClaimType] {
+			return fmt.Errorf("duplicated claim expiry start for %s", gces.ClaimType)
+		}
+		seen[gces.ClaimType] = true
+	}
+	return nil
+}
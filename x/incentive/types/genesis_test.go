@@ -55,10 +55,11 @@ func TestGenesisStateValidate(t *testing.T) {
 					DefaultMultiRewardPeriods,
 					DefaultMultiRewardPeriods,
 					DefaultRewardPeriods,
+					DefaultMultiRewardPeriods,
 					Multipliers{
 						NewMultiplier(Small, 1, sdk.MustNewDecFromStr("0.33")),
 					},
-					time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
+					NewFixedClaimWindows(time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC)),
 				),
 				genAccTimes: GenesisAccumulationTimes{GenesisAccumulationTime{
 					CollateralType:           "bnb-a",
@@ -130,7 +131,7 @@ func TestGenesisStateValidate(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			gs := NewGenesisState(tc.args.params, tc.args.genAccTimes, tc.args.genAccTimes, tc.args.genAccTimes, tc.args.genAccTimes, tc.args.claims, DefaultHardClaims)
+			gs := NewGenesisState(tc.args.params, tc.args.genAccTimes, tc.args.genAccTimes, tc.args.genAccTimes, tc.args.genAccTimes, tc.args.claims, DefaultHardClaims, tc.args.genAccTimes, DefaultSourceClaims, DefaultGenesisClaimAccrualTimes, DefaultGenesisClaimExpiryStarts)
 			err := gs.Validate()
 			if tc.errArgs.expectPass {
 				require.NoError(t, err, tc.name)
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "incentive"
@@ -33,7 +39,28 @@ var (
 	PreviousHardBorrowRewardAccrualTimeKeyPrefix    = []byte{0x08} // prefix for key that stores the previous time Hard borrow rewards accrued
 	HardDelegatorRewardFactorKeyPrefix              = []byte{0x09} // prefix for key that stores Hard delegator reward factors
 	PreviousHardDelegatorRewardAccrualTimeKeyPrefix = []byte{0x10} // prefix for key that stores the previous time Hard delegator rewards accrued
+	SourceClaimKeyPrefix                            = []byte{0x11} // prefix for keys that store reward source claims
+	SourceRewardIndexesKeyPrefix                    = []byte{0x12} // prefix for key that stores reward source reward factors
+	PreviousSourceRewardAccrualTimeKeyPrefix        = []byte{0x13} // prefix for key that stores the previous time reward source rewards accrued
+	ClaimAccrualStartKeyPrefix                      = []byte{0x14} // prefix for keys that store the start of each claim's current accrual period
+	ClaimAccrualQueueKeyPrefix                      = []byte{0x15} // prefix for keys that index claim accrual periods by start time
+	ClaimExpiryStartKeyPrefix                       = []byte{0x16} // prefix for keys that store when each claim type's expiry period took effect
 
 	USDXMintingRewardDenom   = "ukava"
 	HardLiquidityRewardDenom = "hard"
 )
+
+// GetClaimTypePrefix returns the key prefix used to separate claim accrual periods by claim type
+func GetClaimTypePrefix(claimType string) []byte {
+	return append([]byte(claimType), 0x00)
+}
+
+// GetClaimAccrualStartKey returns the key for the start of a claim's accrual period: claimType | 0x00 | owner
+func GetClaimAccrualStartKey(claimType string, owner sdk.AccAddress) []byte {
+	return append(GetClaimTypePrefix(claimType), owner...)
+}
+
+// GetClaimAccrualQueueKey returns the key for a claim in the accrual queue: claimType | 0x00 | start | owner
+func GetClaimAccrualQueueKey(claimType string, start time.Time, owner sdk.AccAddress) []byte {
+	return append(append(GetClaimTypePrefix(claimType), sdk.FormatTimeBytes(start)...), owner...)
+}
@@ -9,8 +9,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 
-	tmtime "github.com/tendermint/tendermint/types/time"
-
 	cdptypes "github.com/kava-labs/kava/x/cdp/types"
 	kavadistTypes "github.com/kava-labs/kava/x/kavadist/types"
 )
@@ -28,7 +26,8 @@ var (
 	KeyHardSupplyRewardPeriods      = []byte("HardSupplyRewardPeriods")
 	KeyHardBorrowRewardPeriods      = []byte("HardBorrowRewardPeriods")
 	KeyHardDelegatorRewardPeriods   = []byte("HardDelegatorRewardPeriods")
-	KeyClaimEnd                     = []byte("ClaimEnd")
+	KeySourceRewardPeriods          = []byte("SourceRewardPeriods")
+	KeyClaimWindows                 = []byte("ClaimWindows")
 	KeyMultipliers                  = []byte("ClaimMultipliers")
 	DefaultActive                   = false
 	DefaultRewardPeriods            = RewardPeriods{}
@@ -36,8 +35,11 @@ var (
 	DefaultMultipliers              = Multipliers{}
 	DefaultUSDXClaims               = USDXMintingClaims{}
 	DefaultHardClaims               = HardLiquidityProviderClaims{}
+	DefaultSourceClaims             = SourceClaims{}
+	DefaultGenesisClaimAccrualTimes = GenesisClaimAccrualTimes{}
+	DefaultGenesisClaimExpiryStarts = GenesisClaimExpiryStarts{}
 	DefaultGenesisAccumulationTimes = GenesisAccumulationTimes{}
-	DefaultClaimEnd                 = tmtime.Canonical(time.Unix(1, 0))
+	DefaultClaimWindows             = ClaimWindows{}
 	GovDenom                        = cdptypes.DefaultGovDenom
 	PrincipalDenom                  = "usdx"
 	IncentiveMacc                   = kavadistTypes.ModuleName
@@ -49,27 +51,29 @@ type Params struct {
 	HardSupplyRewardPeriods    MultiRewardPeriods `json:"hard_supply_reward_periods" yaml:"hard_supply_reward_periods"`
 	HardBorrowRewardPeriods    MultiRewardPeriods `json:"hard_borrow_reward_periods" yaml:"hard_borrow_reward_periods"`
 	HardDelegatorRewardPeriods RewardPeriods      `json:"hard_delegator_reward_periods" yaml:"hard_delegator_reward_periods"`
+	SourceRewardPeriods        MultiRewardPeriods `json:"source_reward_periods" yaml:"source_reward_periods"` // reward periods by reward source id
 	ClaimMultipliers           Multipliers        `json:"claim_multipliers" yaml:"claim_multipliers"`
-	ClaimEnd                   time.Time          `json:"claim_end" yaml:"claim_end"`
+	ClaimWindows               ClaimWindows       `json:"claim_windows" yaml:"claim_windows"`
 }
 
 // NewParams returns a new params object
 func NewParams(usdxMinting RewardPeriods, hardSupply, hardBorrow MultiRewardPeriods,
-	hardDelegator RewardPeriods, multipliers Multipliers, claimEnd time.Time) Params {
+	hardDelegator RewardPeriods, sources MultiRewardPeriods, multipliers Multipliers, claimWindows ClaimWindows) Params {
 	return Params{
 		USDXMintingRewardPeriods:   usdxMinting,
 		HardSupplyRewardPeriods:    hardSupply,
 		HardBorrowRewardPeriods:    hardBorrow,
 		HardDelegatorRewardPeriods: hardDelegator,
+		SourceRewardPeriods:        sources,
 		ClaimMultipliers:           multipliers,
-		ClaimEnd:                   claimEnd,
+		ClaimWindows:               claimWindows,
 	}
 }
 
 // DefaultParams returns default params for incentive module
 func DefaultParams() Params {
 	return NewParams(DefaultRewardPeriods, DefaultMultiRewardPeriods,
-		DefaultMultiRewardPeriods, DefaultRewardPeriods, DefaultMultipliers, DefaultClaimEnd)
+		DefaultMultiRewardPeriods, DefaultRewardPeriods, DefaultMultiRewardPeriods, DefaultMultipliers, DefaultClaimWindows)
 }
 
 // String implements fmt.Stringer
@@ -79,10 +83,11 @@ func (p Params) String() string {
 	Hard Supply Reward Periods: %s
 	Hard Borrow Reward Periods: %s
 	Hard Delegator Reward Periods: %s
+	Source Reward Periods: %s
 	Claim Multipliers :%s
-	Claim End Time: %s
+	Claim Windows: %s
 	`, p.USDXMintingRewardPeriods, p.HardSupplyRewardPeriods, p.HardBorrowRewardPeriods,
-		p.HardDelegatorRewardPeriods, p.ClaimMultipliers, p.ClaimEnd)
+		p.HardDelegatorRewardPeriods, p.SourceRewardPeriods, p.ClaimMultipliers, p.ClaimWindows)
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -97,7 +102,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(KeyHardSupplyRewardPeriods, &p.HardSupplyRewardPeriods, validateMultiRewardPeriodsParam),
 		params.NewParamSetPair(KeyHardBorrowRewardPeriods, &p.HardBorrowRewardPeriods, validateMultiRewardPeriodsParam),
 		params.NewParamSetPair(KeyHardDelegatorRewardPeriods, &p.HardDelegatorRewardPeriods, validateRewardPeriodsParam),
-		params.NewParamSetPair(KeyClaimEnd, &p.ClaimEnd, validateClaimEndParam),
+		params.NewParamSetPair(KeySourceRewardPeriods, &p.SourceRewardPeriods, validateMultiRewardPeriodsParam),
+		params.NewParamSetPair(KeyClaimWindows, &p.ClaimWindows, validateClaimWindowsParam),
 		params.NewParamSetPair(KeyMultipliers, &p.ClaimMultipliers, validateMultipliersParam),
 	}
 }
@@ -109,6 +115,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateClaimWindowsParam(p.ClaimWindows); err != nil {
+		return err
+	}
+
 	if err := validateRewardPeriodsParam(p.USDXMintingRewardPeriods); err != nil {
 		return err
 	}
@@ -121,7 +131,11 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateRewardPeriodsParam(p.HardDelegatorRewardPeriods)
+	if err := validateRewardPeriodsParam(p.HardDelegatorRewardPeriods); err != nil {
+		return err
+	}
+
+	return validateMultiRewardPeriodsParam(p.SourceRewardPeriods)
 }
 
 func validateRewardPeriodsParam(i interface{}) error {
@@ -150,15 +164,12 @@ func validateMultipliersParam(i interface{}) error {
 	return multipliers.Validate()
 }
 
-func validateClaimEndParam(i interface{}) error {
-	endTime, ok := i.(time.Time)
+func validateClaimWindowsParam(i interface{}) error {
+	windows, ok := i.(ClaimWindows)
 	if !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
-	if endTime.Unix() <= 0 {
-		return fmt.Errorf("end time should not be zero")
-	}
-	return nil
+	return windows.Validate()
 }
 
 // RewardPeriod stores the state of an ongoing reward
@@ -306,3 +317,106 @@ func (mn MultiplierName) IsValid() error {
 	}
 	return fmt.Errorf("invalid multiplier name: %s", mn)
 }
+
+// ClaimWindow controls when rewards of a single claim type can be claimed.
+// Claims are rejected after End. If ExpiryPeriod is positive, rewards left unclaimed for longer than ExpiryPeriod after
+// the start of the claim's accrual period expire, and a new accrual period begins. An accrual period starts when a claim
+// is created, claimed, or expires.
+type ClaimWindow struct {
+	ClaimType         string        `json:"claim_type" yaml:"claim_type"`
+	End               time.Time     `json:"end" yaml:"end"`
+	ExpiryPeriod      time.Duration `json:"expiry_period" yaml:"expiry_period"`             // zero disables rolling expiry
+	FundCommunityPool bool          `json:"fund_community_pool" yaml:"fund_community_pool"` // if false, expired rewards stay in the kavadist module account
+}
+
+// NewClaimWindow returns a new ClaimWindow
+func NewClaimWindow(claimType string, end time.Time, expiryPeriod time.Duration, fundCommunityPool bool) ClaimWindow {
+	return ClaimWindow{
+		ClaimType:         claimType,
+		End:               end,
+		ExpiryPeriod:      expiryPeriod,
+		FundCommunityPool: fundCommunityPool,
+	}
+}
+
+// Validate performs a basic check of a ClaimWindow fields.
+func (cw ClaimWindow) Validate() error {
+	if err := ValidateClaimType(cw.ClaimType); err != nil {
+		return err
+	}
+	if cw.End.Unix() <= 0 {
+		return fmt.Errorf("claim window end time for %s should not be zero", cw.ClaimType)
+	}
+	if cw.ExpiryPeriod < 0 {
+		return fmt.Errorf("claim window expiry period for %s cannot be negative: %s", cw.ClaimType, cw.ExpiryPeriod)
+	}
+	return nil
+}
+
+// ExpiresAt returns the time at which rewards in an accrual period starting at accrualStart can no longer be claimed
+func (cw ClaimWindow) ExpiresAt(accrualStart time.Time) time.Time {
+	if cw.ExpiryPeriod > 0 {
+		expiry := accrualStart.Add(cw.ExpiryPeriod)
+		if expiry.Before(cw.End) {
+			return expiry
+		}
+	}
+	return cw.End
+}
+
+// String implements fmt.Stringer
+func (cw ClaimWindow) String() string {
+	return fmt.Sprintf(`Claim Window:
+	Claim Type: %s
+	End: %s
+	Expiry Period: %s
+	Fund Community Pool: %t
+	`, cw.ClaimType, cw.End, cw.ExpiryPeriod, cw.FundCommunityPool)
+}
+
+// ClaimWindows slice of ClaimWindow
+type ClaimWindows []ClaimWindow
+
+// NewFixedClaimWindows returns claim windows for every claim type that close at end, without rolling expiry
+func NewFixedClaimWindows(end time.Time) ClaimWindows {
+	return ClaimWindows{
+		NewClaimWindow(USDXMintingClaimType, end, 0, false),
+		NewClaimWindow(HardLiquidityProviderClaimType, end, 0, false),
+		NewClaimWindow(SourceClaimType, end, 0, false),
+	}
+}
+
+// Validate checks if all the claim windows are valid and there are no duplicated
+// entries.
+func (cws ClaimWindows) Validate() error {
+	seenTypes := make(map[string]bool)
+	for _, cw := range cws {
+		if seenTypes[cw.ClaimType] {
+			return fmt.Errorf("duplicated claim window for claim type %s", cw.ClaimType)
+		}
+		if err := cw.Validate(); err != nil {
+			return err
+		}
+		seenTypes[cw.ClaimType] = true
+	}
+	return nil
+}
+
+// Get returns the claim window for the input claim type and a boolean for if it was found
+func (cws ClaimWindows) Get(claimType string) (ClaimWindow, bool) {
+	for _, cw := range cws {
+		if cw.ClaimType == claimType {
+			return cw, true
+		}
+	}
+	return ClaimWindow{}, false
+}
+
+// String implements fmt.Stringer
+func (cws ClaimWindows) String() string {
+	out := "Claim Windows\n"
+	for _, cw := range cws {
+		out += fmt.Sprintf("%s\n", cw)
+	}
+	return out
+}
@@ -25,7 +25,7 @@ func (suite *ParamTestSuite) TestParamValidation() {
 		hardBorrowRewardPeriods    types.MultiRewardPeriods
 		hardDelegatorRewardPeriods types.RewardPeriods
 		multipliers                types.Multipliers
-		end                        time.Time
+		claimWindows               types.ClaimWindows
 	}
 
 	type errArgs struct {
@@ -47,7 +47,7 @@ func (suite *ParamTestSuite) TestParamValidation() {
 				hardBorrowRewardPeriods:    types.DefaultMultiRewardPeriods,
 				hardDelegatorRewardPeriods: types.DefaultRewardPeriods,
 				multipliers:                types.DefaultMultipliers,
-				end:                        types.DefaultClaimEnd,
+				claimWindows:               types.DefaultClaimWindows,
 			},
 			errArgs{
 				expectPass: true,
@@ -71,19 +71,91 @@ func (suite *ParamTestSuite) TestParamValidation() {
 				hardSupplyRewardPeriods:    types.DefaultMultiRewardPeriods,
 				hardBorrowRewardPeriods:    types.DefaultMultiRewardPeriods,
 				hardDelegatorRewardPeriods: types.DefaultRewardPeriods,
-				end:                        time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC),
+				claimWindows: types.ClaimWindows{
+					types.NewClaimWindow(types.USDXMintingClaimType, time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC), time.Hour*24*90, false),
+					types.NewClaimWindow(types.HardLiquidityProviderClaimType, time.Date(2026, 10, 15, 14, 0, 0, 0, time.UTC), time.Hour*24*30, true),
+				},
 			},
 			errArgs{
 				expectPass: true,
 				contains:   "",
 			},
 		},
+		{
+			"invalid claim window type",
+			args{
+				usdxMintingRewardPeriods:   types.DefaultRewardPeriods,
+				hardSupplyRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardBorrowRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardDelegatorRewardPeriods: types.DefaultRewardPeriods,
+				multipliers:                types.DefaultMultipliers,
+				claimWindows: types.ClaimWindows{
+					types.NewClaimWindow("hard", time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC), 0, false),
+				},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "invalid claim type",
+			},
+		},
+		{
+			"duplicate claim window",
+			args{
+				usdxMintingRewardPeriods:   types.DefaultRewardPeriods,
+				hardSupplyRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardBorrowRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardDelegatorRewardPeriods: types.DefaultRewardPeriods,
+				multipliers:                types.DefaultMultipliers,
+				claimWindows: types.ClaimWindows{
+					types.NewClaimWindow(types.SourceClaimType, time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC), 0, false),
+					types.NewClaimWindow(types.SourceClaimType, time.Date(2026, 10, 15, 14, 0, 0, 0, time.UTC), 0, false),
+				},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "duplicated claim window",
+			},
+		},
+		{
+			"zero claim window end",
+			args{
+				usdxMintingRewardPeriods:   types.DefaultRewardPeriods,
+				hardSupplyRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardBorrowRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardDelegatorRewardPeriods: types.DefaultRewardPeriods,
+				multipliers:                types.DefaultMultipliers,
+				claimWindows: types.ClaimWindows{
+					types.NewClaimWindow(types.USDXMintingClaimType, time.Unix(0, 0), 0, false),
+				},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "end time",
+			},
+		},
+		{
+			"negative expiry period",
+			args{
+				usdxMintingRewardPeriods:   types.DefaultRewardPeriods,
+				hardSupplyRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardBorrowRewardPeriods:    types.DefaultMultiRewardPeriods,
+				hardDelegatorRewardPeriods: types.DefaultRewardPeriods,
+				multipliers:                types.DefaultMultipliers,
+				claimWindows: types.ClaimWindows{
+					types.NewClaimWindow(types.USDXMintingClaimType, time.Date(2025, 10, 15, 14, 0, 0, 0, time.UTC), -time.Hour, false),
+				},
+			},
+			errArgs{
+				expectPass: false,
+				contains:   "cannot be negative",
+			},
+		},
 	}
 
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
 			params := types.NewParams(tc.args.usdxMintingRewardPeriods, tc.args.hardSupplyRewardPeriods,
-				tc.args.hardBorrowRewardPeriods, tc.args.hardDelegatorRewardPeriods, tc.args.multipliers, tc.args.end,
+				tc.args.hardBorrowRewardPeriods, tc.args.hardDelegatorRewardPeriods, types.DefaultMultiRewardPeriods, tc.args.multipliers, tc.args.claimWindows,
 			)
 			err := params.Validate()
 			if tc.errArgs.expectPass {
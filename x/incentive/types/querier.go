@@ -1,6 +1,9 @@
 package types
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,10 +14,13 @@ const (
 	QueryGetHardRewardsUnsynced        = "hard-rewards-unsynced"
 	QueryGetUSDXMintingRewards         = "usdx-minting-rewards"
 	QueryGetUSDXMintingRewardsUnsynced = "usdx-minting-rewards-unsynced"
+	QueryGetSourceRewards              = "source-rewards"
+	QueryGetSourceRewardsUnsynced      = "source-rewards-unsynced"
 	QueryGetRewardFactors              = "reward-factors"
 	QueryGetParams                     = "parameters"
 	QueryGetRewardPeriods              = "reward-periods"
 	QueryGetClaimPeriods               = "claim-periods"
+	QueryGetClaimExpiries              = "claim-expiries"
 	RestClaimCollateralType            = "collateral_type"
 	RestClaimOwner                     = "owner"
 	RestClaimType                      = "type"
@@ -103,6 +109,22 @@ func NewQueryHardRewardsUnsyncedParams(page, limit int, owner sdk.AccAddress) Qu
 	}
 }
 
+// QuerySourceRewardsParams params for synced and unsynced query /incentive/rewards type source
+type QuerySourceRewardsParams struct {
+	Page  int `json:"page" yaml:"page"`
+	Limit int `json:"limit" yaml:"limit"`
+	Owner sdk.AccAddress
+}
+
+// NewQuerySourceRewardsParams returns QuerySourceRewardsParams
+func NewQuerySourceRewardsParams(page, limit int, owner sdk.AccAddress) QuerySourceRewardsParams {
+	return QuerySourceRewardsParams{
+		Page:  page,
+		Limit: limit,
+		Owner: owner,
+	}
+}
+
 // QueryRewardFactorsParams is the params for a filtered reward factors query
 type QueryRewardFactorsParams struct {
 	Denom string `json:"denom" yaml:"denom"`
@@ -138,3 +160,55 @@ func NewRewardFactor(denom string, usdxMintingRewardFactor sdk.Dec, hardSupplyRe
 
 // RewardFactors is a slice of RewardFactor
 type RewardFactors = []RewardFactor
+
+// QueryClaimExpiriesParams params for query /incentive/claim-expiries
+type QueryClaimExpiriesParams struct {
+	Page  int            `json:"page" yaml:"page"`
+	Limit int            `json:"limit" yaml:"limit"`
+	Owner sdk.AccAddress `json:"owner" yaml:"owner"`
+	Type  string         `json:"type" yaml:"type"`
+}
+
+// NewQueryClaimExpiriesParams returns QueryClaimExpiriesParams
+func NewQueryClaimExpiriesParams(page, limit int, owner sdk.AccAddress, claimType string) QueryClaimExpiriesParams {
+	return QueryClaimExpiriesParams{
+		Page:  page,
+		Limit: limit,
+		Owner: owner,
+		Type:  claimType,
+	}
+}
+
+// ClaimExpiry is returned by claim expiry queries and describes when a claim's outstanding rewards stop being claimable
+type ClaimExpiry struct {
+	Owner        sdk.AccAddress `json:"owner" yaml:"owner"`
+	ClaimType    string         `json:"claim_type" yaml:"claim_type"`
+	Reward       sdk.Coins      `json:"reward" yaml:"reward"`
+	AccrualStart time.Time      `json:"accrual_start" yaml:"accrual_start"`
+	ExpiresAt    time.Time      `json:"expires_at" yaml:"expires_at"`
+}
+
+// NewClaimExpiry returns a new ClaimExpiry
+func NewClaimExpiry(owner sdk.AccAddress, claimType string, reward sdk.Coins, accrualStart, expiresAt time.Time) ClaimExpiry {
+	return ClaimExpiry{
+		Owner:        owner,
+		ClaimType:    claimType,
+		Reward:       reward,
+		AccrualStart: accrualStart,
+		ExpiresAt:    expiresAt,
+	}
+}
+
+// String implements fmt.Stringer
+func (ce ClaimExpiry) String() string {
+	return fmt.Sprintf(`Claim Expiry:
+	Owner: %s
+	Claim Type: %s
+	Reward: %s
+	Accrual Start: %s
+	Expires At: %s
+	`, ce.Owner, ce.ClaimType, ce.Reward, ce.AccrualStart, ce.ExpiresAt)
+}
+
+// ClaimExpiries is a slice of ClaimExpiry
+type ClaimExpiries []ClaimExpiry
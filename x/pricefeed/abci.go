@@ -9,14 +9,32 @@ import (
 
 // EndBlocker updates the current pricefeed
 func EndBlocker(ctx sdk.Context, k Keeper) {
-	// Update the current price of each asset.
-	for _, market := range k.GetMarkets(ctx) {
+	// Remove prices and stats of oracles that have been removed from their market, or of markets that have been removed
+	k.PruneRawPrices(ctx)
+	k.PruneOracleStats(ctx)
+
+	// Update the current price of each asset, after the prices of any markets it is derived from.
+	markets, err := k.GetMarkets(ctx).SortByDerivation()
+	if err != nil {
+		panic(err)
+	}
+	updateCurrentPrices(ctx, k, markets)
+
+	// Penalized oracles no longer count towards the median, so prices are updated again without them
+	if k.UpdateOracleStats(ctx) {
+		updateCurrentPrices(ctx, k, markets)
+	}
+}
+
+func updateCurrentPrices(ctx sdk.Context, k Keeper, markets types.Markets) {
+	for _, market := range markets {
 		if !market.Active {
+			k.ClearMarketPrices(ctx, market)
 			continue
 		}
 
 		err := k.SetCurrentPrices(ctx, market.MarketID)
-		if err != nil && !errors.Is(err, types.ErrNoValidPrice) {
+		if err != nil && !errors.Is(err, types.ErrNoValidPrice) && !errors.Is(err, types.ErrMarketHalted) {
 			panic(err)
 		}
 	}
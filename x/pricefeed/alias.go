@@ -9,70 +9,130 @@ import (
 
 const (
 	AttributeExpiry             = types.AttributeExpiry
+	AttributeHaltReason         = types.AttributeHaltReason
+	AttributeJailedUntil        = types.AttributeJailedUntil
 	AttributeMarketID           = types.AttributeMarketID
 	AttributeMarketPrice        = types.AttributeMarketPrice
 	AttributeOracle             = types.AttributeOracle
+	AttributeRemoved            = types.AttributeRemoved
 	AttributeValueCategory      = types.AttributeValueCategory
 	DefaultParamspace           = types.DefaultParamspace
+	DefaultPriceHistoryLimit    = types.DefaultPriceHistoryLimit
+	DerivationDivide            = types.DerivationDivide
+	DerivationInverse           = types.DerivationInverse
+	DerivationMultiply          = types.DerivationMultiply
+	EventTypeMarketHalted       = types.EventTypeMarketHalted
 	EventTypeMarketPriceUpdated = types.EventTypeMarketPriceUpdated
 	EventTypeNoValidPrices      = types.EventTypeNoValidPrices
+	EventTypeOraclePenalized    = types.EventTypeOraclePenalized
 	EventTypeOracleUpdatedPrice = types.EventTypeOracleUpdatedPrice
 	MaxExpiry                   = types.MaxExpiry
+	MaxPriceHistoryLimit        = types.MaxPriceHistoryLimit
+	MaxPriceHistoryRetention    = types.MaxPriceHistoryRetention
+	MaxTWAPWindow               = types.MaxTWAPWindow
 	ModuleName                  = types.ModuleName
 	QuerierRoute                = types.QuerierRoute
 	QueryGetParams              = types.QueryGetParams
 	QueryMarkets                = types.QueryMarkets
+	QueryOracleStats            = types.QueryOracleStats
 	QueryOracles                = types.QueryOracles
 	QueryPrice                  = types.QueryPrice
+	QueryPriceHistory           = types.QueryPriceHistory
 	QueryRawPrices              = types.QueryRawPrices
 	RouterKey                   = types.RouterKey
 	StoreKey                    = types.StoreKey
+	TWAPMarketIDSeparator       = types.TWAPMarketIDSeparator
 	TypeMsgPostPrice            = types.TypeMsgPostPrice
+	TypeMsgPostPrices           = types.TypeMsgPostPrices
 )
 
 var (
 	// function aliases
+	InactiveMarketsInvariant   = keeper.InactiveMarketsInvariant
 	NewKeeper                  = keeper.NewKeeper
 	NewQuerier                 = keeper.NewQuerier
+	RegisterInvariants         = keeper.RegisterInvariants
+	ValidRawPricesInvariant    = keeper.ValidRawPricesInvariant
 	CurrentPriceKey            = types.CurrentPriceKey
 	DefaultGenesisState        = types.DefaultGenesisState
 	DefaultParams              = types.DefaultParams
+	MarketStatusKey            = types.MarketStatusKey
 	NewCurrentPrice            = types.NewCurrentPrice
+	NewDerivation              = types.NewDerivation
 	NewGenesisState            = types.NewGenesisState
 	NewMarket                  = types.NewMarket
+	NewMarketStatus            = types.NewMarketStatus
 	NewMsgPostPrice            = types.NewMsgPostPrice
+	NewMsgPostPrices           = types.NewMsgPostPrices
+	NewMultiPricefeedHooks     = types.NewMultiPricefeedHooks
+	NewOracleParams            = types.NewOracleParams
+	NewOracleStats             = types.NewOracleStats
 	NewParams                  = types.NewParams
 	NewPostedPrice             = types.NewPostedPrice
+	NewPriceEntry              = types.NewPriceEntry
+	NewPriceRecord             = types.NewPriceRecord
+	NewQueryPriceHistoryParams = types.NewQueryPriceHistoryParams
 	NewQueryWithMarketIDParams = types.NewQueryWithMarketIDParams
+	OracleStatsKey             = types.OracleStatsKey
+	OracleStatsKeyPrefix       = types.OracleStatsKeyPrefix
 	ParamKeyTable              = types.ParamKeyTable
+	ParseTWAPMarketID          = types.ParseTWAPMarketID
+	PriceRecordKey             = types.PriceRecordKey
+	PriceRecordKeyPrefix       = types.PriceRecordKeyPrefix
 	RawPriceKey                = types.RawPriceKey
 	RegisterCodec              = types.RegisterCodec
+	TWAPMarketID               = types.TWAPMarketID
+	TWAPPriceKey               = types.TWAPPriceKey
 
 	// variable aliases
-	CurrentPricePrefix = types.CurrentPricePrefix
-	DefaultMarkets     = types.DefaultMarkets
-	ErrAssetNotFound   = types.ErrAssetNotFound
-	ErrEmptyInput      = types.ErrEmptyInput
-	ErrExpired         = types.ErrExpired
-	ErrInvalidMarket   = types.ErrInvalidMarket
-	ErrInvalidOracle   = types.ErrInvalidOracle
-	ErrNoValidPrice    = types.ErrNoValidPrice
-	KeyMarkets         = types.KeyMarkets
-	ModuleCdc          = types.ModuleCdc
-	RawPriceFeedPrefix = types.RawPriceFeedPrefix
+	CurrentPricePrefix           = types.CurrentPricePrefix
+	DefaultMarkets               = types.DefaultMarkets
+	DefaultOracleParams          = types.DefaultOracleParams
+	DefaultPriceHistoryRetention = types.DefaultPriceHistoryRetention
+	ErrAssetNotFound             = types.ErrAssetNotFound
+	ErrEmptyInput                = types.ErrEmptyInput
+	ErrExpired                   = types.ErrExpired
+	ErrInvalidMarket             = types.ErrInvalidMarket
+	ErrInvalidOracle             = types.ErrInvalidOracle
+	ErrMarketHalted              = types.ErrMarketHalted
+	ErrNoValidPrice              = types.ErrNoValidPrice
+	ErrOracleJailed              = types.ErrOracleJailed
+	KeyMarkets                   = types.KeyMarkets
+	KeyOracleParams              = types.KeyOracleParams
+	KeyPriceHistoryRetention     = types.KeyPriceHistoryRetention
+	MarketStatusPrefix           = types.MarketStatusPrefix
+	ModuleCdc                    = types.ModuleCdc
+	OracleStatsPrefix            = types.OracleStatsPrefix
+	OracleWindowStartKey         = types.OracleWindowStartKey
+	PriceRecordPrefix            = types.PriceRecordPrefix
+	RawPriceFeedPrefix           = types.RawPriceFeedPrefix
+	TWAPPricePrefix              = types.TWAPPricePrefix
 )
 
 type (
 	Keeper                  = keeper.Keeper
 	CurrentPrice            = types.CurrentPrice
 	CurrentPrices           = types.CurrentPrices
+	Derivation              = types.Derivation
 	GenesisState            = types.GenesisState
 	Market                  = types.Market
+	MarketStatus            = types.MarketStatus
+	MarketStatuses          = types.MarketStatuses
 	Markets                 = types.Markets
 	MsgPostPrice            = types.MsgPostPrice
+	MsgPostPrices           = types.MsgPostPrices
+	MultiPricefeedHooks     = types.MultiPricefeedHooks
+	OracleParams            = types.OracleParams
+	OracleStats             = types.OracleStats
+	OracleStatsList         = types.OracleStatsList
 	Params                  = types.Params
 	PostedPrice             = types.PostedPrice
 	PostedPrices            = types.PostedPrices
+	PriceEntry              = types.PriceEntry
+	PriceRecord             = types.PriceRecord
+	PriceRecords            = types.PriceRecords
+	PricefeedHooks          = types.PricefeedHooks
+	QueryPriceHistoryParams = types.QueryPriceHistoryParams
 	QueryWithMarketIDParams = types.QueryWithMarketIDParams
 	SortDecs                = types.SortDecs
 )
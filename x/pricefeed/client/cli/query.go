@@ -2,18 +2,30 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
 
+// flags for cli queries
+const (
+	flagStart       = "start"
+	flagEnd         = "end"
+	flagStartHeight = "start-height"
+	flagEndHeight   = "end-height"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	// Group nameservice queries under a subcommand
@@ -30,6 +42,8 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryPrices(queryRoute, cdc),
 		GetCmdRawPrices(queryRoute, cdc),
 		GetCmdOracles(queryRoute, cdc),
+		GetCmdOracleStats(queryRoute, cdc),
+		GetCmdPriceHistory(queryRoute, cdc),
 		GetCmdMarkets(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
 	)...)
@@ -66,6 +80,99 @@ func GetCmdOracles(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdOracleStats queries the performance of the oracles of a market
+func GetCmdOracleStats(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "oracle-stats [marketID]",
+		Short: "get the performance stats of the oracles of a market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			marketID := args[0]
+
+			bz, err := cdc.MarshalJSON(types.QueryWithMarketIDParams{
+				MarketID: marketID,
+			})
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryOracleStats)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var stats types.OracleStatsList
+			cdc.MustUnmarshalJSON(res, &stats)
+			return cliCtx.PrintOutput(stats)
+		},
+	}
+}
+
+// GetCmdPriceHistory queries the historical prices of a market over a time range
+func GetCmdPriceHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-history [marketID]",
+		Short: "get the historical prices of a market",
+		Long:  "Get the median prices of a market accepted between the optional start and end times, given as UNIX times, and the optional start and end heights.",
+		Example: fmt.Sprintf(`%[1]s query %[2]s price-history bnb:usd --start 1609459200 --end 1609462800
+%[1]s query %[2]s price-history bnb:usd --start-height 100 --end-height 200 --page 2 --limit 50`,
+			version.ClientName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			marketID := args[0]
+
+			start, err := parseOptionalTime(viper.GetString(flagStart))
+			if err != nil {
+				return err
+			}
+			end, err := parseOptionalTime(viper.GetString(flagEnd))
+			if err != nil {
+				return err
+			}
+
+			startHeight := viper.GetInt64(flagStartHeight)
+			endHeight := viper.GetInt64(flagEndHeight)
+			page := viper.GetInt(flags.FlagPage)
+			limit := viper.GetInt(flags.FlagLimit)
+
+			bz, err := cdc.MarshalJSON(types.NewQueryPriceHistoryParams(marketID, start, end, startHeight, endHeight, page, limit))
+			if err != nil {
+				return err
+			}
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPriceHistory)
+
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+			var history types.PriceRecords
+			cdc.MustUnmarshalJSON(res, &history)
+			return cliCtx.PrintOutput(history)
+		},
+	}
+	cmd.Flags().String(flagStart, "", "(optional) start of the time range as a UNIX time")
+	cmd.Flags().String(flagEnd, "", "(optional) end of the time range as a UNIX time")
+	cmd.Flags().Int64(flagStartHeight, 0, "(optional) start of the height range")
+	cmd.Flags().Int64(flagEndHeight, 0, "(optional) end of the height range")
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of historical prices to query for")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultPriceHistoryLimit, "pagination limit of historical prices to query for")
+	return cmd
+}
+
+// parseOptionalTime parses a UNIX time, returning the zero time if it is empty
+func parseOptionalTime(arg string) (time.Time, error) {
+	if arg == "" {
+		return time.Time{}, nil
+	}
+	unix, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %s: %w", arg, err)
+	}
+	return time.Unix(unix, 0).UTC(), nil
+}
+
 // GetCmdPrice queries the current price of an asset
 func GetCmdPrice(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
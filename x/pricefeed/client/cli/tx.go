@@ -34,6 +34,7 @@ func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
 
 	pricefeedTxCmd.AddCommand(flags.PostCommands(
 		GetCmdPostPrice(cdc),
+		GetCmdPostPrices(cdc),
 	)...)
 
 	return pricefeedTxCmd
@@ -57,19 +58,54 @@ func GetCmdPostPrice(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			expiryInt, err := strconv.ParseInt(args[2], 10, 64)
+			expiry, err := parseExpiry(args[2])
 			if err != nil {
-				return fmt.Errorf("invalid expiry %s: %w", args[2], err)
+				return err
 			}
 
-			if expiryInt > types.MaxExpiry {
-				return fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+			msg := types.NewMsgPostPrice(cliCtx.GetFromAddress(), args[0], price, expiry)
+			if err = msg.ValidateBasic(); err != nil {
+				return err
 			}
 
-			expiry := tmtime.Canonical(time.Unix(expiryInt, 0))
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
 
-			msg := types.NewMsgPostPrice(cliCtx.GetFromAddress(), args[0], price, expiry)
-			if err = msg.ValidateBasic(); err != nil {
+// GetCmdPostPrices cli command for posting the prices of several markets in one message.
+func GetCmdPostPrices(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "postprices [marketID] [price] [expiry] [[marketID] [price] [expiry]...]",
+		Short: "post the latest prices for several markets, each with a given expiry as a UNIX time",
+		Example: fmt.Sprintf("%s tx %s postprices bnb:usd 25 9999999999 btc:usd 19000 9999999999 --from validator",
+			version.ClientName, types.ModuleName),
+		Args: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 || len(args)%3 != 0 {
+				return fmt.Errorf("requires a market id, price and expiry for each market, received %d arg(s)", len(args))
+			}
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			var prices []types.PriceEntry
+			for i := 0; i < len(args); i += 3 {
+				price, err := sdk.NewDecFromStr(args[i+1])
+				if err != nil {
+					return err
+				}
+				expiry, err := parseExpiry(args[i+2])
+				if err != nil {
+					return err
+				}
+				prices = append(prices, types.NewPriceEntry(args[i], price, expiry))
+			}
+
+			msg := types.NewMsgPostPrices(cliCtx.GetFromAddress(), prices)
+			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
 
@@ -77,3 +113,17 @@ func GetCmdPostPrice(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// parseExpiry parses an expiry given as a UNIX time
+func parseExpiry(arg string) (time.Time, error) {
+	expiryInt, err := strconv.ParseInt(arg, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiry %s: %w", arg, err)
+	}
+
+	if expiryInt > types.MaxExpiry {
+		return time.Time{}, fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+	}
+
+	return tmtime.Canonical(time.Unix(expiryInt, 0)), nil
+}
@@ -18,6 +18,7 @@ import (
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/postprice", types.ModuleName), postPriceHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/postprices", types.ModuleName), postPricesHandlerFn(cliCtx)).Methods("POST")
 
 }
 
@@ -47,20 +48,58 @@ func postPriceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		expiryInt, err := strconv.ParseInt(req.Expiry, 10, 64)
+		expiry, err := parseExpiry(req.Expiry)
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid expiry %s: %s", req.Expiry, err))
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		if expiryInt > types.MaxExpiry {
-			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry))
+		msg := types.NewMsgPostPrice(addr, req.MarketID, price, expiry)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		expiry := tmtime.Canonical(time.Unix(expiryInt, 0))
+		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
 
-		msg := types.NewMsgPostPrice(addr, req.MarketID, price, expiry)
+func postPricesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req PostPricesReq
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		addr, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var prices []types.PriceEntry
+		for _, entry := range req.Prices {
+			price, err := sdk.NewDecFromStr(entry.Price)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			expiry, err := parseExpiry(entry.Expiry)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+			prices = append(prices, types.NewPriceEntry(entry.MarketID, price, expiry))
+		}
+
+		msg := types.NewMsgPostPrices(addr, prices)
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -69,3 +108,17 @@ func postPriceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, baseReq, []sdk.Msg{msg})
 	}
 }
+
+// parseExpiry parses an expiry given as a UNIX time
+func parseExpiry(expiryStr string) (time.Time, error) {
+	expiryInt, err := strconv.ParseInt(expiryStr, 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiry %s: %s", expiryStr, err)
+	}
+
+	if expiryInt > types.MaxExpiry {
+		return time.Time{}, fmt.Errorf("invalid expiry; got %d, max: %d", expiryInt, types.MaxExpiry)
+	}
+
+	return tmtime.Canonical(time.Unix(expiryInt, 0)), nil
+}
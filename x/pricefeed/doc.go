@@ -1,6 +1,4 @@
 /*
-
 Package pricefeed allows a group of white-listed oracles to post price information of specific markets that are tracked by the system. For each market, the module computes the median of all posted prices by white-listed oracles and takes that as the current price value.
-
 */
 package pricefeed
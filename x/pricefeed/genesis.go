@@ -1,7 +1,11 @@
 package pricefeed
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
 )
 
 // InitGenesis sets distribution information for genesis.
@@ -9,8 +13,15 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 	// Set the markets and oracles from params
 	keeper.SetParams(ctx, gs.Params)
 
+	for _, record := range gs.PriceRecords {
+		keeper.SetPriceRecord(ctx, record)
+	}
+
 	// Iterate through the posted prices and set them in the store if they are not expired
 	for _, pp := range gs.PostedPrices {
+		if _, err := keeper.GetOracle(ctx, pp.MarketID, pp.OracleAddress); err != nil {
+			panic(err)
+		}
 		if pp.Expiry.After(ctx.BlockTime()) {
 			_, err := keeper.SetPrice(ctx, pp.OracleAddress, pp.MarketID, pp.Price, pp.Expiry)
 			if err != nil {
@@ -18,11 +29,33 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 			}
 		}
 	}
+
+	// Set the oracle stats after the posted prices, so they are not counted as new posts
+	for _, stats := range gs.OracleStats {
+		keeper.SetOracleStats(ctx, stats)
+	}
+
+	// Set the market statuses before the current prices, so new prices are checked against the last accepted prices
+	for _, status := range gs.MarketStatuses {
+		keeper.SetMarketStatus(ctx, status)
+	}
 	params := keeper.GetParams(ctx)
 
 	// Set the current price (if any) based on what's now in the store
-	for _, market := range params.Markets {
-		if !market.Active {
+	markets, err := params.Markets.SortByDerivation()
+	if err != nil {
+		panic(err)
+	}
+	for _, market := range markets {
+		// halted markets keep their imported status until prices are next updated
+		if !market.Active || keeper.IsMarketHalted(ctx, market.MarketID) {
+			continue
+		}
+		if market.IsDerived() {
+			err = keeper.SetCurrentPrices(ctx, market.MarketID)
+			if err != nil && !errors.Is(err, types.ErrNoValidPrice) && !errors.Is(err, types.ErrMarketHalted) {
+				panic(err)
+			}
 			continue
 		}
 		rps, err := keeper.GetRawPrices(ctx, market.MarketID)
@@ -33,7 +66,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, gs GenesisState) {
 			continue
 		}
 		err = keeper.SetCurrentPrices(ctx, market.MarketID)
-		if err != nil {
+		if err != nil && !errors.Is(err, types.ErrMarketHalted) {
 			panic(err)
 		}
 	}
@@ -54,5 +87,5 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		postedPrices = append(postedPrices, pp...)
 	}
 
-	return NewGenesisState(params, postedPrices)
+	return NewGenesisState(params, postedPrices, keeper.GetPriceRecords(ctx), keeper.GetAllOracleStats(ctx), keeper.GetAllMarketStatuses(ctx))
 }
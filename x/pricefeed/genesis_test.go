@@ -2,13 +2,15 @@ package pricefeed_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/pricefeed"
-
-	"github.com/stretchr/testify/suite"
 )
 
 type GenesisTestSuite struct {
@@ -28,6 +30,7 @@ func (suite *GenesisTestSuite) TestValidGenState() {
 	})
 	_, addrs := app.GeneratePrivKeyAddressPairs(10)
 
+	tApp = app.NewTestApp()
 	suite.NotPanics(func() {
 		tApp.InitializeFromGenesisStates(
 			NewPricefeedGenStateWithOracles(addrs),
@@ -35,6 +38,37 @@ func (suite *GenesisTestSuite) TestValidGenState() {
 	})
 }
 
+func (suite *GenesisTestSuite) TestExportImportMarketStatus() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Now().UTC()})
+	tApp.InitializeFromGenesisStates()
+	keeper := tApp.GetPriceFeedKeeper()
+
+	market := pricefeed.Market{
+		MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: []sdk.AccAddress{oracle}, Active: true,
+		MaxPriceDeviation: sdk.MustNewDecFromStr("0.1"), ResumeUpdates: 3,
+	}
+	keeper.SetParams(ctx, pricefeed.NewParams(pricefeed.Markets{market}, pricefeed.DefaultOracleParams, pricefeed.DefaultPriceHistoryRetention))
+	_, err := keeper.SetPrice(ctx, oracle, "xrp:usd", sdk.MustNewDecFromStr("0.25"), ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.SetCurrentPrices(ctx, "xrp:usd"))
+	_, err = keeper.SetPrice(ctx, oracle, "xrp:usd", sdk.MustNewDecFromStr("0.5"), ctx.BlockTime().Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Error(keeper.SetCurrentPrices(ctx, "xrp:usd"))
+	suite.Require().True(keeper.IsMarketHalted(ctx, "xrp:usd"))
+
+	exported := pricefeed.ExportGenesis(ctx, keeper)
+	suite.Require().NoError(exported.Validate())
+	suite.Require().Len(exported.MarketStatuses, 1)
+
+	// the market is still halted after importing, rather than accepting the outlier price as a fresh start
+	tApp = app.NewTestApp()
+	tApp.InitializeFromGenesisStates(app.GenesisState{pricefeed.ModuleName: pricefeed.ModuleCdc.MustMarshalJSON(exported)})
+	ctx = tApp.NewContext(true, abci.Header{Height: 1, Time: ctx.BlockTime()})
+	suite.True(tApp.GetPriceFeedKeeper().IsMarketHalted(ctx, "xrp:usd"))
+	suite.Equal(exported.MarketStatuses, tApp.GetPriceFeedKeeper().GetAllMarketStatuses(ctx))
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
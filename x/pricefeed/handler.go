@@ -12,6 +12,8 @@ func NewHandler(k Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgPostPrice:
 			return HandleMsgPostPrice(ctx, k, msg)
+		case MsgPostPrices:
+			return HandleMsgPostPrices(ctx, k, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", ModuleName, msg)
 		}
@@ -46,3 +48,28 @@ func HandleMsgPostPrice(
 
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
+
+// HandleMsgPostPrices handles the prices of several markets posted by an oracle.
+// If any of the prices cannot be set the whole message fails.
+func HandleMsgPostPrices(ctx sdk.Context, k Keeper, msg MsgPostPrices) (*sdk.Result, error) {
+	for _, entry := range msg.Prices {
+		_, err := k.GetOracle(ctx, entry.MarketID, msg.From)
+		if err != nil {
+			return nil, err
+		}
+		_, err = k.SetPrice(ctx, msg.From, entry.MarketID, entry.Price, entry.Expiry)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.From.String()),
+		),
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
@@ -0,0 +1,70 @@
+package pricefeed_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+type HandlerTestSuite struct {
+	suite.Suite
+
+	ctx     sdk.Context
+	keeper  pricefeed.Keeper
+	handler sdk.Handler
+	addrs   []sdk.AccAddress
+}
+
+func (suite *HandlerTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	_, suite.addrs = app.GeneratePrivKeyAddressPairs(2)
+	tApp.InitializeFromGenesisStates(NewPricefeedGenStateWithOracles(suite.addrs[:1]))
+	suite.ctx = tApp.NewContext(true, abci.Header{Height: 1, Time: time.Now().UTC()})
+	suite.keeper = tApp.GetPriceFeedKeeper()
+	suite.handler = pricefeed.NewHandler(suite.keeper)
+}
+
+func (suite *HandlerTestSuite) TestMsgPostPrices() {
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+	msg := pricefeed.NewMsgPostPrices(suite.addrs[0], []pricefeed.PriceEntry{
+		pricefeed.NewPriceEntry("btc:usd", sdk.MustNewDecFromStr("19000"), expiry),
+		pricefeed.NewPriceEntry("xrp:usd", sdk.MustNewDecFromStr("0.45"), expiry),
+	})
+	_, err := suite.handler(suite.ctx, msg)
+	suite.Require().NoError(err)
+
+	for _, entry := range msg.Prices {
+		rawPrices, err := suite.keeper.GetRawPrices(suite.ctx, entry.MarketID)
+		suite.Require().NoError(err)
+		found := false
+		for _, rp := range rawPrices {
+			if rp.OracleAddress.Equals(suite.addrs[0]) {
+				suite.Require().Equal(entry.Price, rp.Price)
+				found = true
+			}
+		}
+		suite.Require().True(found, entry.MarketID)
+	}
+}
+
+func (suite *HandlerTestSuite) TestMsgPostPrices_InvalidOracle() {
+	expiry := suite.ctx.BlockTime().Add(time.Hour)
+	msg := pricefeed.NewMsgPostPrices(suite.addrs[1], []pricefeed.PriceEntry{
+		pricefeed.NewPriceEntry("btc:usd", sdk.MustNewDecFromStr("19000"), expiry),
+	})
+	_, err := suite.handler(suite.ctx, msg)
+	suite.Require().True(errors.Is(err, pricefeed.ErrInvalidOracle))
+}
+
+func TestHandlerTestSuite(t *testing.T) {
+	suite.Run(t, new(HandlerTestSuite))
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetPriceHistory returns a page of a market's price records accepted within the params' time and height ranges, inclusive.
+// Records are returned oldest first, and iteration stops once the page is full.
+func (k Keeper) GetPriceHistory(ctx sdk.Context, params types.QueryPriceHistoryParams) types.PriceRecords {
+	limit := params.Limit
+	if limit <= 0 {
+		limit = types.DefaultPriceHistoryLimit
+	}
+	if limit > types.MaxPriceHistoryLimit {
+		limit = types.MaxPriceHistoryLimit
+	}
+	skip := 0
+	if params.Page > 1 {
+		skip = (params.Page - 1) * limit
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceRecordKeyPrefix(params.MarketID))
+	var startKey []byte
+	if !params.StartTime.IsZero() {
+		startKey = sdk.FormatTimeBytes(params.StartTime)
+	}
+	iterator := store.Iterator(startKey, nil)
+	defer iterator.Close()
+
+	records := types.PriceRecords{}
+	for ; iterator.Valid() && len(records) < limit; iterator.Next() {
+		var record types.PriceRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		// records are ordered by time, and heights increase with time
+		if (!params.EndTime.IsZero() && record.Time.After(params.EndTime)) || (params.EndHeight > 0 && record.Height > params.EndHeight) {
+			break
+		}
+		if record.Height < params.StartHeight {
+			continue
+		}
+		if skip > 0 {
+			skip--
+			continue
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// recordPrice stores a market's newly accepted median price and deletes the records that are no longer needed.
+// Records are kept for the longer of the market's longest time weighted average price window and the price history retention period,
+// along with the price at the start of that period if the market has time weighted average prices.
+func (k Keeper) recordPrice(ctx sdk.Context, market types.Market, price sdk.Dec) {
+	longestWindow := longestTWAPWindow(market)
+	keep := k.GetPriceHistoryRetention(ctx)
+	if longestWindow > keep {
+		keep = longestWindow
+	}
+	if keep > 0 {
+		k.SetPriceRecord(ctx, types.NewPriceRecord(market.MarketID, price, ctx.BlockHeight(), ctx.BlockTime()))
+	}
+	k.prunePriceRecords(ctx, market.MarketID, keep, longestWindow > 0)
+}
+
+// longestTWAPWindow returns the longest time weighted average price window of a market
+func longestTWAPWindow(market types.Market) time.Duration {
+	var longest time.Duration
+	for _, window := range market.TWAPWindows {
+		if window > longest {
+			longest = window
+		}
+	}
+	return longest
+}
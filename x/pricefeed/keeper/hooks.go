@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// Implements PricefeedHooks interface
+var _ types.PricefeedHooks = Keeper{}
+
+// AfterPriceChanged - call hook if registered
+func (k Keeper) AfterPriceChanged(ctx sdk.Context, marketID string, oldPrice, newPrice sdk.Dec) {
+	if k.hooks != nil {
+		k.hooks.AfterPriceChanged(ctx, marketID, oldPrice, newPrice)
+	}
+}
+
+// AfterMarketStatusChanged - call hook if registered
+func (k Keeper) AfterMarketStatusChanged(ctx sdk.Context, marketID string, halted bool) {
+	if k.hooks != nil {
+		k.hooks.AfterMarketStatusChanged(ctx, marketID, halted)
+	}
+}
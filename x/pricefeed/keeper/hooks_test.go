@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// recordingHooks records the pricefeed hook calls it receives
+type recordingHooks struct {
+	calls []string
+}
+
+func (h *recordingHooks) AfterPriceChanged(ctx sdk.Context, marketID string, oldPrice, newPrice sdk.Dec) {
+	h.calls = append(h.calls, fmt.Sprintf("price %s %s -> %s", marketID, oldPrice, newPrice))
+}
+
+func (h *recordingHooks) AfterMarketStatusChanged(ctx sdk.Context, marketID string, halted bool) {
+	h.calls = append(h.calls, fmt.Sprintf("status %s halted %t", marketID, halted))
+}
+
+// newKeeperWithoutHooks returns a pricefeed keeper on its own store, as the app's keeper already has its hooks set
+func newKeeperWithoutHooks(t *testing.T) (sdk.Context, keeper.Keeper) {
+	cdc := app.MakeCodec()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{Height: 1, Time: time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)}, false, log.NewNopLogger())
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tkeyParams)
+	return ctx, keeper.NewKeeper(cdc, key, paramsKeeper.Subspace(types.DefaultParamspace))
+}
+
+func TestKeeper_Hooks(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	ctx, pk := newKeeperWithoutHooks(t)
+
+	// every hook of a MultiPricefeedHooks is called
+	first, second := &recordingHooks{}, &recordingHooks{}
+	pk.SetHooks(types.NewMultiPricefeedHooks(first, second))
+	require.Panics(t, func() { pk.SetHooks(first) })
+
+	market := types.Market{
+		MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true,
+		MaxPriceDeviation: sdk.MustNewDecFromStr("0.1"), ResumeUpdates: 1,
+	}
+	pk.SetParams(ctx, types.Params{Markets: types.Markets{market}})
+
+	setPrice := func(price string) {
+		_, err := pk.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		_ = pk.SetCurrentPrices(ctx, "tstusd")
+	}
+
+	setPrice("10")
+	// unchanged prices do not call the hooks
+	setPrice("10")
+	// a large move halts the market, and consistent prices resume it
+	setPrice("20")
+	setPrice("20.5")
+	setPrice("20.5")
+	// expired prices clear the price
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	_ = pk.SetCurrentPrices(ctx, "tstusd")
+
+	expected := []string{
+		"price tstusd 0.000000000000000000 -> 10.000000000000000000",
+		"status tstusd halted true",
+		"status tstusd halted false",
+		"price tstusd 10.000000000000000000 -> 20.500000000000000000",
+		"price tstusd 20.500000000000000000 -> 0.000000000000000000",
+	}
+	require.Equal(t, expected, first.calls)
+	require.Equal(t, expected, second.calls)
+}
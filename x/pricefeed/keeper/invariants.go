@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// RegisterInvariants registers all pricefeed invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+
+	ir.RegisterRoute(types.ModuleName, "valid-raw-prices",
+		ValidRawPricesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "inactive-markets",
+		InactiveMarketsInvariant(k))
+}
+
+// ValidRawPricesInvariant verifies that all raw prices in the store were posted by a current oracle of an existing market
+func ValidRawPricesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+
+		var invalidPrice types.PostedPrice
+		broken := false
+		k.IterateRawPrices(ctx, func(marketID string, prices types.PostedPrices) bool {
+			for _, p := range prices {
+				if _, err := k.GetOracle(ctx, marketID, p.OracleAddress); err != nil || p.MarketID != marketID {
+					invalidPrice = p
+					broken = true
+					return true
+				}
+			}
+			return false
+		})
+
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"valid raw prices",
+			fmt.Sprintf(
+				"\tfound raw price not posted by an oracle of its market\n"+
+					"\tprice:\n\t%+v\n",
+				invalidPrice),
+		)
+		return invariantMessage, broken
+	}
+}
+
+// InactiveMarketsInvariant verifies that inactive markets have no current price
+func InactiveMarketsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+
+		var invalidMarket types.Market
+		var invalidPrice types.CurrentPrice
+		broken := false
+		for _, market := range k.GetMarkets(ctx) {
+			if market.Active {
+				continue
+			}
+			if price, err := k.getCurrentPrice(ctx, market.MarketID); err == nil {
+				invalidMarket = market
+				invalidPrice = price
+				broken = true
+				break
+			}
+		}
+
+		invariantMessage := sdk.FormatInvariant(
+			types.ModuleName,
+			"inactive markets",
+			fmt.Sprintf(
+				"\tfound inactive market with a current price %+v\n"+
+					"\tmarket:\n\t%+v\n",
+				invalidPrice, invalidMarket),
+		)
+		return invariantMessage, broken
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"sort"
 	"time"
 
+	"github.com/tendermint/tendermint/crypto/tmhash"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -24,6 +25,8 @@ type Keeper struct {
 	cdc *codec.Codec
 	// The reference to the Paramstore to get and set pricefeed specific params
 	paramSubspace subspace.Subspace
+	// Hooks run by other keepers in response to market price changes
+	hooks types.PricefeedHooks
 }
 
 // NewKeeper returns a new keeper for the pricefeed module.
@@ -38,9 +41,19 @@ func NewKeeper(
 		cdc:           cdc,
 		key:           key,
 		paramSubspace: paramstore,
+		hooks:         nil,
 	}
 }
 
+// SetHooks sets the pricefeed keeper hooks
+func (k *Keeper) SetHooks(hooks types.PricefeedHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set pricefeed hooks twice")
+	}
+	k.hooks = hooks
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
@@ -57,6 +70,9 @@ func (k Keeper) SetPrice(
 	if !expiry.After(ctx.BlockTime()) {
 		return types.PostedPrice{}, types.ErrExpired
 	}
+	if k.IsOracleJailed(ctx, marketID, oracle) {
+		return types.PostedPrice{}, sdkerrors.Wrap(types.ErrOracleJailed, oracle.String())
+	}
 
 	store := ctx.KVStore(k.key)
 	prices, err := k.GetRawPrices(ctx, marketID)
@@ -93,44 +109,77 @@ func (k Keeper) SetPrice(
 	)
 
 	store.Set(types.RawPriceKey(marketID), k.cdc.MustMarshalBinaryBare(prices))
+	k.recordOraclePost(ctx, marketID, oracle)
 	return prices[index], nil
 }
 
-// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs
+// SetCurrentPrices updates the price of an asset to the median of all valid oracle inputs,
+// or for derived markets to the price derived from the current prices of their input markets
 func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
-	_, ok := k.GetMarket(ctx, marketID)
+	market, ok := k.GetMarket(ctx, marketID)
 	if !ok {
 		return sdkerrors.Wrap(types.ErrInvalidMarket, marketID)
 	}
 	// store current price
 	validPrevPrice := true
-	prevPrice, err := k.GetCurrentPrice(ctx, marketID)
+	prevPrice, err := k.getCurrentPrice(ctx, marketID)
 	if err != nil {
 		validPrevPrice = false
 	}
 
-	prices, err := k.GetRawPrices(ctx, marketID)
-	if err != nil {
-		return err
-	}
-	var notExpiredPrices types.CurrentPrices
-	// filter out expired prices
-	for _, v := range prices {
-		if v.Expiry.After(ctx.BlockTime()) {
-			notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+	status := k.getMarketStatus(ctx, marketID)
+	var medianPrice sdk.Dec
+	var inputs []byte
+	if market.IsDerived() {
+		var inputPrices []sdk.Dec
+		medianPrice, inputPrices, err = k.calculateDerivedPrice(ctx, *market.Derivation)
+		if err != nil {
+			k.clearCurrentPrice(ctx, market)
+			return sdkerrors.Wrapf(types.ErrNoValidPrice, "%s: %s", marketID, err)
+		}
+		inputs = tmhash.Sum(k.cdc.MustMarshalBinaryBare(inputPrices))
+	} else {
+		prices, err := k.GetRawPrices(ctx, marketID)
+		if err != nil {
+			return err
+		}
+		var notExpiredPrices types.CurrentPrices
+		var validPosts types.PostedPrices
+		// filter out expired prices and prices from jailed oracles
+		for _, v := range prices {
+			if v.Expiry.After(ctx.BlockTime()) && !k.IsOracleJailed(ctx, marketID, v.OracleAddress) {
+				notExpiredPrices = append(notExpiredPrices, types.NewCurrentPrice(v.MarketID, v.Price))
+				validPosts = append(validPosts, v)
+			}
+		}
+
+		if len(notExpiredPrices) == 0 {
+			k.clearCurrentPrice(ctx, market)
+			return types.ErrNoValidPrice
+		}
+
+		if uint64(len(notExpiredPrices)) < market.MinOracles {
+			// price updates without a quorum break a run of consistent prices
+			status.PendingUpdates = 0
+			return k.haltMarket(ctx, status, fmt.Sprintf("%d of %d required oracle prices", len(notExpiredPrices), market.MinOracles))
 		}
-	}
 
-	if len(notExpiredPrices) == 0 {
-		// NOTE: The current price stored will continue storing the most recent (expired)
-		// price if this is not set.
-		// This zero's out the current price stored value for that market and ensures
-		// that CDP methods that GetCurrentPrice will return error.
-		k.setCurrentPrice(ctx, marketID, types.CurrentPrice{})
-		return types.ErrNoValidPrice
+		medianPrice = k.CalculateMedianPrice(ctx, notExpiredPrices)
+		inputs = tmhash.Sum(k.cdc.MustMarshalBinaryBare(validPosts))
 	}
 
-	medianPrice := k.CalculateMedianPrice(ctx, notExpiredPrices)
+	// new prices are compared against the last accepted price, which is kept in the status so it survives gaps and genesis export
+	lastPrice, hasLastPrice := prevPrice.Price, validPrevPrice
+	if status.HasLastPrice() {
+		lastPrice, hasLastPrice = status.LastPrice, true
+	}
+	if hasLastPrice && !market.AllowsPriceChange(lastPrice, medianPrice, ctx.BlockTime().Sub(status.LastPriceTime)) {
+		// a halted market resumes once enough consecutive prices agree with each other, so a genuine move does not halt it permanently
+		status = status.WithPendingPrice(market, medianPrice, inputs)
+		if !status.CanResume(market) {
+			return k.haltMarket(ctx, status, fmt.Sprintf("price %s deviates too far from %s", medianPrice, lastPrice))
+		}
+	}
 
 	// check case that market price was not set in genesis
 	if validPrevPrice && !medianPrice.Equal(prevPrice.Price) {
@@ -146,10 +195,163 @@ func (k Keeper) SetCurrentPrices(ctx sdk.Context, marketID string) error {
 
 	currentPrice := types.NewCurrentPrice(marketID, medianPrice)
 	k.setCurrentPrice(ctx, marketID, currentPrice)
+	k.recordPrice(ctx, market, medianPrice)
+	k.setTWAPPrices(ctx, market)
+
+	if market.HasGuards() {
+		k.SetMarketStatus(ctx, types.NewMarketStatus(marketID, false, ctx.BlockTime(), medianPrice))
+	} else {
+		k.deleteMarketStatus(ctx, marketID)
+	}
 
+	if status.Halted {
+		k.AfterMarketStatusChanged(ctx, marketID, false)
+	}
+	if !validPrevPrice {
+		k.AfterPriceChanged(ctx, marketID, sdk.ZeroDec(), medianPrice)
+	} else if !medianPrice.Equal(prevPrice.Price) {
+		k.AfterPriceChanged(ctx, marketID, prevPrice.Price, medianPrice)
+	}
 	return nil
 }
 
+// clearCurrentPrice removes a market's price when it has no valid price
+func (k Keeper) clearCurrentPrice(ctx sdk.Context, market types.Market) {
+	prevPrice, err := k.getCurrentPrice(ctx, market.MarketID)
+	// NOTE: The current price stored will continue storing the most recent (expired)
+	// price if this is not set.
+	// This zero's out the current price stored value for that market and ensures
+	// that CDP methods that GetCurrentPrice will return error.
+	k.setCurrentPrice(ctx, market.MarketID, types.CurrentPrice{})
+	k.clearTWAPPrices(ctx, market)
+	if err == nil {
+		k.AfterPriceChanged(ctx, market.MarketID, prevPrice.Price, sdk.ZeroDec())
+	}
+}
+
+// PruneMarkets removes the raw prices and oracle stats of addresses that are no longer oracles of their market, and the prices of inactive markets.
+// It is called when the params change, so the store matches the new params before the end of the block.
+func (k Keeper) PruneMarkets(ctx sdk.Context) {
+	k.PruneRawPrices(ctx)
+	k.PruneOracleStats(ctx)
+	for _, market := range k.GetMarkets(ctx) {
+		if !market.Active {
+			k.ClearMarketPrices(ctx, market)
+		}
+	}
+}
+
+// ClearMarketPrices removes the current price, time weighted average prices, price records and guard status of a market,
+// so an inactive market is not left with a stale price
+func (k Keeper) ClearMarketPrices(ctx sdk.Context, market types.Market) {
+	prevPrice, err := k.getCurrentPrice(ctx, market.MarketID)
+	store := ctx.KVStore(k.key)
+	store.Delete(types.CurrentPriceKey(market.MarketID))
+	k.clearTWAPPrices(ctx, market)
+	k.prunePriceRecords(ctx, market.MarketID, 0, false)
+	k.deleteMarketStatus(ctx, market.MarketID)
+	if err == nil {
+		k.AfterPriceChanged(ctx, market.MarketID, prevPrice.Price, sdk.ZeroDec())
+	}
+}
+
+// calculateDerivedPrice derives a price from the current prices of the derivation's input markets, and returns the input prices it was derived from
+func (k Keeper) calculateDerivedPrice(ctx sdk.Context, derivation types.Derivation) (sdk.Dec, []sdk.Dec, error) {
+	prices := make([]sdk.Dec, len(derivation.Inputs))
+	for i, input := range derivation.Inputs {
+		price, err := k.GetCurrentPrice(ctx, input)
+		if err != nil {
+			return sdk.Dec{}, nil, err
+		}
+		prices[i] = price.Price
+	}
+	price, err := derivation.Calculate(prices)
+	return price, prices, err
+}
+
+// haltMarket marks a market as halted by its guards. Its last accepted price is kept to compare new prices against.
+func (k Keeper) haltMarket(ctx sdk.Context, status types.MarketStatus, reason string) error {
+	wasHalted := status.Halted
+	if !wasHalted {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMarketHalted,
+				sdk.NewAttribute(types.AttributeMarketID, status.MarketID),
+				sdk.NewAttribute(types.AttributeHaltReason, reason),
+			),
+		)
+	}
+	status.Halted = true
+	k.SetMarketStatus(ctx, status)
+	if !wasHalted {
+		k.AfterMarketStatusChanged(ctx, status.MarketID, true)
+	}
+	return sdkerrors.Wrapf(types.ErrMarketHalted, "%s: %s", status.MarketID, reason)
+}
+
+// IsMarketHalted returns true if the market's price has been halted by its guards
+func (k Keeper) IsMarketHalted(ctx sdk.Context, marketID string) bool {
+	return k.getMarketStatus(ctx, marketID).Halted
+}
+
+func (k Keeper) getMarketStatus(ctx sdk.Context, marketID string) types.MarketStatus {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.MarketStatusKey(marketID))
+	if bz == nil {
+		return types.NewMarketStatus(marketID, false, time.Time{}, sdk.Dec{})
+	}
+	var status types.MarketStatus
+	k.cdc.MustUnmarshalBinaryBare(bz, &status)
+	return status
+}
+
+// GetMarketStatus returns the status of a market's guards
+func (k Keeper) GetMarketStatus(ctx sdk.Context, marketID string) (types.MarketStatus, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.MarketStatusKey(marketID))
+	if bz == nil {
+		return types.MarketStatus{}, false
+	}
+	var status types.MarketStatus
+	k.cdc.MustUnmarshalBinaryBare(bz, &status)
+	return status, true
+}
+
+// IterateMarketStatuses iterates over the statuses of all markets with guards and performs a callback function
+func (k Keeper) IterateMarketStatuses(ctx sdk.Context, cb func(status types.MarketStatus) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.MarketStatusPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var status types.MarketStatus
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &status)
+		if cb(status) {
+			break
+		}
+	}
+}
+
+// GetAllMarketStatuses returns the statuses of all markets with guards
+func (k Keeper) GetAllMarketStatuses(ctx sdk.Context) types.MarketStatuses {
+	statuses := types.MarketStatuses{}
+	k.IterateMarketStatuses(ctx, func(status types.MarketStatus) (stop bool) {
+		statuses = append(statuses, status)
+		return false
+	})
+	return statuses
+}
+
+// SetMarketStatus sets the status of a market's guards
+func (k Keeper) SetMarketStatus(ctx sdk.Context, status types.MarketStatus) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.MarketStatusKey(status.MarketID), k.cdc.MustMarshalBinaryBare(status))
+}
+
+func (k Keeper) deleteMarketStatus(ctx sdk.Context, marketID string) {
+	store := ctx.KVStore(k.key)
+	store.Delete(types.MarketStatusKey(marketID))
+}
+
 func (k Keeper) setCurrentPrice(ctx sdk.Context, marketID string, currentPrice types.CurrentPrice) {
 	store := ctx.KVStore(k.key)
 	store.Set(types.CurrentPriceKey(marketID), k.cdc.MustMarshalBinaryBare(currentPrice))
@@ -183,8 +385,22 @@ func (k Keeper) calculateMeanPrice(ctx sdk.Context, prices types.CurrentPrices)
 	return mean
 }
 
-// GetCurrentPrice fetches the current median price of all oracles for a specific market
+// GetCurrentPrice fetches the current median price of all oracles for a specific market,
+// or the time weighted average of the median price for a market id such as "bnb:usd:30".
+// No price is returned for markets halted by their guards.
 func (k Keeper) GetCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
+	store := ctx.KVStore(k.key)
+	if !store.Has(types.CurrentPriceKey(marketID)) {
+		return k.getTWAPPrice(ctx, marketID)
+	}
+	if k.IsMarketHalted(ctx, marketID) {
+		return types.CurrentPrice{}, sdkerrors.Wrap(types.ErrMarketHalted, marketID)
+	}
+	return k.getCurrentPrice(ctx, marketID)
+}
+
+// getCurrentPrice fetches the stored current price of a market, including the last accepted price of a halted market
+func (k Keeper) getCurrentPrice(ctx sdk.Context, marketID string) (types.CurrentPrice, error) {
 	store := ctx.KVStore(k.key)
 	bz := store.Get(types.CurrentPriceKey(marketID))
 
@@ -216,13 +432,17 @@ func (k Keeper) IterateCurrentPrices(ctx sdk.Context, cb func(cp types.CurrentPr
 	}
 }
 
-// GetCurrentPrices returns all current price objects from the store
+// GetCurrentPrices returns all current price objects from the store, including time weighted average prices
 func (k Keeper) GetCurrentPrices(ctx sdk.Context) types.CurrentPrices {
 	cps := types.CurrentPrices{}
 	k.IterateCurrentPrices(ctx, func(cp types.CurrentPrice) (stop bool) {
 		cps = append(cps, cp)
 		return false
 	})
+	k.IterateTWAPPrices(ctx, func(cp types.CurrentPrice) (stop bool) {
+		cps = append(cps, cp)
+		return false
+	})
 	return cps
 }
 
@@ -240,3 +460,65 @@ func (k Keeper) GetRawPrices(ctx sdk.Context, marketID string) (types.PostedPric
 	}
 	return prices, nil
 }
+
+// IterateRawPrices iterates over the prices posted by oracles for all markets in the store and performs a callback function
+func (k Keeper) IterateRawPrices(ctx sdk.Context, cb func(marketID string, prices types.PostedPrices) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.RawPriceFeedPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var prices types.PostedPrices
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &prices)
+		if cb(string(iterator.Key()), prices) {
+			break
+		}
+	}
+}
+
+// PruneRawPrices deletes the prices posted for markets that no longer exist, and by addresses that are no longer oracles of their market
+func (k Keeper) PruneRawPrices(ctx sdk.Context) {
+	oracles := make(map[string][]sdk.AccAddress)
+	for _, market := range k.GetMarkets(ctx) {
+		oracles[market.MarketID] = market.Oracles
+	}
+
+	pruned := make(map[string]types.PostedPrices)
+	k.IterateRawPrices(ctx, func(marketID string, prices types.PostedPrices) (stop bool) {
+		marketOracles, found := oracles[marketID]
+		var remaining types.PostedPrices
+		for _, p := range prices {
+			if found && containsAddress(marketOracles, p.OracleAddress) {
+				remaining = append(remaining, p)
+			}
+		}
+		if len(remaining) != len(prices) {
+			pruned[marketID] = remaining
+		}
+		return false
+	})
+
+	// sort the market ids so the store is written to in a deterministic order
+	marketIDs := make([]string, 0, len(pruned))
+	for marketID := range pruned {
+		marketIDs = append(marketIDs, marketID)
+	}
+	sort.Strings(marketIDs)
+
+	store := ctx.KVStore(k.key)
+	for _, marketID := range marketIDs {
+		if len(pruned[marketID]) == 0 {
+			store.Delete(types.RawPriceKey(marketID))
+			continue
+		}
+		store.Set(types.RawPriceKey(marketID), k.cdc.MustMarshalBinaryBare(pruned[marketID]))
+	}
+}
+
+func containsAddress(addresses []sdk.AccAddress, address sdk.AccAddress) bool {
+	for _, a := range addresses {
+		if a.Equals(address) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -11,6 +12,8 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/kava-labs/kava/x/pricefeed/keeper"
 	"github.com/kava-labs/kava/x/pricefeed/types"
 )
 
@@ -140,3 +143,486 @@ func TestKeeper_GetSetCurrentPrice(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, price.Price.Equal(sdk.MustNewDecFromStr("0.345")), true)
 }
+
+func TestKeeper_GetCurrentPrice_TWAP(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	startTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true, TWAPWindows: []time.Duration{10 * time.Minute}},
+		},
+	}
+	keeper.SetParams(ctx, mp)
+
+	postPrice := func(minutes int, price string) {
+		ctx = ctx.WithBlockTime(startTime.Add(time.Duration(minutes) * time.Minute))
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	}
+	requireTWAP := func(expected string) {
+		price, err := keeper.GetCurrentPrice(ctx, "tstusd:twap10")
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr(expected), price.Price)
+	}
+
+	// there is no average until the prices cover the whole window
+	postPrice(0, "10")
+	_, err := keeper.GetCurrentPrice(ctx, "tstusd:twap10")
+	require.Error(t, err)
+	postPrice(5, "20")
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd:twap10")
+	require.Error(t, err)
+
+	postPrice(10, "20")
+	requireTWAP("15")
+
+	// prices before the window are not used, apart from the price at the start of the window
+	postPrice(15, "20")
+	requireTWAP("20")
+	postPrice(20, "20")
+	requireTWAP("20")
+	require.Len(t, keeper.GetPriceRecords(ctx), 4)
+
+	// twap markets have no price once the market's prices expire
+	ctx = ctx.WithBlockTime(startTime.Add(2 * time.Hour))
+	require.Error(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd:twap10")
+	require.Error(t, err)
+
+	// the price history is kept across the gap, so the average resumes from the last valid price rather than the new spot price
+	postPrice(120, "30")
+	requireTWAP("20")
+	postPrice(125, "30")
+	requireTWAP("25")
+
+	// twap markets have no price once their window is removed from the market
+	mp.Markets[0].TWAPWindows = nil
+	keeper.SetParams(ctx, mp)
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd:twap10")
+	require.Error(t, err)
+}
+
+func TestKeeper_SetCurrentPrices_Guards(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	startTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: types.Markets{
+			types.Market{
+				MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true,
+				MaxPriceDeviation: sdk.MustNewDecFromStr("0.1"), DeviationPeriod: time.Hour, MinOracles: 2,
+				TWAPWindows: []time.Duration{time.Hour},
+			},
+		},
+	}
+	keeper.SetParams(ctx, mp)
+
+	postPrice := func(oracle int, price string, expiry time.Duration) {
+		_, err := keeper.SetPrice(ctx, addrs[oracle], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(expiry))
+		require.NoError(t, err)
+	}
+	requirePrice := func(expected string) {
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+		require.False(t, keeper.IsMarketHalted(ctx, "tstusd"))
+		price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+		require.NoError(t, err)
+		require.Equal(t, sdk.MustNewDecFromStr(expected), price.Price)
+	}
+	requireHalted := func() {
+		require.True(t, errors.Is(keeper.SetCurrentPrices(ctx, "tstusd"), types.ErrMarketHalted))
+		require.True(t, keeper.IsMarketHalted(ctx, "tstusd"))
+		_, err := keeper.GetCurrentPrice(ctx, "tstusd")
+		require.Error(t, err)
+		_, err = keeper.GetCurrentPrice(ctx, "tstusd:twap60")
+		require.Error(t, err)
+	}
+
+	// prices need a quorum of oracles
+	postPrice(0, "10", 24*time.Hour)
+	requireHalted()
+	postPrice(1, "10", 2*time.Hour)
+	requirePrice("10")
+
+	// prices can change by 10% an hour
+	ctx = ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	postPrice(0, "11", 24*time.Hour)
+	postPrice(1, "11", 2*time.Hour)
+	requireHalted()
+	_, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.True(t, errors.Is(err, types.ErrMarketHalted))
+	_, err = keeper.GetCurrentPrice(ctx, "tstusd:twap60")
+	require.True(t, errors.Is(err, types.ErrMarketHalted))
+
+	// halted markets resume once enough time has passed for the change to be allowed
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	requirePrice("11")
+
+	// prices are halted when oracle prices expire below the quorum
+	ctx = ctx.WithBlockTime(startTime.Add(3 * time.Hour))
+	requireHalted()
+}
+
+func TestKeeper_UpdateOracleStats(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	startTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+		OracleParams: types.NewOracleParams(time.Hour, sdk.MustNewDecFromStr("0.1"), 2, 24*time.Hour, false),
+	}
+	keeper.SetParams(ctx, mp)
+
+	postPrice := func(oracle int, price string) {
+		_, err := keeper.SetPrice(ctx, addrs[oracle], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(2*time.Hour))
+		require.NoError(t, err)
+	}
+	endBlock := func(offset time.Duration) {
+		ctx = ctx.WithBlockTime(startTime.Add(offset))
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+		keeper.UpdateOracleStats(ctx)
+	}
+	stats := func(oracle int) types.OracleStats {
+		s, found := keeper.GetOracleStats(ctx, "tstusd", addrs[oracle])
+		require.True(t, found)
+		return s
+	}
+
+	// the third oracle's price deviates from the median in the first window
+	postPrice(0, "10")
+	postPrice(1, "10.5")
+	postPrice(2, "20")
+	endBlock(0)
+	endBlock(time.Hour)
+	require.Equal(t, uint64(1), stats(0).Posts)
+	require.Equal(t, sdk.ZeroDec(), stats(1).LastDeviation)
+	require.Equal(t, uint64(0), stats(0).ConsecutiveFaults)
+	require.Equal(t, uint64(1), stats(2).DeviationFaults)
+	require.Equal(t, uint64(1), stats(2).ConsecutiveFaults)
+
+	// and misses the second window, so it is jailed
+	postPrice(0, "10")
+	postPrice(1, "10.5")
+	endBlock(2 * time.Hour)
+	require.Equal(t, uint64(2), stats(0).Posts)
+	require.Equal(t, uint64(1), stats(2).MissedWindows)
+	require.True(t, keeper.IsOracleJailed(ctx, "tstusd", addrs[2]))
+	require.Equal(t, startTime.Add(26*time.Hour), stats(2).JailedUntil)
+	require.Len(t, keeper.GetMarketOracleStats(ctx, "tstusd"), 3)
+
+	_, err := keeper.SetPrice(ctx, addrs[2], "tstusd", sdk.OneDec(), ctx.BlockTime().Add(time.Hour))
+	require.True(t, errors.Is(err, types.ErrOracleJailed))
+	// prices from jailed oracles are not used
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("10.25"), price.Price)
+
+	// penalized oracles can instead be removed from the market
+	mp.OracleParams = types.NewOracleParams(time.Hour, sdk.MustNewDecFromStr("0.1"), 2, 0, true)
+	keeper.SetParams(ctx, mp)
+	postPrice(0, "10")
+	endBlock(3 * time.Hour)
+	postPrice(0, "10")
+	endBlock(4 * time.Hour)
+	require.Equal(t, uint64(2), stats(1).MissedWindows)
+	require.True(t, stats(1).Removed)
+	require.True(t, keeper.IsOracleJailed(ctx, "tstusd", addrs[1]))
+	_, err = keeper.SetPrice(ctx, addrs[1], "tstusd", sdk.OneDec(), ctx.BlockTime().Add(time.Hour))
+	require.True(t, errors.Is(err, types.ErrOracleJailed))
+	// removed oracles are left in the params for governance to remove
+	oracles, err := keeper.GetOracles(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, addrs, oracles)
+	rawPrices, err := keeper.GetRawPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	for _, rp := range rawPrices {
+		require.False(t, rp.OracleAddress.Equals(addrs[1]))
+	}
+
+	// stats are pruned once the oracle is no longer in the market, so it starts again if it is added back
+	mp.Markets[0].Oracles = addrs[:1]
+	keeper.SetParams(ctx, mp)
+	keeper.PruneOracleStats(ctx)
+	_, found := keeper.GetOracleStats(ctx, "tstusd", addrs[1])
+	require.False(t, found)
+	_, found = keeper.GetOracleStats(ctx, "tstusd", addrs[0])
+	require.True(t, found)
+	mp.Markets[0].Oracles = addrs
+	keeper.SetParams(ctx, mp)
+	require.False(t, keeper.IsOracleJailed(ctx, "tstusd", addrs[1]))
+}
+
+func TestKeeper_UpdateOracleStats_ExpiredPrice(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	tApp := app.NewTestApp()
+	startTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+		OracleParams: types.NewOracleParams(time.Hour, sdk.MustNewDecFromStr("0.1"), 2, 24*time.Hour, false),
+	}
+	keeper.SetParams(ctx, mp)
+
+	// the third oracle posts a deviating price that expires before the window ends
+	_, err := keeper.SetPrice(ctx, addrs[2], "tstusd", sdk.MustNewDecFromStr("20"), startTime.Add(30*time.Minute))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(startTime)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	keeper.UpdateOracleStats(ctx)
+
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	for _, oracle := range addrs[:2] {
+		_, err := keeper.SetPrice(ctx, oracle, "tstusd", sdk.MustNewDecFromStr("10"), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+	}
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	keeper.UpdateOracleStats(ctx)
+
+	// the expired price is not part of the median, so it is not a deviation fault
+	stats, found := keeper.GetOracleStats(ctx, "tstusd", addrs[2])
+	require.True(t, found)
+	require.Equal(t, uint64(0), stats.DeviationFaults)
+	require.Equal(t, uint64(0), stats.ConsecutiveFaults)
+}
+
+func TestKeeper_SetCurrentPrices_Derived(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: time.Now().UTC()})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Active: true, Derivation: types.NewDerivation(types.DerivationMultiply, "btc:bnb", "bnb:usd")},
+			types.Market{MarketID: "usd:btc", BaseAsset: "usd", QuoteAsset: "btc", Active: true, Derivation: types.NewDerivation(types.DerivationInverse, "btc:usd")},
+			types.Market{MarketID: "btc:bnb", BaseAsset: "btc", QuoteAsset: "bnb", Oracles: addrs, Active: true},
+			types.Market{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+	}
+	keeper.SetParams(ctx, mp)
+
+	// derived markets have no price until their inputs do
+	pricefeed.EndBlocker(ctx, keeper)
+	_, err := keeper.GetCurrentPrice(ctx, "btc:usd")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+
+	_, err = keeper.SetPrice(ctx, addrs[0], "btc:bnb", sdk.MustNewDecFromStr("1000"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	_, err = keeper.SetPrice(ctx, addrs[0], "bnb:usd", sdk.MustNewDecFromStr("40"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+
+	// derived prices are calculated after their inputs in the same block
+	pricefeed.EndBlocker(ctx, keeper)
+	price, err := keeper.GetCurrentPrice(ctx, "btc:usd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("40000"), price.Price)
+	price, err = keeper.GetCurrentPrice(ctx, "usd:btc")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("0.000025"), price.Price)
+
+	// derived prices are invalid when an input price expires
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	pricefeed.EndBlocker(ctx, keeper)
+	_, err = keeper.GetCurrentPrice(ctx, "btc:usd")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+	_, err = keeper.GetCurrentPrice(ctx, "usd:btc")
+	require.True(t, errors.Is(err, types.ErrNoValidPrice))
+}
+
+func TestKeeper_PriceHistory(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	startTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: startTime})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+		PriceHistoryRetention: 2 * time.Hour,
+	}
+	keeper.SetParams(ctx, mp)
+
+	// record a price every hour
+	for i := 0; i < 4; i++ {
+		ctx = ctx.WithBlockHeight(int64(i + 1)).WithBlockTime(startTime.Add(time.Duration(i) * time.Hour))
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.NewDec(int64(10+i)), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	}
+
+	// prices older than the retention period are pruned
+	history := keeper.GetPriceHistory(ctx, types.NewQueryPriceHistoryParams("tstusd", time.Time{}, time.Time{}, 0, 0, 1, 0))
+	require.Equal(t, types.PriceRecords{
+		types.NewPriceRecord("tstusd", sdk.NewDec(11), 2, startTime.Add(time.Hour)),
+		types.NewPriceRecord("tstusd", sdk.NewDec(12), 3, startTime.Add(2*time.Hour)),
+		types.NewPriceRecord("tstusd", sdk.NewDec(13), 4, startTime.Add(3*time.Hour)),
+	}, history)
+
+	// ranges include their start and end times and heights
+	history = keeper.GetPriceHistory(ctx, types.NewQueryPriceHistoryParams("tstusd", startTime.Add(2*time.Hour), startTime.Add(2*time.Hour), 0, 0, 1, 0))
+	require.Equal(t, types.PriceRecords{
+		types.NewPriceRecord("tstusd", sdk.NewDec(12), 3, startTime.Add(2*time.Hour)),
+	}, history)
+	require.Len(t, keeper.GetPriceHistory(ctx, types.NewQueryPriceHistoryParams("tstusd", startTime.Add(90*time.Minute), time.Time{}, 0, 0, 1, 0)), 2)
+	history = keeper.GetPriceHistory(ctx, types.NewQueryPriceHistoryParams("tstusd", time.Time{}, time.Time{}, 3, 3, 1, 0))
+	require.Equal(t, types.PriceRecords{
+		types.NewPriceRecord("tstusd", sdk.NewDec(12), 3, startTime.Add(2*time.Hour)),
+	}, history)
+	require.Len(t, keeper.GetPriceHistory(ctx, types.NewQueryPriceHistoryParams("tstusd", time.Time{}, time.Time{}, 3, 0, 1, 0)), 2)
+	require.Len(t, keeper.GetPriceHistory(ctx, types.NewQueryPriceHistoryParams("other", time.Time{}, time.Time{}, 0, 0, 1, 0)), 0)
+
+	// results are paginated
+	history = keeper.GetPriceHistory(ctx, types.NewQueryPriceHistoryParams("tstusd", time.Time{}, time.Time{}, 0, 0, 2, 2))
+	require.Equal(t, types.PriceRecords{
+		types.NewPriceRecord("tstusd", sdk.NewDec(13), 4, startTime.Add(3*time.Hour)),
+	}, history)
+	require.Len(t, keeper.GetPriceHistory(ctx, types.NewQueryPriceHistoryParams("tstusd", time.Time{}, time.Time{}, 0, 0, 1, 2)), 2)
+	require.Len(t, keeper.GetPriceHistory(ctx, types.NewQueryPriceHistoryParams("tstusd", time.Time{}, time.Time{}, 0, 0, 3, 2)), 0)
+
+	// no history is kept without a retention period
+	mp.PriceHistoryRetention = 0
+	keeper.SetParams(ctx, mp)
+	require.NoError(t, keeper.SetCurrentPrices(ctx, "tstusd"))
+	require.Len(t, keeper.GetPriceHistory(ctx, types.NewQueryPriceHistoryParams("tstusd", time.Time{}, time.Time{}, 0, 0, 1, 0)), 0)
+}
+
+func TestKeeper_PruneRawPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: time.Now().UTC()})
+	pk := tApp.GetPriceFeedKeeper()
+
+	mp := types.Params{
+		Markets: types.Markets{
+			types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true},
+			types.Market{MarketID: "tst2usd", BaseAsset: "tst2", QuoteAsset: "usd", Oracles: addrs, Active: true},
+		},
+	}
+	pk.SetParams(ctx, mp)
+	for _, marketID := range []string{"tstusd", "tst2usd"} {
+		for _, addr := range addrs {
+			_, err := pk.SetPrice(ctx, addr, marketID, sdk.OneDec(), ctx.BlockTime().Add(time.Hour))
+			require.NoError(t, err)
+		}
+	}
+	_, broken := keeper.ValidRawPricesInvariant(pk)(ctx)
+	require.False(t, broken)
+
+	// remove an oracle from the first market and remove the second market
+	mp.Markets = types.Markets{
+		types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs[:1], Active: true},
+	}
+	pk.SetParams(ctx, mp)
+	_, broken = keeper.ValidRawPricesInvariant(pk)(ctx)
+	require.True(t, broken)
+
+	pk.PruneRawPrices(ctx)
+	_, broken = keeper.ValidRawPricesInvariant(pk)(ctx)
+	require.False(t, broken)
+	rawPrices, err := pk.GetRawPrices(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Len(t, rawPrices, 1)
+	require.Equal(t, addrs[0], rawPrices[0].OracleAddress)
+	rawPrices, err = pk.GetRawPrices(ctx, "tst2usd")
+	require.NoError(t, err)
+	require.Empty(t, rawPrices)
+}
+
+func TestKeeper_SetCurrentPrices_Resume(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	market := types.Market{
+		MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true,
+		MaxPriceDeviation: sdk.MustNewDecFromStr("0.1"), ResumeUpdates: 2,
+	}
+	keeper.SetParams(ctx, types.Params{Markets: types.Markets{market}})
+
+	setPrice := func(price string) error {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+		_, err := keeper.SetPrice(ctx, addrs[0], "tstusd", sdk.MustNewDecFromStr(price), ctx.BlockTime().Add(time.Hour))
+		require.NoError(t, err)
+		return keeper.SetCurrentPrices(ctx, "tstusd")
+	}
+
+	require.NoError(t, setPrice("10"))
+
+	// a large move halts the market, and inconsistent prices restart the count
+	require.True(t, errors.Is(setPrice("20"), types.ErrMarketHalted))
+	// recalculating the price without a new post is not counted as an update
+	for i := 0; i < 3; i++ {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+		require.True(t, errors.Is(keeper.SetCurrentPrices(ctx, "tstusd"), types.ErrMarketHalted))
+	}
+	status, found := keeper.GetMarketStatus(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, uint64(1), status.PendingUpdates)
+	require.True(t, errors.Is(setPrice("30"), types.ErrMarketHalted))
+	require.True(t, errors.Is(setPrice("30.5"), types.ErrMarketHalted))
+	status, found = keeper.GetMarketStatus(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, uint64(2), status.PendingUpdates)
+
+	// the market resumes after the resume updates consistent prices following the halting one
+	require.NoError(t, setPrice("31"))
+	require.False(t, keeper.IsMarketHalted(ctx, "tstusd"))
+	price, err := keeper.GetCurrentPrice(ctx, "tstusd")
+	require.NoError(t, err)
+	require.Equal(t, sdk.MustNewDecFromStr("31"), price.Price)
+
+	// the accepted price is the new reference for the guards
+	status, found = keeper.GetMarketStatus(ctx, "tstusd")
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("31"), status.LastPrice)
+	require.Zero(t, status.PendingUpdates)
+	require.NoError(t, setPrice("32"))
+}
+
+func TestKeeper_ClearMarketPrices(t *testing.T) {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Time: time.Now().UTC()})
+	pk := tApp.GetPriceFeedKeeper()
+
+	market := types.Market{MarketID: "tstusd", BaseAsset: "tst", QuoteAsset: "usd", Oracles: addrs, Active: true, TWAPWindows: []time.Duration{10 * time.Minute}}
+	pk.SetParams(ctx, types.Params{Markets: types.Markets{market}})
+	_, err := pk.SetPrice(ctx, addrs[0], "tstusd", sdk.OneDec(), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	require.NoError(t, pk.SetCurrentPrices(ctx, "tstusd"))
+	_, broken := keeper.InactiveMarketsInvariant(pk)(ctx)
+	require.False(t, broken)
+
+	// deactivating the market leaves a stale price until it is cleared
+	market.Active = false
+	pk.SetParams(ctx, types.Params{Markets: types.Markets{market}})
+	_, broken = keeper.InactiveMarketsInvariant(pk)(ctx)
+	require.True(t, broken)
+
+	pk.ClearMarketPrices(ctx, market)
+	_, broken = keeper.InactiveMarketsInvariant(pk)(ctx)
+	require.False(t, broken)
+	_, err = pk.GetCurrentPrice(ctx, "tstusd")
+	require.Error(t, err)
+	_, err = pk.GetCurrentPrice(ctx, "tstusd:twap10")
+	require.Error(t, err)
+}
@@ -0,0 +1,256 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// GetOracleStats returns the stats of an oracle in a market
+func (k Keeper) GetOracleStats(ctx sdk.Context, marketID string, oracle sdk.AccAddress) (types.OracleStats, bool) {
+	store := ctx.KVStore(k.key)
+	bz := store.Get(types.OracleStatsKey(marketID, oracle))
+	if bz == nil {
+		return types.OracleStats{}, false
+	}
+	var stats types.OracleStats
+	k.cdc.MustUnmarshalBinaryBare(bz, &stats)
+	return stats, true
+}
+
+// SetOracleStats stores the stats of an oracle in a market
+func (k Keeper) SetOracleStats(ctx sdk.Context, stats types.OracleStats) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.OracleStatsKey(stats.MarketID, stats.OracleAddress), k.cdc.MustMarshalBinaryBare(stats))
+}
+
+// IterateOracleStats iterates over the stats of all oracles in a market and performs a callback function
+func (k Keeper) IterateOracleStats(ctx sdk.Context, marketID string, cb func(stats types.OracleStats) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OracleStatsKeyPrefix(marketID))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStats
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// GetMarketOracleStats returns the stats of all oracles in a market
+func (k Keeper) GetMarketOracleStats(ctx sdk.Context, marketID string) types.OracleStatsList {
+	statsList := types.OracleStatsList{}
+	k.IterateOracleStats(ctx, marketID, func(stats types.OracleStats) (stop bool) {
+		statsList = append(statsList, stats)
+		return false
+	})
+	return statsList
+}
+
+// GetAllOracleStats returns the stats of all oracles in all markets
+func (k Keeper) GetAllOracleStats(ctx sdk.Context) types.OracleStatsList {
+	statsList := types.OracleStatsList{}
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OracleStatsPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStats
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stats)
+		statsList = append(statsList, stats)
+	}
+	return statsList
+}
+
+// IsOracleJailed returns true if an oracle has been jailed from posting prices in a market
+func (k Keeper) IsOracleJailed(ctx sdk.Context, marketID string, oracle sdk.AccAddress) bool {
+	stats, found := k.GetOracleStats(ctx, marketID, oracle)
+	return found && stats.IsJailed(ctx.BlockTime())
+}
+
+// recordOraclePost counts a price posted by an oracle in its stats
+func (k Keeper) recordOraclePost(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	stats, found := k.GetOracleStats(ctx, marketID, oracle)
+	if !found {
+		stats = types.NewOracleStats(marketID, oracle)
+	}
+	stats.Posts++
+	stats.LastPostTime = ctx.BlockTime()
+	k.SetOracleStats(ctx, stats)
+}
+
+// UpdateOracleStats checks the performance of the oracles of each active market at the end of every oracle window.
+// Oracles that did not post a price in the window, or whose price deviated too far from the market's median price, are faulted.
+// Oracles that reach the maximum number of consecutive faults are penalized. Returns true if any oracle was penalized.
+func (k Keeper) UpdateOracleStats(ctx sdk.Context) bool {
+	oracleParams := k.GetOracleParams(ctx)
+	if oracleParams.Window == 0 {
+		return false
+	}
+	windowStart, found := k.getOracleWindowStart(ctx)
+	if !found {
+		k.setOracleWindowStart(ctx, ctx.BlockTime())
+		return false
+	}
+	if ctx.BlockTime().Before(windowStart.Add(oracleParams.Window)) {
+		return false
+	}
+
+	penalized := false
+	for _, market := range k.GetMarkets(ctx) {
+		if !market.Active {
+			continue
+		}
+		if k.updateMarketOracleStats(ctx, market, oracleParams, windowStart) {
+			penalized = true
+		}
+	}
+	k.setOracleWindowStart(ctx, ctx.BlockTime())
+	return penalized
+}
+
+func (k Keeper) updateMarketOracleStats(ctx sdk.Context, market types.Market, oracleParams types.OracleParams, windowStart time.Time) bool {
+	median, err := k.getCurrentPrice(ctx, market.MarketID)
+	validMedian := err == nil
+	rawPrices, err := k.GetRawPrices(ctx, market.MarketID)
+	if err != nil {
+		panic(err)
+	}
+
+	var penalized []types.OracleStats
+	for _, oracle := range market.Oracles {
+		stats, found := k.GetOracleStats(ctx, market.MarketID, oracle)
+		if !found {
+			stats = types.NewOracleStats(market.MarketID, oracle)
+		}
+		if stats.IsJailed(ctx.BlockTime()) {
+			continue
+		}
+
+		faulted := false
+		if stats.LastPostTime.Before(windowStart) {
+			stats.MissedWindows++
+			faulted = true
+		} else if validMedian {
+			for _, rp := range rawPrices {
+				// expired prices are not part of the median, so they are not compared against it
+				if !rp.OracleAddress.Equals(oracle) || !rp.Expiry.After(ctx.BlockTime()) {
+					continue
+				}
+				stats.LastDeviation = rp.Price.Sub(median.Price).Abs().Quo(median.Price)
+				if !oracleParams.MaxDeviation.IsNil() && oracleParams.MaxDeviation.IsPositive() && stats.LastDeviation.GT(oracleParams.MaxDeviation) {
+					stats.DeviationFaults++
+					faulted = true
+				}
+			}
+		}
+
+		if faulted {
+			stats.ConsecutiveFaults++
+		} else {
+			stats.ConsecutiveFaults = 0
+		}
+		if oracleParams.MaxConsecutiveFaults > 0 && stats.ConsecutiveFaults >= oracleParams.MaxConsecutiveFaults {
+			stats.ConsecutiveFaults = 0
+			if oracleParams.JailDuration > 0 {
+				stats.JailedUntil = ctx.BlockTime().Add(oracleParams.JailDuration)
+			}
+			penalized = append(penalized, stats)
+		}
+		k.SetOracleStats(ctx, stats)
+	}
+
+	for _, stats := range penalized {
+		removed := oracleParams.RemoveOracles && k.removeOracle(ctx, market, stats)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeOraclePenalized,
+				sdk.NewAttribute(types.AttributeMarketID, market.MarketID),
+				sdk.NewAttribute(types.AttributeOracle, stats.OracleAddress.String()),
+				sdk.NewAttribute(types.AttributeJailedUntil, stats.JailedUntil.UTC().String()),
+				sdk.NewAttribute(types.AttributeRemoved, strconv.FormatBool(removed)),
+			),
+		)
+	}
+	return len(penalized) > 0
+}
+
+// removeOracle marks an oracle as removed in its stats and deletes its posted price. The market's params are not changed, so the oracle stays
+// listed until governance removes it, which prunes its stats. Oracles are not removed if the market would be left with fewer oracles than its minimum.
+func (k Keeper) removeOracle(ctx sdk.Context, market types.Market, stats types.OracleStats) bool {
+	var remaining uint64
+	for _, oracle := range market.Oracles {
+		if oracle.Equals(stats.OracleAddress) {
+			continue
+		}
+		if oracleStats, found := k.GetOracleStats(ctx, market.MarketID, oracle); !found || !oracleStats.Removed {
+			remaining++
+		}
+	}
+	if remaining < market.MinOracles {
+		return false
+	}
+	stats.Removed = true
+	k.SetOracleStats(ctx, stats)
+	k.deleteRawPrice(ctx, market.MarketID, stats.OracleAddress)
+	return true
+}
+
+// PruneOracleStats deletes the stats of oracles that are no longer oracles of their market, or of markets that have been removed.
+// Oracles that are added back to a market start with new stats.
+func (k Keeper) PruneOracleStats(ctx sdk.Context) {
+	oracles := make(map[string][]sdk.AccAddress)
+	for _, market := range k.GetMarkets(ctx) {
+		oracles[market.MarketID] = market.Oracles
+	}
+
+	var pruned [][]byte
+	store := prefix.NewStore(ctx.KVStore(k.key), types.OracleStatsPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.OracleStats
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &stats)
+		marketOracles, found := oracles[stats.MarketID]
+		if !found || !containsAddress(marketOracles, stats.OracleAddress) {
+			pruned = append(pruned, iterator.Key())
+		}
+	}
+	for _, key := range pruned {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) deleteRawPrice(ctx sdk.Context, marketID string, oracle sdk.AccAddress) {
+	prices, err := k.GetRawPrices(ctx, marketID)
+	if err != nil {
+		panic(err)
+	}
+	var remaining types.PostedPrices
+	for _, p := range prices {
+		if !p.OracleAddress.Equals(oracle) {
+			remaining = append(remaining, p)
+		}
+	}
+	ctx.KVStore(k.key).Set(types.RawPriceKey(marketID), k.cdc.MustMarshalBinaryBare(remaining))
+}
+
+func (k Keeper) getOracleWindowStart(ctx sdk.Context) (time.Time, bool) {
+	bz := ctx.KVStore(k.key).Get(types.OracleWindowStartKey)
+	if bz == nil {
+		return time.Time{}, false
+	}
+	windowStart, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		panic(err)
+	}
+	return windowStart, true
+}
+
+func (k Keeper) setOracleWindowStart(ctx sdk.Context, windowStart time.Time) {
+	ctx.KVStore(k.key).Set(types.OracleWindowStartKey, sdk.FormatTimeBytes(windowStart))
+}
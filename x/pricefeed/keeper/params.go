@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -24,6 +26,16 @@ func (k Keeper) GetMarkets(ctx sdk.Context) types.Markets {
 	return k.GetParams(ctx).Markets
 }
 
+// GetOracleParams returns the oracle performance params from params
+func (k Keeper) GetOracleParams(ctx sdk.Context) types.OracleParams {
+	return k.GetParams(ctx).OracleParams
+}
+
+// GetPriceHistoryRetention returns how long the median prices of markets are kept for
+func (k Keeper) GetPriceHistoryRetention(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).PriceHistoryRetention
+}
+
 // GetOracles returns the oracles in the pricefeed store
 func (k Keeper) GetOracles(ctx sdk.Context, marketID string) ([]sdk.AccAddress, error) {
 	for _, m := range k.GetMarkets(ctx) {
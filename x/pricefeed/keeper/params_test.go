@@ -38,7 +38,7 @@ func (suite *KeeperTestSuite) SetupTest() {
 
 func (suite *KeeperTestSuite) TestGetSetOracles() {
 	params := suite.keeper.GetParams(suite.ctx)
-	suite.Equal([]sdk.AccAddress(nil), params.Markets[0].Oracles)
+	suite.Equal([]sdk.AccAddress{oracle}, params.Markets[0].Oracles)
 
 	params.Markets[0].Oracles = suite.addrs
 	suite.NotPanics(func() { suite.keeper.SetParams(suite.ctx, params) })
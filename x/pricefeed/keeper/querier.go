@@ -22,6 +22,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryRawPrices(ctx, req, keeper)
 		case types.QueryOracles:
 			return queryOracles(ctx, req, keeper)
+		case types.QueryOracleStats:
+			return queryOracleStats(ctx, req, keeper)
+		case types.QueryPriceHistory:
+			return queryPriceHistory(ctx, req, keeper)
 		case types.QueryMarkets:
 			return queryMarkets(ctx, req, keeper)
 		case types.QueryGetParams:
@@ -108,6 +112,46 @@ func queryOracles(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []
 	return bz, nil
 }
 
+func queryOracleStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
+	var requestParams types.QueryWithMarketIDParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	_, found := keeper.GetMarket(ctx, requestParams.MarketID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+	}
+
+	stats := keeper.GetMarketOracleStats(ctx, requestParams.MarketID)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, stats)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryPriceHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
+	var requestParams types.QueryPriceHistoryParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+	_, found := keeper.GetMarket(ctx, requestParams.MarketID)
+	if !found {
+		return nil, sdkerrors.Wrap(types.ErrAssetNotFound, requestParams.MarketID)
+	}
+
+	history := keeper.GetPriceHistory(ctx, requestParams)
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, history)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
 func queryMarkets(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) (res []byte, sdkErr error) {
 	markets := keeper.GetMarkets(ctx)
 
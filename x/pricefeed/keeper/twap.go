@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// SetPriceRecord stores the current price of a market at a point in time
+func (k Keeper) SetPriceRecord(ctx sdk.Context, record types.PriceRecord) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.PriceRecordKey(record.MarketID, record.Time), k.cdc.MustMarshalBinaryBare(record))
+}
+
+// IteratePriceRecords iterates over all price records in the store and performs a callback function
+func (k Keeper) IteratePriceRecords(ctx sdk.Context, cb func(record types.PriceRecord) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceRecordPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.PriceRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// GetPriceRecords returns all price records from the store
+func (k Keeper) GetPriceRecords(ctx sdk.Context) types.PriceRecords {
+	records := types.PriceRecords{}
+	k.IteratePriceRecords(ctx, func(record types.PriceRecord) (stop bool) {
+		records = append(records, record)
+		return false
+	})
+	return records
+}
+
+// CalculateTWAP returns the time weighted average of a market's recorded prices over a window ending at the current block time.
+// Each price is weighted by how long it was the current price, and the last recorded price carries over any time the market had no valid price.
+// No average is returned until the records cover the whole window, so a new market's first prices cannot set its average on their own.
+func (k Keeper) CalculateTWAP(ctx sdk.Context, marketID string, window time.Duration) (sdk.Dec, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceRecordKeyPrefix(marketID))
+	now := ctx.BlockTime()
+	windowStart := sdk.FormatTimeBytes(now.Add(-window))
+
+	var (
+		sum       = sdk.ZeroDec()
+		start     time.Time
+		lastPrice sdk.Dec
+		lastTime  time.Time
+		found     bool
+	)
+
+	// the price at the start of the window is the last one recorded before it
+	reverseIterator := store.ReverseIterator(nil, windowStart)
+	if reverseIterator.Valid() {
+		var record types.PriceRecord
+		k.cdc.MustUnmarshalBinaryBare(reverseIterator.Value(), &record)
+		start, lastPrice, lastTime, found = now.Add(-window), record.Price, now.Add(-window), true
+	}
+	reverseIterator.Close()
+
+	iterator := store.Iterator(windowStart, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.PriceRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		if record.Time.After(now) {
+			break
+		}
+		if found {
+			sum = sum.Add(lastPrice.MulInt64(int64(record.Time.Sub(lastTime))))
+		} else {
+			start, found = record.Time, true
+		}
+		lastPrice, lastTime = record.Price, record.Time
+	}
+	if !found || start.After(now.Add(-window)) {
+		return sdk.Dec{}, false
+	}
+
+	sum = sum.Add(lastPrice.MulInt64(int64(now.Sub(lastTime))))
+	return sum.QuoInt64(int64(window)), true
+}
+
+// setTWAPPrices updates the time weighted average prices derived from a market, after its current price has been recorded.
+func (k Keeper) setTWAPPrices(ctx sdk.Context, market types.Market) {
+	k.deleteUnusedTWAPPrices(ctx, market)
+	for _, window := range market.TWAPWindows {
+		twapMarketID := types.TWAPMarketID(market.MarketID, window)
+		if twap, found := k.CalculateTWAP(ctx, market.MarketID, window); found {
+			k.setTWAPPrice(ctx, types.NewCurrentPrice(twapMarketID, twap))
+		}
+	}
+}
+
+// clearTWAPPrices removes the time weighted average prices derived from a market, so they are not used while it has no valid price.
+// The market's price records are kept, so averages resume across the gap once the market has a valid price again.
+func (k Keeper) clearTWAPPrices(ctx sdk.Context, market types.Market) {
+	store := ctx.KVStore(k.key)
+	for _, twapMarketID := range market.TWAPMarketIDs() {
+		store.Delete(types.TWAPPriceKey(twapMarketID))
+	}
+}
+
+// deleteUnusedTWAPPrices removes the prices of time weighted average price markets whose window has been removed from the market.
+func (k Keeper) deleteUnusedTWAPPrices(ctx sdk.Context, market types.Market) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TWAPPricePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(market.MarketID+types.TWAPMarketIDSeparator))
+	defer iterator.Close()
+
+	var unused [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		marketID, window, ok := types.ParseTWAPMarketID(string(iterator.Key()))
+		if ok && marketID == market.MarketID && !market.HasTWAPWindow(window) {
+			unused = append(unused, iterator.Key())
+		}
+	}
+	for _, key := range unused {
+		store.Delete(key)
+	}
+}
+
+// prunePriceRecords deletes a market's price records from before the window.
+// If keepStart is set the last record before the start of the window is kept, as it is the price at the start of a time weighted average price window.
+func (k Keeper) prunePriceRecords(ctx sdk.Context, marketID string, window time.Duration, keepStart bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.PriceRecordKeyPrefix(marketID))
+	end := sdk.FormatTimeBytes(ctx.BlockTime().Add(-window))
+	if window == 0 {
+		// without a window no records are kept
+		end = nil
+	}
+	iterator := store.ReverseIterator(nil, end)
+	defer iterator.Close()
+
+	var expired [][]byte
+	for keep := keepStart; iterator.Valid(); iterator.Next() {
+		if keep {
+			keep = false
+			continue
+		}
+		expired = append(expired, iterator.Key())
+	}
+	for _, key := range expired {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) setTWAPPrice(ctx sdk.Context, twapPrice types.CurrentPrice) {
+	store := ctx.KVStore(k.key)
+	store.Set(types.TWAPPriceKey(twapPrice.MarketID), k.cdc.MustMarshalBinaryBare(twapPrice))
+}
+
+// getTWAPPrice fetches the price of a time weighted average price market. Prices are only found for windows the market they are derived from still has,
+// and not while that market is halted.
+func (k Keeper) getTWAPPrice(ctx sdk.Context, twapMarketID string) (types.CurrentPrice, error) {
+	marketID, window, ok := types.ParseTWAPMarketID(twapMarketID)
+	if !ok {
+		return types.CurrentPrice{}, types.ErrNoValidPrice
+	}
+	market, found := k.GetMarket(ctx, marketID)
+	if !found || !market.HasTWAPWindow(window) {
+		return types.CurrentPrice{}, types.ErrNoValidPrice
+	}
+	if k.IsMarketHalted(ctx, marketID) {
+		return types.CurrentPrice{}, sdkerrors.Wrap(types.ErrMarketHalted, twapMarketID)
+	}
+	bz := ctx.KVStore(k.key).Get(types.TWAPPriceKey(twapMarketID))
+	if bz == nil {
+		return types.CurrentPrice{}, types.ErrNoValidPrice
+	}
+	var price types.CurrentPrice
+	k.cdc.MustUnmarshalBinaryBare(bz, &price)
+	return price, nil
+}
+
+// IterateTWAPPrices iterates over the prices of all time weighted average price markets in the store and performs a callback function
+func (k Keeper) IterateTWAPPrices(ctx sdk.Context, cb func(cp types.CurrentPrice) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.TWAPPricePrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var cp types.CurrentPrice
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &cp)
+		if cb(cp) {
+			break
+		}
+	}
+}
@@ -0,0 +1,27 @@
+package pricefeed
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// NewParamChangeProposalHandler wraps a param change proposal handler to prune the pricefeed store as soon as its params change,
+// so prices from removed oracles and the prices of inactive markets are not left until the end of the block.
+// The keeper is taken by pointer so the hooks set on it after the routers are built are called.
+func NewParamChangeProposalHandler(k *Keeper, handler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := handler(ctx, content); err != nil {
+			return err
+		}
+		if p, ok := content.(paramstypes.ParameterChangeProposal); ok {
+			for _, change := range p.Changes {
+				if change.Subspace == DefaultParamspace {
+					k.PruneMarkets(ctx)
+					break
+				}
+			}
+		}
+		return nil
+	}
+}
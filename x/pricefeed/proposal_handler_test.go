@@ -0,0 +1,54 @@
+package pricefeed_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/pricefeed"
+)
+
+func TestParamChangeProposalHandler_PrunesMarkets(t *testing.T) {
+	tApp := app.NewTestApp()
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	tApp.InitializeFromGenesisStates(NewPricefeedGenStateWithOracles(addrs))
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: time.Now().UTC()})
+	keeper := tApp.GetPriceFeedKeeper()
+
+	_, err := keeper.SetPrice(ctx, addrs[1], "btc:usd", sdk.MustNewDecFromStr("8000.00"), ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, err)
+	pricefeed.EndBlocker(ctx, keeper)
+	_, err = keeper.GetCurrentPrice(ctx, "xrp:usd")
+	require.NoError(t, err)
+
+	// deactivate a market and remove an oracle through gov's param change handler
+	markets := pricefeed.Markets{
+		{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: addrs[:1], Active: true},
+		{MarketID: "xrp:usd", BaseAsset: "xrp", QuoteAsset: "usd", Oracles: addrs, Active: false},
+	}
+	proposal := paramstypes.NewParameterChangeProposal("A Title", "A description of this proposal.", []paramstypes.ParamChange{
+		paramstypes.NewParamChange(pricefeed.ModuleName, string(pricefeed.KeyMarkets), string(pricefeed.ModuleCdc.MustMarshalJSON(markets))),
+	})
+	handler := tApp.GetGovKeeper().Router().GetRoute(params.RouterKey)
+	require.NoError(t, handler(ctx, proposal))
+
+	// the store is pruned without waiting for the end blocker
+	_, err = keeper.GetCurrentPrice(ctx, "xrp:usd")
+	require.Error(t, err)
+	_, broken := pricefeed.InactiveMarketsInvariant(keeper)(ctx)
+	require.False(t, broken)
+	_, broken = pricefeed.ValidRawPricesInvariant(keeper)(ctx)
+	require.False(t, broken)
+	rawPrices, err := keeper.GetRawPrices(ctx, "btc:usd")
+	require.NoError(t, err)
+	require.Len(t, rawPrices, 1)
+	require.Equal(t, addrs[0], rawPrices[0].OracleAddress)
+}
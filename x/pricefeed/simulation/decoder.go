@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/tendermint/tendermint/libs/kv"
 
@@ -14,6 +15,38 @@ import (
 // DecodeStore unmarshals the KVPair's Value to the corresponding pricefeed type
 func DecodeStore(cdc *codec.Codec, kvA, kvB kv.Pair) string {
 	switch {
+	case bytes.Equal(kvA.Key[:1], types.PriceRecordPrefix):
+		var recordA, recordB types.PriceRecord
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &recordA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &recordB)
+		return fmt.Sprintf("%s\n%s", recordA, recordB)
+
+	case bytes.Equal(kvA.Key[:1], types.TWAPPricePrefix):
+		var priceA, priceB types.CurrentPrice
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &priceA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &priceB)
+		return fmt.Sprintf("%s\n%s", priceA, priceB)
+
+	case bytes.Equal(kvA.Key[:1], types.MarketStatusPrefix):
+		var statusA, statusB types.MarketStatus
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &statusA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &statusB)
+		return fmt.Sprintf("%v\n%v", statusA, statusB)
+
+	case bytes.Equal(kvA.Key[:1], types.OracleStatsPrefix):
+		var statsA, statsB types.OracleStats
+		cdc.MustUnmarshalBinaryBare(kvA.Value, &statsA)
+		cdc.MustUnmarshalBinaryBare(kvB.Value, &statsB)
+		return fmt.Sprintf("%s\n%s", statsA, statsB)
+
+	case bytes.Equal(kvA.Key[:1], types.OracleWindowStartKey):
+		timeA, errA := sdk.ParseTimeBytes(kvA.Value)
+		timeB, errB := sdk.ParseTimeBytes(kvB.Value)
+		if errA != nil || errB != nil {
+			panic(fmt.Sprintf("invalid %s oracle window start %X %X", types.ModuleName, kvA.Value, kvB.Value))
+		}
+		return fmt.Sprintf("%s\n%s", timeA, timeB)
+
 	case bytes.Contains(kvA.Key, []byte(types.CurrentPricePrefix)):
 		var priceA, priceB types.CurrentPrice
 		cdc.MustUnmarshalBinaryBare(kvA.Value, &priceA)
@@ -19,4 +19,8 @@ var (
 	ErrInvalidOracle = sdkerrors.Register(ModuleName, 6, "oracle does not exist or not authorized")
 	// ErrAssetNotFound error for not found asset
 	ErrAssetNotFound = sdkerrors.Register(ModuleName, 7, "asset not found")
+	// ErrMarketHalted error for markets halted by their price guards
+	ErrMarketHalted = sdkerrors.Register(ModuleName, 8, "market is halted")
+	// ErrOracleJailed error for posted price messages from jailed oracles
+	ErrOracleJailed = sdkerrors.Register(ModuleName, 9, "oracle is jailed")
 )
@@ -5,10 +5,15 @@ const (
 	EventTypeMarketPriceUpdated = "market_price_updated"
 	EventTypeOracleUpdatedPrice = "oracle_updated_price"
 	EventTypeNoValidPrices      = "no_valid_prices"
+	EventTypeMarketHalted       = "market_halted"
+	EventTypeOraclePenalized    = "oracle_penalized"
 
 	AttributeValueCategory = ModuleName
 	AttributeMarketID      = "market_id"
 	AttributeMarketPrice   = "market_price"
 	AttributeOracle        = "oracle"
 	AttributeExpiry        = "expiry"
+	AttributeHaltReason    = "halt_reason"
+	AttributeJailedUntil   = "jailed_until"
+	AttributeRemoved       = "removed"
 )
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PricefeedHooks event hooks for other keepers to run code in response to market price changes
+type PricefeedHooks interface {
+	AfterPriceChanged(ctx sdk.Context, marketID string, oldPrice, newPrice sdk.Dec)
+	AfterMarketStatusChanged(ctx sdk.Context, marketID string, halted bool)
+}
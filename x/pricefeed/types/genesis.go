@@ -2,19 +2,27 @@ package types
 
 import (
 	"bytes"
+	"fmt"
 )
 
 // GenesisState - pricefeed state that must be provided at genesis
 type GenesisState struct {
-	Params       Params       `json:"params" yaml:"params"`
-	PostedPrices PostedPrices `json:"posted_prices" yaml:"posted_prices"`
+	Params       Params          `json:"params" yaml:"params"`
+	PostedPrices PostedPrices    `json:"posted_prices" yaml:"posted_prices"`
+	PriceRecords PriceRecords    `json:"price_records,omitempty" yaml:"price_records,omitempty"`
+	OracleStats  OracleStatsList `json:"oracle_stats,omitempty" yaml:"oracle_stats,omitempty"`
+	// MarketStatuses are the statuses of the markets with guards, so halted markets stay halted across genesis export and import
+	MarketStatuses MarketStatuses `json:"market_statuses,omitempty" yaml:"market_statuses,omitempty"`
 }
 
 // NewGenesisState creates a new genesis state for the pricefeed module
-func NewGenesisState(p Params, pp []PostedPrice) GenesisState {
+func NewGenesisState(p Params, pp []PostedPrice, pr []PriceRecord, os []OracleStats, ms []MarketStatus) GenesisState {
 	return GenesisState{
-		Params:       p,
-		PostedPrices: pp,
+		Params:         p,
+		PostedPrices:   pp,
+		PriceRecords:   pr,
+		OracleStats:    os,
+		MarketStatuses: ms,
 	}
 }
 
@@ -23,6 +31,9 @@ func DefaultGenesisState() GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
 		[]PostedPrice{},
+		[]PriceRecord{},
+		[]OracleStats{},
+		[]MarketStatus{},
 	)
 }
 
@@ -44,5 +55,59 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
-	return gs.PostedPrices.Validate()
+	if err := gs.PostedPrices.Validate(); err != nil {
+		return err
+	}
+	if err := gs.validatePostedPriceOracles(); err != nil {
+		return err
+	}
+	if err := gs.PriceRecords.Validate(); err != nil {
+		return err
+	}
+	if err := gs.OracleStats.Validate(); err != nil {
+		return err
+	}
+	if err := gs.MarketStatuses.Validate(); err != nil {
+		return err
+	}
+	return gs.validateMarketStatusMarkets()
+}
+
+// validateMarketStatusMarkets checks that each market status is for an existing market
+func (gs GenesisState) validateMarketStatusMarkets() error {
+	markets := make(map[string]bool, len(gs.Params.Markets))
+	for _, m := range gs.Params.Markets {
+		markets[m.MarketID] = true
+	}
+	for _, s := range gs.MarketStatuses {
+		if !markets[s.MarketID] {
+			return fmt.Errorf("market status for unknown market %s", s.MarketID)
+		}
+	}
+	return nil
+}
+
+// validatePostedPriceOracles checks that each posted price was posted by an oracle of an existing market
+func (gs GenesisState) validatePostedPriceOracles() error {
+	markets := make(map[string]Market, len(gs.Params.Markets))
+	for _, m := range gs.Params.Markets {
+		markets[m.MarketID] = m
+	}
+	for _, pp := range gs.PostedPrices {
+		market, found := markets[pp.MarketID]
+		if !found {
+			return fmt.Errorf("posted price for unknown market %s", pp.MarketID)
+		}
+		isOracle := false
+		for _, o := range market.Oracles {
+			if o.Equals(pp.OracleAddress) {
+				isOracle = true
+				break
+			}
+		}
+		if !isOracle {
+			return fmt.Errorf("posted price for market %s from %s, which is not an oracle of the market", pp.MarketID, pp.OracleAddress)
+		}
+	}
+	return nil
 }
@@ -32,9 +32,12 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "valid genesis",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
-				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+				}, DefaultOracleParams, DefaultPriceHistoryRetention),
+				[]PostedPrice{NewPostedPrice("market", addr, sdk.OneDec(), now)},
+				nil,
+				nil,
+				nil,
 			),
 			expPass: true,
 		},
@@ -42,9 +45,12 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "invalid param",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					{MarketID: "", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+				}, DefaultOracleParams, DefaultPriceHistoryRetention),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				nil,
+				nil,
+				nil,
 			),
 			expPass: false,
 		},
@@ -52,29 +58,156 @@ func TestGenesisStateValidate(t *testing.T) {
 			msg: "dup market param",
 			genesisState: NewGenesisState(
 				NewParams(Markets{
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-					{"market", "xrp", "bnb", []sdk.AccAddress{addr}, true},
-				}),
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+				}, DefaultOracleParams, DefaultPriceHistoryRetention),
 				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				nil,
+				nil,
+				nil,
 			),
 			expPass: false,
 		},
 		{
 			msg: "invalid posted price",
 			genesisState: NewGenesisState(
-				NewParams(Markets{}),
+				NewParams(Markets{}, DefaultOracleParams, DefaultPriceHistoryRetention),
 				[]PostedPrice{NewPostedPrice("xrp", nil, sdk.OneDec(), now)},
+				nil,
+				nil,
+				nil,
 			),
 			expPass: false,
 		},
 		{
 			msg: "duplicated posted price",
 			genesisState: NewGenesisState(
-				NewParams(Markets{}),
+				NewParams(Markets{}, DefaultOracleParams, DefaultPriceHistoryRetention),
 				[]PostedPrice{
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 					NewPostedPrice("xrp", addr, sdk.OneDec(), now),
 				},
+				nil,
+				nil,
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "posted price for unknown market",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+				}, DefaultOracleParams, DefaultPriceHistoryRetention),
+				[]PostedPrice{NewPostedPrice("xrp", addr, sdk.OneDec(), now)},
+				nil,
+				nil,
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "posted price from non oracle",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{}, Active: true},
+				}, DefaultOracleParams, DefaultPriceHistoryRetention),
+				[]PostedPrice{NewPostedPrice("market", addr, sdk.OneDec(), now)},
+				nil,
+				nil,
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid oracle params",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, NewOracleParams(0, sdk.MustNewDecFromStr("0.05"), 3, time.Hour, false), DefaultPriceHistoryRetention),
+				nil,
+				nil,
+				nil,
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid price history retention",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleParams, MaxPriceHistoryRetention+time.Hour),
+				nil,
+				nil,
+				nil,
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated oracle stats",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleParams, DefaultPriceHistoryRetention),
+				nil,
+				nil,
+				[]OracleStats{NewOracleStats("xrp", addr), NewOracleStats("xrp", addr)},
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated price record",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleParams, DefaultPriceHistoryRetention),
+				nil,
+				[]PriceRecord{NewPriceRecord("xrp", sdk.OneDec(), 1, now), NewPriceRecord("xrp", sdk.OneDec(), 2, now)},
+				nil,
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "invalid price record height",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleParams, DefaultPriceHistoryRetention),
+				nil,
+				[]PriceRecord{NewPriceRecord("xrp", sdk.OneDec(), 0, now)},
+				nil,
+				nil,
+			),
+			expPass: false,
+		},
+		{
+			msg: "valid market status",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+				}, DefaultOracleParams, DefaultPriceHistoryRetention),
+				nil,
+				nil,
+				nil,
+				[]MarketStatus{NewMarketStatus("market", true, now, sdk.OneDec())},
+			),
+			expPass: true,
+		},
+		{
+			msg: "market status for unknown market",
+			genesisState: NewGenesisState(
+				NewParams(Markets{}, DefaultOracleParams, DefaultPriceHistoryRetention),
+				nil,
+				nil,
+				nil,
+				[]MarketStatus{NewMarketStatus("market", true, now, sdk.OneDec())},
+			),
+			expPass: false,
+		},
+		{
+			msg: "duplicated market status",
+			genesisState: NewGenesisState(
+				NewParams(Markets{
+					{MarketID: "market", BaseAsset: "xrp", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}, Active: true},
+				}, DefaultOracleParams, DefaultPriceHistoryRetention),
+				nil,
+				nil,
+				nil,
+				[]MarketStatus{NewMarketStatus("market", true, now, sdk.OneDec()), NewMarketStatus("market", false, now, sdk.OneDec())},
 			),
 			expPass: false,
 		},
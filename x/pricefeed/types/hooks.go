@@ -0,0 +1,25 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// MultiPricefeedHooks combine multiple pricefeed hooks, all hook functions are run in array sequence
+type MultiPricefeedHooks []PricefeedHooks
+
+// NewMultiPricefeedHooks returns a new MultiPricefeedHooks
+func NewMultiPricefeedHooks(hooks ...PricefeedHooks) MultiPricefeedHooks {
+	return hooks
+}
+
+// AfterPriceChanged runs after the current price of a market changes
+func (h MultiPricefeedHooks) AfterPriceChanged(ctx sdk.Context, marketID string, oldPrice, newPrice sdk.Dec) {
+	for i := range h {
+		h[i].AfterPriceChanged(ctx, marketID, oldPrice, newPrice)
+	}
+}
+
+// AfterMarketStatusChanged runs after a market is halted or resumed by its guards
+func (h MultiPricefeedHooks) AfterMarketStatusChanged(ctx sdk.Context, marketID string, halted bool) {
+	for i := range h {
+		h[i].AfterMarketStatusChanged(ctx, marketID, halted)
+	}
+}
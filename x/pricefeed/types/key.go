@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName The name that will be used throughout the module
 	ModuleName = "pricefeed"
@@ -23,6 +29,21 @@ var (
 
 	// RawPriceFeedPrefix prefix for the raw pricefeed of an asset
 	RawPriceFeedPrefix = []byte{0x01}
+
+	// PriceRecordPrefix prefix for the history of accepted median prices of an asset
+	PriceRecordPrefix = []byte{0x02}
+
+	// TWAPPricePrefix prefix for the time weighted average prices of an asset
+	TWAPPricePrefix = []byte{0x03}
+
+	// MarketStatusPrefix prefix for the status of a market's guards
+	MarketStatusPrefix = []byte{0x04}
+
+	// OracleStatsPrefix prefix for the performance of oracles
+	OracleStatsPrefix = []byte{0x05}
+
+	// OracleWindowStartKey key for the start time of the current oracle window
+	OracleWindowStartKey = []byte{0x06}
 )
 
 // CurrentPriceKey returns the prefix for the current price
@@ -34,3 +55,33 @@ func CurrentPriceKey(marketID string) []byte {
 func RawPriceKey(marketID string) []byte {
 	return append(RawPriceFeedPrefix, []byte(marketID)...)
 }
+
+// PriceRecordKeyPrefix returns the prefix for the price records of a market
+func PriceRecordKeyPrefix(marketID string) []byte {
+	return append(append(PriceRecordPrefix, []byte(marketID)...), 0x00)
+}
+
+// PriceRecordKey returns the key for a market's price record at a point in time
+func PriceRecordKey(marketID string, t time.Time) []byte {
+	return append(PriceRecordKeyPrefix(marketID), sdk.FormatTimeBytes(t)...)
+}
+
+// TWAPPriceKey returns the key for the price of a time weighted average price market
+func TWAPPriceKey(twapMarketID string) []byte {
+	return append(TWAPPricePrefix, []byte(twapMarketID)...)
+}
+
+// MarketStatusKey returns the key for the status of a market
+func MarketStatusKey(marketID string) []byte {
+	return append(MarketStatusPrefix, []byte(marketID)...)
+}
+
+// OracleStatsKeyPrefix returns the prefix for the oracle stats of a market
+func OracleStatsKeyPrefix(marketID string) []byte {
+	return append(append(OracleStatsPrefix, []byte(marketID)...), 0x00)
+}
+
+// OracleStatsKey returns the key for the stats of an oracle in a market
+func OracleStatsKey(marketID string, oracle sdk.AccAddress) []byte {
+	return append(OracleStatsKeyPrefix(marketID), oracle...)
+}
@@ -1,21 +1,39 @@
 package types
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxTWAPWindow is the longest window a time weighted average price can be calculated over
+const MaxTWAPWindow = 24 * time.Hour
+
+// Operations used to derive a market's price from the prices of other markets
+const (
+	DerivationMultiply = "multiply" // the product of two markets' prices, eg btc:usd = btc:bnb * bnb:usd
+	DerivationDivide   = "divide"   // the quotient of two markets' prices, eg hard:bnb = hard:usd / bnb:usd
+	DerivationInverse  = "inverse"  // the inverse of a market's price, eg usd:bnb = 1 / bnb:usd
+)
+
 // Market an asset in the pricefeed
 type Market struct {
-	MarketID   string           `json:"market_id" yaml:"market_id"`
-	BaseAsset  string           `json:"base_asset" yaml:"base_asset"`
-	QuoteAsset string           `json:"quote_asset" yaml:"quote_asset"`
-	Oracles    []sdk.AccAddress `json:"oracles" yaml:"oracles"`
-	Active     bool             `json:"active" yaml:"active"`
+	MarketID          string           `json:"market_id" yaml:"market_id"`
+	BaseAsset         string           `json:"base_asset" yaml:"base_asset"`
+	QuoteAsset        string           `json:"quote_asset" yaml:"quote_asset"`
+	Oracles           []sdk.AccAddress `json:"oracles" yaml:"oracles"`
+	Active            bool             `json:"active" yaml:"active"`
+	TWAPWindows       []time.Duration  `json:"twap_windows,omitempty" yaml:"twap_windows,omitempty"`               // windows of the time weighted average price markets derived from this market
+	MaxPriceDeviation sdk.Dec          `json:"max_price_deviation,omitempty" yaml:"max_price_deviation,omitempty"` // largest change from the previous price as a fraction of it, zero for no limit
+	DeviationPeriod   time.Duration    `json:"deviation_period,omitempty" yaml:"deviation_period,omitempty"`       // period the max deviation is allowed over, zero for a limit per block
+	ResumeUpdates     uint64           `json:"resume_updates,omitempty" yaml:"resume_updates,omitempty"`           // consistent price updates after which a market halted by its max price deviation accepts the new price, zero to wait for the deviation period
+	MinOracles        uint64           `json:"min_oracles,omitempty" yaml:"min_oracles,omitempty"`                 // number of unexpired oracle prices needed for the price to be valid
+	Derivation        *Derivation      `json:"derivation,omitempty" yaml:"derivation,omitempty"`                   // derives the market's price from other markets instead of oracle prices
 }
 
 // NewMarket returns a new Market
@@ -36,8 +54,87 @@ func (m Market) String() string {
 	Base Asset: %s
 	Quote Asset: %s
 	Oracles: %s
-	Active: %t`,
-		m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active)
+	Active: %t
+	TWAP Windows: %s
+	Max Price Deviation: %s
+	Deviation Period: %s
+	Resume Updates: %d
+	Min Oracles: %d
+	Derivation: %s`,
+		m.MarketID, m.BaseAsset, m.QuoteAsset, m.Oracles, m.Active, m.TWAPWindows, m.MaxPriceDeviation, m.DeviationPeriod, m.ResumeUpdates, m.MinOracles, m.Derivation)
+}
+
+// IsDerived returns true if the market's price is derived from other markets
+func (m Market) IsDerived() bool {
+	return m.Derivation != nil
+}
+
+// HasGuards returns true if the market's price can be halted by a max price deviation or min number of oracles
+func (m Market) HasGuards() bool {
+	return m.MinOracles > 0 || (!m.MaxPriceDeviation.IsNil() && m.MaxPriceDeviation.IsPositive())
+}
+
+// AllowsPriceChange returns true if a change from the previous price is within the market's max price deviation.
+// With a deviation period set, the allowed deviation grows with the time elapsed since the previous price was accepted.
+func (m Market) AllowsPriceChange(previous, price sdk.Dec, elapsed time.Duration) bool {
+	if m.MaxPriceDeviation.IsNil() || m.MaxPriceDeviation.IsZero() || !previous.IsPositive() {
+		return true
+	}
+	maxDeviation := m.MaxPriceDeviation
+	if m.DeviationPeriod > 0 {
+		maxDeviation = maxDeviation.MulInt64(int64(elapsed)).QuoInt64(int64(m.DeviationPeriod))
+	}
+	return price.Sub(previous).Abs().Quo(previous).LTE(maxDeviation)
+}
+
+// IsConsistentPrice returns true if a price is within the market's max price deviation of a previous price, regardless of the deviation period.
+// It is used to check that the prices of a halted market agree with each other before the market resumes.
+func (m Market) IsConsistentPrice(previous, price sdk.Dec) bool {
+	if m.MaxPriceDeviation.IsNil() || m.MaxPriceDeviation.IsZero() || previous.IsNil() || !previous.IsPositive() {
+		return true
+	}
+	return price.Sub(previous).Abs().Quo(previous).LTE(m.MaxPriceDeviation)
+}
+
+// TWAPMarketIDs returns the ids of the time weighted average price markets derived from this market
+func (m Market) TWAPMarketIDs() []string {
+	ids := make([]string, len(m.TWAPWindows))
+	for i, window := range m.TWAPWindows {
+		ids[i] = TWAPMarketID(m.MarketID, window)
+	}
+	return ids
+}
+
+// HasTWAPWindow returns true if the market has a time weighted average price market over the window
+func (m Market) HasTWAPWindow(window time.Duration) bool {
+	for _, w := range m.TWAPWindows {
+		if w == window {
+			return true
+		}
+	}
+	return false
+}
+
+// TWAPMarketIDSeparator separates the id of a market from the window of a time weighted average price market derived from it.
+// It is distinct from the ids of existing markets such as "btc:usd:30", which are not time weighted averages.
+const TWAPMarketIDSeparator = ":twap"
+
+// TWAPMarketID returns the id of the time weighted average price market of a market over a window, eg "bnb:usd:twap30" for 30 minutes
+func TWAPMarketID(marketID string, window time.Duration) string {
+	return fmt.Sprintf("%s%s%d", marketID, TWAPMarketIDSeparator, window/time.Minute)
+}
+
+// ParseTWAPMarketID splits a time weighted average price market id into the id of the market it is derived from and its window
+func ParseTWAPMarketID(twapMarketID string) (string, time.Duration, bool) {
+	i := strings.LastIndex(twapMarketID, TWAPMarketIDSeparator)
+	if i <= 0 {
+		return "", 0, false
+	}
+	minutes, err := strconv.ParseUint(twapMarketID[i+len(TWAPMarketIDSeparator):], 10, 32)
+	if err != nil || minutes == 0 {
+		return "", 0, false
+	}
+	return twapMarketID[:i], time.Duration(minutes) * time.Minute, true
 }
 
 // Validate performs a basic validation of the market params
@@ -61,6 +158,40 @@ func (m Market) Validate() error {
 		}
 		seenOracles[oracle.String()] = true
 	}
+	seenWindows := make(map[time.Duration]bool)
+	for _, window := range m.TWAPWindows {
+		if window <= 0 || window > MaxTWAPWindow {
+			return fmt.Errorf("twap window must be positive and at most %s, got %s", MaxTWAPWindow, window)
+		}
+		if window%time.Minute != 0 {
+			return fmt.Errorf("twap window must be a whole number of minutes, got %s", window)
+		}
+		if seenWindows[window] {
+			return fmt.Errorf("duplicated twap window %s", window)
+		}
+		seenWindows[window] = true
+	}
+	if !m.MaxPriceDeviation.IsNil() && m.MaxPriceDeviation.IsNegative() {
+		return fmt.Errorf("max price deviation cannot be negative %s", m.MaxPriceDeviation)
+	}
+	if m.DeviationPeriod < 0 {
+		return fmt.Errorf("deviation period cannot be negative %s", m.DeviationPeriod)
+	}
+	// without a deviation period the allowed change does not grow while halted, so the market needs another way to resume
+	if !m.MaxPriceDeviation.IsNil() && m.MaxPriceDeviation.IsPositive() && m.DeviationPeriod == 0 && m.ResumeUpdates == 0 {
+		return fmt.Errorf("market %s with a max price deviation and no deviation period must set resume updates", m.MarketID)
+	}
+	if m.MinOracles > uint64(len(m.Oracles)) {
+		return fmt.Errorf("min oracles %d is greater than the number of oracles %d", m.MinOracles, len(m.Oracles))
+	}
+	if m.IsDerived() {
+		if len(m.Oracles) > 0 {
+			return fmt.Errorf("derived market %s cannot have oracles", m.MarketID)
+		}
+		if err := m.Derivation.Validate(); err != nil {
+			return fmt.Errorf("invalid derivation for market %s: %w", m.MarketID, err)
+		}
+	}
 	return nil
 }
 
@@ -80,7 +211,70 @@ func (ms Markets) Validate() error {
 		}
 		seenMarkets[m.MarketID] = true
 	}
-	return nil
+	for _, m := range ms {
+		for _, id := range m.TWAPMarketIDs() {
+			if seenMarkets[id] {
+				return fmt.Errorf("twap market %s duplicates an existing market", id)
+			}
+			seenMarkets[id] = true
+		}
+	}
+	_, err := ms.SortByDerivation()
+	return err
+}
+
+// SortByDerivation returns the markets ordered so that each derived market comes after the markets it is derived from.
+// Markets are otherwise kept in their original order. An error is returned if a derivation input is not a market,
+// or if markets are derived from each other in a cycle.
+func (ms Markets) SortByDerivation() (Markets, error) {
+	markets := make(map[string]Market, len(ms))
+	for _, m := range ms {
+		markets[m.MarketID] = m
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(ms))
+	sorted := make(Markets, 0, len(ms))
+	var visit func(m Market) error
+	visit = func(m Market) error {
+		switch state[m.MarketID] {
+		case visiting:
+			return fmt.Errorf("market %s is derived from itself", m.MarketID)
+		case visited:
+			return nil
+		}
+		state[m.MarketID] = visiting
+		if m.IsDerived() {
+			for _, input := range m.Derivation.Inputs {
+				inputMarket, found := markets[input]
+				if !found {
+					// time weighted average price markets are calculated with the market they are derived from
+					marketID, window, ok := ParseTWAPMarketID(input)
+					inputMarket, found = markets[marketID]
+					if !ok || !found || !inputMarket.HasTWAPWindow(window) {
+						return fmt.Errorf("market %s is derived from unknown market %s", m.MarketID, input)
+					}
+				}
+				if err := visit(inputMarket); err != nil {
+					return err
+				}
+			}
+		}
+		state[m.MarketID] = visited
+		sorted = append(sorted, m)
+		return nil
+	}
+
+	for _, m := range ms {
+		if err := visit(m); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
 }
 
 // String implements fmt.Stringer
@@ -92,6 +286,70 @@ func (ms Markets) String() string {
 	return strings.TrimSpace(out)
 }
 
+// Derivation defines a market's price as an operation on the prices of other markets.
+// Inputs can be any market id, including time weighted average price market ids.
+type Derivation struct {
+	Operation string   `json:"operation" yaml:"operation"`
+	Inputs    []string `json:"inputs" yaml:"inputs"`
+}
+
+// NewDerivation returns a new Derivation
+func NewDerivation(operation string, inputs ...string) *Derivation {
+	return &Derivation{
+		Operation: operation,
+		Inputs:    inputs,
+	}
+}
+
+// String implements fmt.Stringer
+func (d Derivation) String() string {
+	return fmt.Sprintf("%s(%s)", d.Operation, strings.Join(d.Inputs, ", "))
+}
+
+// Validate performs a basic validation of the derivation
+func (d Derivation) Validate() error {
+	var numInputs int
+	switch d.Operation {
+	case DerivationMultiply, DerivationDivide:
+		numInputs = 2
+	case DerivationInverse:
+		numInputs = 1
+	default:
+		return fmt.Errorf("invalid derivation operation %s", d.Operation)
+	}
+	if len(d.Inputs) != numInputs {
+		return fmt.Errorf("%s derivation needs %d inputs, got %d", d.Operation, numInputs, len(d.Inputs))
+	}
+	for _, input := range d.Inputs {
+		if strings.TrimSpace(input) == "" {
+			return errors.New("derivation input cannot be blank")
+		}
+	}
+	return nil
+}
+
+// Calculate returns the derived price from the prices of the inputs, in the same order as the inputs
+func (d Derivation) Calculate(prices []sdk.Dec) (sdk.Dec, error) {
+	if len(prices) != len(d.Inputs) {
+		return sdk.Dec{}, fmt.Errorf("%s derivation needs %d prices, got %d", d.Operation, len(d.Inputs), len(prices))
+	}
+	for _, price := range prices {
+		if !price.IsPositive() {
+			return sdk.Dec{}, fmt.Errorf("derivation input price must be positive, got %s", price)
+		}
+	}
+	switch d.Operation {
+	case DerivationMultiply:
+		return prices[0].Mul(prices[1]), nil
+	case DerivationDivide:
+		return prices[0].Quo(prices[1]), nil
+	case DerivationInverse:
+		return sdk.OneDec().Quo(prices[0]), nil
+	default:
+		return sdk.Dec{}, fmt.Errorf("invalid derivation operation %s", d.Operation)
+	}
+}
+
 // CurrentPrice struct that contains the metadata of a current price for a particular market in the pricefeed module.
 type CurrentPrice struct {
 	MarketID string  `json:"market_id" yaml:"market_id"`
@@ -162,6 +420,150 @@ func (pps PostedPrices) Validate() error {
 	return nil
 }
 
+// MarketStatus records whether a market's price has been halted by its guards
+type MarketStatus struct {
+	MarketID       string    `json:"market_id" yaml:"market_id"`
+	Halted         bool      `json:"halted" yaml:"halted"`
+	LastPriceTime  time.Time `json:"last_price_time" yaml:"last_price_time"`                     // when the market's current price was last accepted
+	LastPrice      sdk.Dec   `json:"last_price" yaml:"last_price"`                               // the market's last accepted price, which new prices are compared against
+	PendingPrice   sdk.Dec   `json:"pending_price,omitempty" yaml:"pending_price,omitempty"`     // the latest price rejected by the max price deviation
+	PendingUpdates uint64    `json:"pending_updates,omitempty" yaml:"pending_updates,omitempty"` // number of consecutive consistent price updates rejected by the max price deviation
+	PendingInputs  []byte    `json:"pending_inputs,omitempty" yaml:"pending_inputs,omitempty"`   // hash of the prices the pending price was calculated from
+}
+
+// NewMarketStatus returns a new MarketStatus
+func NewMarketStatus(marketID string, halted bool, lastPriceTime time.Time, lastPrice sdk.Dec) MarketStatus {
+	return MarketStatus{
+		MarketID:      marketID,
+		Halted:        halted,
+		LastPriceTime: lastPriceTime,
+		LastPrice:     lastPrice,
+	}
+}
+
+// HasLastPrice returns true if the status records a last accepted price
+func (s MarketStatus) HasLastPrice() bool {
+	return !s.LastPrice.IsNil() && s.LastPrice.IsPositive()
+}
+
+// WithPendingPrice returns the status with a price rejected by the market's max price deviation counted towards resuming the market.
+// Consecutive prices that are consistent with each other count towards the same pending price, otherwise counting restarts from the new price.
+// A price calculated from the same inputs as the pending price is not a new update, so it is not counted again.
+func (s MarketStatus) WithPendingPrice(m Market, price sdk.Dec, inputs []byte) MarketStatus {
+	if s.PendingUpdates > 0 && bytes.Equal(s.PendingInputs, inputs) {
+		return s
+	}
+	s.PendingInputs = inputs
+	if s.PendingUpdates > 0 && m.IsConsistentPrice(s.PendingPrice, price) {
+		s.PendingUpdates++
+	} else {
+		s.PendingUpdates = 1
+	}
+	s.PendingPrice = price
+	return s
+}
+
+// CanResume returns true if enough consistent prices have been rejected by the market's max price deviation for the latest one to be accepted.
+// The first rejected price halts the market, and it resumes after the market's resume updates further consistent prices.
+func (s MarketStatus) CanResume(m Market) bool {
+	return m.ResumeUpdates > 0 && s.PendingUpdates > m.ResumeUpdates
+}
+
+// Validate performs a basic check of a MarketStatus.
+func (s MarketStatus) Validate() error {
+	if strings.TrimSpace(s.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if !s.LastPrice.IsNil() && s.LastPrice.IsNegative() {
+		return fmt.Errorf("last price cannot be negative %s", s.LastPrice)
+	}
+	if s.PendingUpdates > 0 && (s.PendingPrice.IsNil() || !s.PendingPrice.IsPositive()) {
+		return fmt.Errorf("pending price must be positive with pending updates, got %s", s.PendingPrice)
+	}
+	return nil
+}
+
+// MarketStatuses is a slice of MarketStatus
+type MarketStatuses []MarketStatus
+
+// Validate checks each status is valid and there is at most one status per market
+func (ss MarketStatuses) Validate() error {
+	seen := make(map[string]bool, len(ss))
+	for _, s := range ss {
+		if seen[s.MarketID] {
+			return fmt.Errorf("duplicated market status %s", s.MarketID)
+		}
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		seen[s.MarketID] = true
+	}
+	return nil
+}
+
+// PriceRecord is the median price of a market accepted at a block, used to calculate time weighted average prices and kept for the price history retention period
+type PriceRecord struct {
+	MarketID string    `json:"market_id" yaml:"market_id"`
+	Price    sdk.Dec   `json:"price" yaml:"price"`
+	Height   int64     `json:"height" yaml:"height"`
+	Time     time.Time `json:"time" yaml:"time"`
+}
+
+// NewPriceRecord returns a new PriceRecord
+func NewPriceRecord(marketID string, price sdk.Dec, height int64, t time.Time) PriceRecord {
+	return PriceRecord{
+		MarketID: marketID,
+		Price:    price,
+		Height:   height,
+		Time:     t,
+	}
+}
+
+// Validate performs a basic check of a PriceRecord.
+func (pr PriceRecord) Validate() error {
+	if strings.TrimSpace(pr.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if pr.Price.IsNil() || !pr.Price.IsPositive() {
+		return fmt.Errorf("recorded price must be positive %s", pr.Price)
+	}
+	if pr.Height <= 0 {
+		return fmt.Errorf("record height must be positive %d", pr.Height)
+	}
+	if pr.Time.Unix() <= 0 {
+		return errors.New("record time cannot be zero")
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (pr PriceRecord) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Price: %s
+Height: %d
+Time: %s`, pr.MarketID, pr.Price, pr.Height, pr.Time))
+}
+
+// PriceRecords type for an array of PriceRecord
+type PriceRecords []PriceRecord
+
+// Validate checks if all the price records are valid and there are no duplicated
+// entries.
+func (prs PriceRecords) Validate() error {
+	seenRecords := make(map[string]bool)
+	for _, pr := range prs {
+		if err := pr.Validate(); err != nil {
+			return err
+		}
+		key := string(PriceRecordKey(pr.MarketID, pr.Time))
+		if seenRecords[key] {
+			return fmt.Errorf("duplicated price record for market id %s at %s", pr.MarketID, pr.Time)
+		}
+		seenRecords[key] = true
+	}
+	return nil
+}
+
 // implement fmt.Stringer
 func (cp CurrentPrice) String() string {
 	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
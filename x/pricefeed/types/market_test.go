@@ -77,6 +77,113 @@ func TestMarketValidate(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid twap windows",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				TWAPWindows: []time.Duration{30 * time.Minute, 24 * time.Hour},
+			},
+			true,
+		},
+		{
+			"twap window not in minutes",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				TWAPWindows: []time.Duration{90 * time.Second},
+			},
+			false,
+		},
+		{
+			"twap window too long",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				TWAPWindows: []time.Duration{MaxTWAPWindow + time.Minute},
+			},
+			false,
+		},
+		{
+			"duplicated twap window",
+			Market{
+				MarketID:    "market",
+				BaseAsset:   "xrp",
+				QuoteAsset:  "bnb",
+				TWAPWindows: []time.Duration{time.Hour, time.Hour},
+			},
+			false,
+		},
+		{
+			"valid guards",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				Oracles:           []sdk.AccAddress{addr},
+				MaxPriceDeviation: sdk.MustNewDecFromStr("0.1"),
+				DeviationPeriod:   time.Hour,
+				MinOracles:        1,
+			},
+			true,
+		},
+		{
+			"valid guards resuming after updates",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				Oracles:           []sdk.AccAddress{addr},
+				MaxPriceDeviation: sdk.MustNewDecFromStr("0.1"),
+				ResumeUpdates:     3,
+			},
+			true,
+		},
+		{
+			"max price deviation without a way to resume",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				Oracles:           []sdk.AccAddress{addr},
+				MaxPriceDeviation: sdk.MustNewDecFromStr("0.1"),
+			},
+			false,
+		},
+		{
+			"negative max price deviation",
+			Market{
+				MarketID:          "market",
+				BaseAsset:         "xrp",
+				QuoteAsset:        "bnb",
+				MaxPriceDeviation: sdk.MustNewDecFromStr("-0.1"),
+			},
+			false,
+		},
+		{
+			"negative deviation period",
+			Market{
+				MarketID:        "market",
+				BaseAsset:       "xrp",
+				QuoteAsset:      "bnb",
+				DeviationPeriod: -time.Hour,
+			},
+			false,
+		},
+		{
+			"min oracles above number of oracles",
+			Market{
+				MarketID:   "market",
+				BaseAsset:  "xrp",
+				QuoteAsset: "bnb",
+				Oracles:    []sdk.AccAddress{addr},
+				MinOracles: 2,
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -156,3 +263,122 @@ func TestPostedPriceValidate(t *testing.T) {
 		}
 	}
 }
+
+func TestMarketsValidate_TWAPMarketID(t *testing.T) {
+	markets := Markets{
+		{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", TWAPWindows: []time.Duration{30 * time.Minute}},
+		{MarketID: "bnb:usd:twap60", BaseAsset: "bnb", QuoteAsset: "usd"},
+		{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", TWAPWindows: []time.Duration{30 * time.Minute}},
+		{MarketID: "btc:usd:30", BaseAsset: "btc", QuoteAsset: "usd"},
+	}
+	// existing markets with minute suffixes do not collide with twap markets
+	require.NoError(t, markets.Validate())
+
+	// a twap market cannot have the same id as another market
+	markets[1].MarketID = "bnb:usd:twap30"
+	require.Error(t, markets.Validate())
+}
+
+func TestParseTWAPMarketID(t *testing.T) {
+	marketID, window, ok := ParseTWAPMarketID(TWAPMarketID("bnb:usd", 30*time.Minute))
+	require.True(t, ok)
+	require.Equal(t, "bnb:usd", marketID)
+	require.Equal(t, 30*time.Minute, window)
+
+	require.Equal(t, "bnb:usd:twap30", TWAPMarketID("bnb:usd", 30*time.Minute))
+
+	for _, id := range []string{"bnb", "bnb:usd", "bnb:usd:30", "bnb:usd:twap0", "bnb:usd:twap-30", "bnb:usd:twap", ":twap30"} {
+		_, _, ok := ParseTWAPMarketID(id)
+		require.False(t, ok, id)
+	}
+}
+
+func TestMarketAllowsPriceChange(t *testing.T) {
+	d := sdk.MustNewDecFromStr
+	perBlock := Market{MaxPriceDeviation: d("0.1")}
+	require.True(t, perBlock.AllowsPriceChange(d("10"), d("11"), 0))
+	require.True(t, perBlock.AllowsPriceChange(d("10"), d("9"), time.Hour))
+	require.False(t, perBlock.AllowsPriceChange(d("10"), d("11.1"), time.Hour))
+
+	perHour := Market{MaxPriceDeviation: d("0.1"), DeviationPeriod: time.Hour}
+	require.True(t, perHour.AllowsPriceChange(d("10"), d("10.5"), 30*time.Minute))
+	require.False(t, perHour.AllowsPriceChange(d("10"), d("10.6"), 30*time.Minute))
+	require.True(t, perHour.AllowsPriceChange(d("10"), d("12"), 2*time.Hour))
+
+	// markets without a max deviation, or without a previous price, allow any change
+	require.True(t, Market{}.AllowsPriceChange(d("10"), d("100"), 0))
+	require.True(t, perBlock.AllowsPriceChange(sdk.ZeroDec(), d("100"), 0))
+}
+
+func TestMarketsValidate_Derivation(t *testing.T) {
+	mockPrivKey := tmtypes.NewMockPV()
+	pubkey, err := mockPrivKey.GetPubKey()
+	require.NoError(t, err)
+	addr := sdk.AccAddress(pubkey.Address())
+
+	btcBnb := Market{MarketID: "btc:bnb", BaseAsset: "btc", QuoteAsset: "bnb", Oracles: []sdk.AccAddress{addr}}
+	bnbUsd := Market{MarketID: "bnb:usd", BaseAsset: "bnb", QuoteAsset: "usd", Oracles: []sdk.AccAddress{addr}, TWAPWindows: []time.Duration{30 * time.Minute}}
+	btcUsd := Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Derivation: NewDerivation(DerivationMultiply, "btc:bnb", "bnb:usd:twap30")}
+	usdBtc := Market{MarketID: "usd:btc", BaseAsset: "usd", QuoteAsset: "btc", Derivation: NewDerivation(DerivationInverse, "btc:usd")}
+
+	// derived markets are ordered after their inputs
+	sorted, err := Markets{usdBtc, btcUsd, btcBnb, bnbUsd}.SortByDerivation()
+	require.NoError(t, err)
+	require.Equal(t, Markets{btcBnb, bnbUsd, btcUsd, usdBtc}, sorted)
+	require.NoError(t, Markets{usdBtc, btcUsd, btcBnb, bnbUsd}.Validate())
+
+	testCases := []struct {
+		msg     string
+		markets Markets
+	}{
+		{
+			"unknown input",
+			Markets{btcBnb, btcUsd},
+		},
+		{
+			"unknown twap input",
+			Markets{btcBnb, bnbUsd, Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Derivation: NewDerivation(DerivationMultiply, "btc:bnb", "bnb:usd:twap60")}},
+		},
+		{
+			"cycle",
+			Markets{Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Derivation: NewDerivation(DerivationInverse, "usd:btc")}, usdBtc},
+		},
+		{
+			"derived from itself",
+			Markets{Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Derivation: NewDerivation(DerivationInverse, "btc:usd")}},
+		},
+		{
+			"derived market with oracles",
+			Markets{btcBnb, bnbUsd, Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Oracles: []sdk.AccAddress{addr}, Derivation: NewDerivation(DerivationMultiply, "btc:bnb", "bnb:usd")}},
+		},
+		{
+			"wrong number of inputs",
+			Markets{btcBnb, bnbUsd, Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Derivation: NewDerivation(DerivationMultiply, "btc:bnb")}},
+		},
+		{
+			"invalid operation",
+			Markets{btcBnb, bnbUsd, Market{MarketID: "btc:usd", BaseAsset: "btc", QuoteAsset: "usd", Derivation: NewDerivation("add", "btc:bnb", "bnb:usd")}},
+		},
+	}
+	for _, tc := range testCases {
+		require.Error(t, tc.markets.Validate(), tc.msg)
+	}
+}
+
+func TestDerivationCalculate(t *testing.T) {
+	d := sdk.MustNewDecFromStr
+	price, err := NewDerivation(DerivationMultiply, "a", "b").Calculate([]sdk.Dec{d("2"), d("3.5")})
+	require.NoError(t, err)
+	require.Equal(t, d("7"), price)
+
+	price, err = NewDerivation(DerivationDivide, "a", "b").Calculate([]sdk.Dec{d("7"), d("2")})
+	require.NoError(t, err)
+	require.Equal(t, d("3.5"), price)
+
+	price, err = NewDerivation(DerivationInverse, "a").Calculate([]sdk.Dec{d("4")})
+	require.NoError(t, err)
+	require.Equal(t, d("0.25"), price)
+
+	_, err = NewDerivation(DerivationDivide, "a", "b").Calculate([]sdk.Dec{d("7"), sdk.ZeroDec()})
+	require.Error(t, err)
+}
@@ -13,13 +13,18 @@ import (
 const (
 	// TypeMsgPostPrice type of PostPrice msg
 	TypeMsgPostPrice = "post_price"
+	// TypeMsgPostPrices type of PostPrices msg
+	TypeMsgPostPrices = "post_prices"
 
 	// MaxExpiry defines the max expiry time defined as UNIX time (9999-12-31 23:59:59 +0000 UTC)
 	MaxExpiry = 253402300799
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPostPrice{}
+var (
+	_ sdk.Msg = &MsgPostPrice{}
+	_ sdk.Msg = &MsgPostPrices{}
+)
 
 // MsgPostPrice struct representing a posted price message.
 // Used by oracles to input prices to the pricefeed
@@ -77,3 +82,86 @@ func (msg MsgPostPrice) ValidateBasic() error {
 	}
 	return nil
 }
+
+// PriceEntry is the price of a market posted in a MsgPostPrices
+type PriceEntry struct {
+	MarketID string    `json:"market_id" yaml:"market_id"` // asset code used by exchanges/api
+	Price    sdk.Dec   `json:"price" yaml:"price"`         // price in decimal (max precision 18)
+	Expiry   time.Time `json:"expiry" yaml:"expiry"`       // expiry time
+}
+
+// NewPriceEntry returns a new PriceEntry
+func NewPriceEntry(marketID string, price sdk.Dec, expiry time.Time) PriceEntry {
+	return PriceEntry{
+		MarketID: marketID,
+		Price:    price,
+		Expiry:   expiry,
+	}
+}
+
+// Validate performs a basic validation of the price entry
+func (pe PriceEntry) Validate() error {
+	if strings.TrimSpace(pe.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if pe.Price.IsNil() || pe.Price.IsNegative() {
+		return fmt.Errorf("price cannot be negative: %s", pe.Price)
+	}
+	if pe.Expiry.Unix() <= 0 {
+		return errors.New("must set an expiration time")
+	}
+	return nil
+}
+
+// MsgPostPrices struct representing a message posting the prices of several markets at once.
+// Used by oracles to input prices for all their markets in a single message
+type MsgPostPrices struct {
+	From   sdk.AccAddress `json:"from" yaml:"from"`     // client that sent in this address
+	Prices []PriceEntry   `json:"prices" yaml:"prices"` // prices of each market
+}
+
+// NewMsgPostPrices creates a new post prices msg
+func NewMsgPostPrices(from sdk.AccAddress, prices []PriceEntry) MsgPostPrices {
+	return MsgPostPrices{
+		From:   from,
+		Prices: prices,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgPostPrices) Route() string { return RouterKey }
+
+// Type Implements Msg
+func (msg MsgPostPrices) Type() string { return TypeMsgPostPrices }
+
+// GetSignBytes Implements Msg.
+func (msg MsgPostPrices) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners Implements Msg.
+func (msg MsgPostPrices) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.From}
+}
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgPostPrices) ValidateBasic() error {
+	if msg.From.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "sender address cannot be empty")
+	}
+	if len(msg.Prices) == 0 {
+		return errors.New("prices cannot be empty")
+	}
+	seenMarkets := make(map[string]bool)
+	for _, entry := range msg.Prices {
+		if err := entry.Validate(); err != nil {
+			return err
+		}
+		if seenMarkets[entry.MarketID] {
+			return fmt.Errorf("duplicated price for market %s", entry.MarketID)
+		}
+		seenMarkets[entry.MarketID] = true
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OracleStats records how an oracle has performed in a market
+type OracleStats struct {
+	MarketID          string         `json:"market_id" yaml:"market_id"`
+	OracleAddress     sdk.AccAddress `json:"oracle_address" yaml:"oracle_address"`
+	Posts             uint64         `json:"posts" yaml:"posts"`                           // number of prices posted
+	LastPostTime      time.Time      `json:"last_post_time" yaml:"last_post_time"`         // when the oracle last posted a price
+	MissedWindows     uint64         `json:"missed_windows" yaml:"missed_windows"`         // number of windows the oracle posted no price in
+	DeviationFaults   uint64         `json:"deviation_faults" yaml:"deviation_faults"`     // number of windows the oracle's price deviated too far from the median
+	LastDeviation     sdk.Dec        `json:"last_deviation" yaml:"last_deviation"`         // deviation of the oracle's price from the median at the end of the last window, as a fraction of the median
+	ConsecutiveFaults uint64         `json:"consecutive_faults" yaml:"consecutive_faults"` // windows in a row the oracle has missed or deviated in
+	JailedUntil       time.Time      `json:"jailed_until" yaml:"jailed_until"`             // the oracle cannot post prices until this time
+	Removed           bool           `json:"removed,omitempty" yaml:"removed,omitempty"`   // the oracle was removed for its faults and cannot post prices until governance removes it from the market
+}
+
+// NewOracleStats returns a new OracleStats for an oracle with no recorded activity
+func NewOracleStats(marketID string, oracle sdk.AccAddress) OracleStats {
+	return OracleStats{
+		MarketID:      marketID,
+		OracleAddress: oracle,
+		LastDeviation: sdk.ZeroDec(),
+	}
+}
+
+// IsJailed returns true if the oracle cannot post prices at the given time
+func (os OracleStats) IsJailed(t time.Time) bool {
+	return os.Removed || os.JailedUntil.After(t)
+}
+
+// Validate performs a basic check of an OracleStats.
+func (os OracleStats) Validate() error {
+	if strings.TrimSpace(os.MarketID) == "" {
+		return errors.New("market id cannot be blank")
+	}
+	if os.OracleAddress.Empty() {
+		return errors.New("oracle address cannot be empty")
+	}
+	if os.LastDeviation.IsNil() || os.LastDeviation.IsNegative() {
+		return fmt.Errorf("last deviation cannot be negative %s", os.LastDeviation)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (os OracleStats) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Market ID: %s
+Oracle Address: %s
+Posts: %d
+Last Post Time: %s
+Missed Windows: %d
+Deviation Faults: %d
+Last Deviation: %s
+Consecutive Faults: %d
+Jailed Until: %s`,
+		os.MarketID, os.OracleAddress, os.Posts, os.LastPostTime, os.MissedWindows, os.DeviationFaults, os.LastDeviation, os.ConsecutiveFaults, os.JailedUntil))
+}
+
+// OracleStatsList type for an array of OracleStats
+type OracleStatsList []OracleStats
+
+// Validate checks if all the oracle stats are valid and there are no duplicated
+// entries.
+func (osl OracleStatsList) Validate() error {
+	seenStats := make(map[string]bool)
+	for _, os := range osl {
+		if err := os.Validate(); err != nil {
+			return err
+		}
+		key := string(OracleStatsKey(os.MarketID, os.OracleAddress))
+		if seenStats[key] {
+			return fmt.Errorf("duplicated oracle stats for market id %s and oracle address %s", os.MarketID, os.OracleAddress)
+		}
+		seenStats[key] = true
+	}
+	return nil
+}
@@ -1,33 +1,101 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // Parameter keys
 var (
-	KeyMarkets     = []byte("Markets")
-	DefaultMarkets = Markets{}
+	KeyMarkets                   = []byte("Markets")
+	KeyOracleParams              = []byte("OracleParams")
+	KeyPriceHistoryRetention     = []byte("PriceHistoryRetention")
+	DefaultMarkets               = Markets{}
+	DefaultOracleParams          = NewOracleParams(0, sdk.ZeroDec(), 0, 0, false)
+	DefaultPriceHistoryRetention = time.Duration(0)
 )
 
+// MaxPriceHistoryRetention is the longest time historical prices can be kept for
+const MaxPriceHistoryRetention = 30 * 24 * time.Hour
+
 // Params params for pricefeed. Can be altered via governance
 type Params struct {
-	Markets Markets `json:"markets" yaml:"markets"` //  Array containing the markets supported by the pricefeed
+	Markets               Markets       `json:"markets" yaml:"markets"`                                 //  Array containing the markets supported by the pricefeed
+	OracleParams          OracleParams  `json:"oracle_params" yaml:"oracle_params"`                     // How oracle performance is measured and penalized
+	PriceHistoryRetention time.Duration `json:"price_history_retention" yaml:"price_history_retention"` // How long the median prices of markets are kept for, zero to keep no history
 }
 
 // NewParams creates a new AssetParams object
-func NewParams(markets Markets) Params {
+func NewParams(markets Markets, oracleParams OracleParams, priceHistoryRetention time.Duration) Params {
 	return Params{
-		Markets: markets,
+		Markets:               markets,
+		OracleParams:          oracleParams,
+		PriceHistoryRetention: priceHistoryRetention,
 	}
 }
 
 // DefaultParams default params for pricefeed
 func DefaultParams() Params {
-	return NewParams(DefaultMarkets)
+	return NewParams(DefaultMarkets, DefaultOracleParams, DefaultPriceHistoryRetention)
+}
+
+// OracleParams configure how oracle performance is measured and penalized.
+// Oracles are checked at the end of each window, and a window where an oracle posts no price, or posts a price too far from the median, is a fault.
+type OracleParams struct {
+	Window               time.Duration `json:"window" yaml:"window"`                                 // length of the windows oracle performance is measured over, zero to disable tracking
+	MaxDeviation         sdk.Dec       `json:"max_deviation" yaml:"max_deviation"`                   // largest deviation of an oracle's price from the median as a fraction of it, zero for no limit
+	MaxConsecutiveFaults uint64        `json:"max_consecutive_faults" yaml:"max_consecutive_faults"` // faults in a row before an oracle is penalized, zero for no penalties
+	JailDuration         time.Duration `json:"jail_duration" yaml:"jail_duration"`                   // how long penalized oracles cannot post prices for
+	RemoveOracles        bool          `json:"remove_oracles" yaml:"remove_oracles"`                 // whether penalized oracles are barred from posting until removed by governance
+}
+
+// NewOracleParams returns a new OracleParams
+func NewOracleParams(window time.Duration, maxDeviation sdk.Dec, maxConsecutiveFaults uint64, jailDuration time.Duration, removeOracles bool) OracleParams {
+	return OracleParams{
+		Window:               window,
+		MaxDeviation:         maxDeviation,
+		MaxConsecutiveFaults: maxConsecutiveFaults,
+		JailDuration:         jailDuration,
+		RemoveOracles:        removeOracles,
+	}
+}
+
+// String implements fmt.Stringer
+func (op OracleParams) String() string {
+	return fmt.Sprintf(`Oracle Params:
+	Window: %s
+	Max Deviation: %s
+	Max Consecutive Faults: %d
+	Jail Duration: %s
+	Remove Oracles: %t`,
+		op.Window, op.MaxDeviation, op.MaxConsecutiveFaults, op.JailDuration, op.RemoveOracles)
+}
+
+// Validate performs a basic validation of the oracle params
+func (op OracleParams) Validate() error {
+	if op.Window < 0 {
+		return fmt.Errorf("oracle window cannot be negative %s", op.Window)
+	}
+	if !op.MaxDeviation.IsNil() && op.MaxDeviation.IsNegative() {
+		return fmt.Errorf("oracle max deviation cannot be negative %s", op.MaxDeviation)
+	}
+	if op.JailDuration < 0 {
+		return fmt.Errorf("oracle jail duration cannot be negative %s", op.JailDuration)
+	}
+	if op.MaxConsecutiveFaults > 0 {
+		if op.Window == 0 {
+			return errors.New("oracle penalties require an oracle window")
+		}
+		if op.JailDuration == 0 && !op.RemoveOracles {
+			return errors.New("oracle penalties require a jail duration or oracle removal")
+		}
+	}
+	return nil
 }
 
 // ParamKeyTable Key declaration for parameters
@@ -40,6 +108,8 @@ func ParamKeyTable() params.KeyTable {
 func (p *Params) ParamSetPairs() params.ParamSetPairs {
 	return params.ParamSetPairs{
 		params.NewParamSetPair(KeyMarkets, &p.Markets, validateMarketParams),
+		params.NewParamSetPair(KeyOracleParams, &p.OracleParams, validateOracleParams),
+		params.NewParamSetPair(KeyPriceHistoryRetention, &p.PriceHistoryRetention, validatePriceHistoryRetention),
 	}
 }
 
@@ -49,12 +119,20 @@ func (p Params) String() string {
 	for _, a := range p.Markets {
 		out += fmt.Sprintf("%s\n", a.String())
 	}
+	out += fmt.Sprintf("%s\n", p.OracleParams)
+	out += fmt.Sprintf("Price History Retention: %s\n", p.PriceHistoryRetention)
 	return strings.TrimSpace(out)
 }
 
 // Validate ensure that params have valid values
 func (p Params) Validate() error {
-	return validateMarketParams(p.Markets)
+	if err := validateMarketParams(p.Markets); err != nil {
+		return err
+	}
+	if err := validateOracleParams(p.OracleParams); err != nil {
+		return err
+	}
+	return validatePriceHistoryRetention(p.PriceHistoryRetention)
 }
 
 func validateMarketParams(i interface{}) error {
@@ -65,3 +143,24 @@ func validateMarketParams(i interface{}) error {
 
 	return markets.Validate()
 }
+
+func validateOracleParams(i interface{}) error {
+	oracleParams, ok := i.(OracleParams)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return oracleParams.Validate()
+}
+
+func validatePriceHistoryRetention(i interface{}) error {
+	retention, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if retention < 0 || retention > MaxPriceHistoryRetention {
+		return fmt.Errorf("price history retention must be between 0 and %s, got %s", MaxPriceHistoryRetention, retention)
+	}
+	return nil
+}
@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 // price Takes an [assetcode] and returns CurrentPrice for that asset
 // pricefeed Takes an [assetcode] and returns the raw []PostedPrice for that asset
 // assets Returns []Assets in the pricefeed system
@@ -17,6 +19,10 @@ const (
 	QueryPrice = "price"
 	// QueryPrices command for quering all prices
 	QueryPrices = "prices"
+	// QueryOracleStats command for querying the performance of a market's oracles
+	QueryOracleStats = "oracle-stats"
+	// QueryPriceHistory command for querying the historical prices of a market
+	QueryPriceHistory = "price-history"
 )
 
 // QueryWithMarketIDParams fields for querying information from a specific market
@@ -30,3 +36,34 @@ func NewQueryWithMarketIDParams(marketID string) QueryWithMarketIDParams {
 		MarketID: marketID,
 	}
 }
+
+// DefaultPriceHistoryLimit is the number of historical prices returned per page when no limit is given
+const DefaultPriceHistoryLimit = 100
+
+// MaxPriceHistoryLimit is the most historical prices returned per page
+const MaxPriceHistoryLimit = 1000
+
+// QueryPriceHistoryParams fields for querying the historical prices of a market over a time and height range.
+// Zero start or end times and heights leave that end of the range unbounded.
+type QueryPriceHistoryParams struct {
+	MarketID    string    `json:"market_id" yaml:"market_id"`
+	StartTime   time.Time `json:"start_time" yaml:"start_time"`
+	EndTime     time.Time `json:"end_time" yaml:"end_time"`
+	StartHeight int64     `json:"start_height" yaml:"start_height"`
+	EndHeight   int64     `json:"end_height" yaml:"end_height"`
+	Page        int       `json:"page" yaml:"page"`
+	Limit       int       `json:"limit" yaml:"limit"`
+}
+
+// NewQueryPriceHistoryParams creates a new instance of QueryPriceHistoryParams
+func NewQueryPriceHistoryParams(marketID string, startTime, endTime time.Time, startHeight, endHeight int64, page, limit int) QueryPriceHistoryParams {
+	return QueryPriceHistoryParams{
+		MarketID:    marketID,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		StartHeight: startHeight,
+		EndHeight:   endHeight,
+		Page:        page,
+		Limit:       limit,
+	}
+}